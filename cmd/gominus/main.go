@@ -13,6 +13,7 @@ import (
 	"github.com/inkbytefo/go-minus/internal/optimizer"
 	"github.com/inkbytefo/go-minus/internal/parser"
 	"github.com/inkbytefo/go-minus/internal/semantic"
+	"github.com/inkbytefo/go-minus/internal/token"
 )
 
 func main() {
@@ -58,8 +59,10 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Lexer oluştur
-	l := lexer.New(string(input))
+	// Kaynak dosyayı FileSet'e ekle ve lexer oluştur
+	fset := token.NewFileSet()
+	file := fset.AddFile(filename, -1, len(input))
+	l := lexer.NewFile(file, string(input), 0)
 
 	// Parser oluştur
 	p := parser.New(l)
@@ -88,6 +91,10 @@ func main() {
 
 	// IR üretimi
 	generator := irgen.NewWithAnalyzer(analyzer)
+	if *targetOS != "" {
+		// Hedefe bağlı runtime çağrıları hedef işletim sistemine göre üretilir
+		generator.SetTargetTriple(strings.ToLower(*targetArch + "-" + *targetOS))
+	}
 	ir, err := generator.GenerateProgram(program)
 	if err != nil {
 		fmt.Printf("IR üretimi sırasında hata oluştu: %v\n", err)
@@ -2,6 +2,7 @@ package ast
 
 import (
 	"bytes"
+	"math/big"
 	"strings"
 
 	"github.com/inkbytefo/go-minus/internal/token"
@@ -31,6 +32,7 @@ type Expression interface {
 // Her geçerli GO+ programı bir dizi ifadeden (Statement) oluşur.
 type Program struct {
 	Statements []Statement
+	Comments   []*CommentGroup // Kaynak sırasına göre dosyadaki tüm yorum grupları
 }
 
 // TokenLiteral, programın ilk ifadesinin token değişmez değerini döndürür (eğer varsa).
@@ -81,7 +83,8 @@ func (i *Identifier) End() token.Position  { return i.Token.Position }
 // VarStatement, bir değişken tanımlama ifadesini temsil eder.
 // Örnek: var x int = 5
 type VarStatement struct {
-	Token token.Token // token.VAR token'ı
+	Token token.Token   // token.VAR token'ı
+	Doc   *CommentGroup // Opsiyonel belge yorumu
 	Name  *Identifier
 	Type  Expression // Opsiyonel tip
 	Value Expression // Opsiyonel değer
@@ -149,11 +152,12 @@ func (cs *ConstStatement) String() string {
 func (cs *ConstStatement) Pos() token.Position { return cs.Token.Position }
 func (cs *ConstStatement) End() token.Position { return cs.Value.End() }
 
-// ReturnStatement, bir dönüş ifadesini temsil eder.
-// Örnek: return 5
+// ReturnStatement, bir dönüş ifadesini temsil eder. Birden çok sonuç
+// döndüren fonksiyonlarda değerler virgülle ayrılır.
+// Örnek: return 5, return x, nil
 type ReturnStatement struct {
-	Token       token.Token // token.RETURN token'ı
-	ReturnValue Expression
+	Token        token.Token // token.RETURN token'ı
+	ReturnValues []Expression
 }
 
 func (rs *ReturnStatement) statementNode()       {}
@@ -163,9 +167,11 @@ func (rs *ReturnStatement) String() string {
 
 	out.WriteString(rs.TokenLiteral() + " ")
 
-	if rs.ReturnValue != nil {
-		out.WriteString(rs.ReturnValue.String())
+	values := []string{}
+	for _, v := range rs.ReturnValues {
+		values = append(values, v.String())
 	}
+	out.WriteString(strings.Join(values, ", "))
 
 	out.WriteString(";")
 
@@ -173,8 +179,8 @@ func (rs *ReturnStatement) String() string {
 }
 func (rs *ReturnStatement) Pos() token.Position { return rs.Token.Position }
 func (rs *ReturnStatement) End() token.Position {
-	if rs.ReturnValue != nil {
-		return rs.ReturnValue.End()
+	if len(rs.ReturnValues) > 0 {
+		return rs.ReturnValues[len(rs.ReturnValues)-1].End()
 	}
 	return rs.Token.Position
 }
@@ -230,11 +236,15 @@ func (bs *BlockStatement) End() token.Position {
 	return bs.Token.Position
 }
 
+// FloatPrecision, tipsiz ondalık sabitlerin tutulduğu mantis hassasiyetidir (bit).
+const FloatPrecision = 512
+
 // IntegerLiteral, bir tamsayı değişmez değerini temsil eder.
-// Örnek: 5
+// Örnek: 5, 0x1F, 0b1010, 1_000_000
 type IntegerLiteral struct {
-	Token token.Token // token.INT token'ı
-	Value int64
+	Token    token.Token // token.INT token'ı
+	Value    int64       // int64'e sığıyorsa değer
+	Constant *big.Int    // Tipsiz sabitin tam değeri
 }
 
 func (il *IntegerLiteral) expressionNode()      {}
@@ -244,10 +254,11 @@ func (il *IntegerLiteral) Pos() token.Position  { return il.Token.Position }
 func (il *IntegerLiteral) End() token.Position  { return il.Token.Position }
 
 // FloatLiteral, bir ondalık sayı değişmez değerini temsil eder.
-// Örnek: 3.14
+// Örnek: 3.14, .5, 1e-9, 0x1p-2
 type FloatLiteral struct {
-	Token token.Token // token.FLOAT token'ı
-	Value float64
+	Token    token.Token // token.FLOAT token'ı
+	Value    float64     // En yakın float64 değeri
+	Constant *big.Float  // Tipsiz sabitin yüksek hassasiyetli değeri
 }
 
 func (fl *FloatLiteral) expressionNode()      {}
@@ -256,6 +267,22 @@ func (fl *FloatLiteral) String() string       { return fl.Token.Literal }
 func (fl *FloatLiteral) Pos() token.Position  { return fl.Token.Position }
 func (fl *FloatLiteral) End() token.Position  { return fl.Token.Position }
 
+// ImaginaryLiteral, bir sanal sayı değişmez değerini temsil eder. Karmaşık
+// sayılar desteklenmediğinden literal yalnızca ayrıştırılır; anlamsal
+// analiz onu reddeder.
+// Örnek: 2i, 1.5i, 0x1p-2i
+type ImaginaryLiteral struct {
+	Token    token.Token // token.IMAG token'ı
+	Value    float64     // Sanal kısmın en yakın float64 değeri
+	Constant *big.Float  // Sanal kısmın yüksek hassasiyetli değeri
+}
+
+func (il *ImaginaryLiteral) expressionNode()      {}
+func (il *ImaginaryLiteral) TokenLiteral() string { return il.Token.Literal }
+func (il *ImaginaryLiteral) String() string       { return il.Token.Literal }
+func (il *ImaginaryLiteral) Pos() token.Position  { return il.Token.Position }
+func (il *ImaginaryLiteral) End() token.Position  { return il.Token.Position }
+
 // StringLiteral, bir string değişmez değerini temsil eder.
 // Örnek: "hello"
 type StringLiteral struct {
@@ -360,6 +387,66 @@ func (ie *InfixExpression) String() string {
 func (ie *InfixExpression) Pos() token.Position { return ie.Left.Pos() }
 func (ie *InfixExpression) End() token.Position { return ie.Right.End() }
 
+// AssignExpression, bir atama ifadesini temsil eder.
+// Örnek: x = 5, sum += x, a[f()] <<= 1
+type AssignExpression struct {
+	Token    token.Token // Atama operatörü token'ı
+	Left     Expression
+	Operator string // "=", "+=", "-=", ...
+	Value    Expression
+}
+
+func (ae *AssignExpression) expressionNode()      {}
+func (ae *AssignExpression) TokenLiteral() string { return ae.Token.Literal }
+func (ae *AssignExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(ae.Left.String())
+	out.WriteString(" " + ae.Operator + " ")
+	out.WriteString(ae.Value.String())
+	out.WriteString(")")
+
+	return out.String()
+}
+func (ae *AssignExpression) Pos() token.Position { return ae.Left.Pos() }
+func (ae *AssignExpression) End() token.Position { return ae.Value.End() }
+
+// BinaryOperator, bileşik atamanın uyguladığı ikili operatörü döndürür
+// (örn. "+=" için "+"). Basit atama (=) için boş string döner.
+func (ae *AssignExpression) BinaryOperator() string {
+	return strings.TrimSuffix(ae.Operator, "=")
+}
+
+// MultiAssignStatement, birden çok hedefe aynı anda atama yapan bir ifadeyi
+// temsil eder. Sağ taraf ya hedef sayısı kadar değerden ya da çok sonuçlu tek
+// bir çağrıdan oluşur.
+// Örnek: x, err := f(), a, b = b, a
+type MultiAssignStatement struct {
+	Token    token.Token // ":=" veya "=" token'ı
+	Left     []Expression
+	Operator string // ":=" veya "="
+	Values   []Expression
+}
+
+func (mas *MultiAssignStatement) statementNode()       {}
+func (mas *MultiAssignStatement) TokenLiteral() string { return mas.Token.Literal }
+func (mas *MultiAssignStatement) String() string {
+	left := []string{}
+	for _, l := range mas.Left {
+		left = append(left, l.String())
+	}
+	values := []string{}
+	for _, v := range mas.Values {
+		values = append(values, v.String())
+	}
+	return strings.Join(left, ", ") + " " + mas.Operator + " " + strings.Join(values, ", ")
+}
+func (mas *MultiAssignStatement) Pos() token.Position { return mas.Left[0].Pos() }
+func (mas *MultiAssignStatement) End() token.Position {
+	return mas.Values[len(mas.Values)-1].End()
+}
+
 // PostfixExpression, bir postfix ifadesini temsil eder.
 // Örnek: i++, j--
 type PostfixExpression struct {
@@ -421,7 +508,7 @@ func (ie *IfExpression) End() token.Position {
 // Örnek: func(x, y) { return x + y; }
 type FunctionLiteral struct {
 	Token      token.Token // token.FUNCTION token'ı
-	Parameters []*Identifier
+	Parameters []*Parameter
 	Body       *BlockStatement
 	ReturnType Expression // Opsiyonel dönüş tipi
 }
@@ -458,6 +545,7 @@ type CallExpression struct {
 	Token     token.Token // token.LPAREN token'ı
 	Function  Expression  // Identifier veya FunctionLiteral
 	Arguments []Expression
+	Ellipsis  token.Position // Son argüman f(xs...) ile yayılıyorsa '...' konumu
 }
 
 func (ce *CallExpression) expressionNode()      {}
@@ -473,6 +561,9 @@ func (ce *CallExpression) String() string {
 	out.WriteString(ce.Function.String())
 	out.WriteString("(")
 	out.WriteString(strings.Join(args, ", "))
+	if ce.Ellipsis.IsValid() {
+		out.WriteString("...")
+	}
 	out.WriteString(")")
 
 	return out.String()
@@ -540,6 +631,53 @@ func (ie *IndexExpression) String() string {
 func (ie *IndexExpression) Pos() token.Position { return ie.Left.Pos() }
 func (ie *IndexExpression) End() token.Position { return ie.Index.End() }
 
+// SliceExpression, bir dilimleme ifadesini temsil eder. Atlanan sınırlar
+// nil'dir; Max yalnızca üç indeksli biçimde (s[i:j:k]) bulunur.
+// Örnek: s[1:3], s[:n], s[i:], xs[:n:n]
+type SliceExpression struct {
+	Token token.Token // token.LBRACKET token'ı
+	Left  Expression
+	Low   Expression
+	High  Expression
+	Max   Expression
+}
+
+func (se *SliceExpression) expressionNode()      {}
+func (se *SliceExpression) TokenLiteral() string { return se.Token.Literal }
+func (se *SliceExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(se.Left.String())
+	out.WriteString("[")
+	if se.Low != nil {
+		out.WriteString(se.Low.String())
+	}
+	out.WriteString(":")
+	if se.High != nil {
+		out.WriteString(se.High.String())
+	}
+	if se.Max != nil {
+		out.WriteString(":")
+		out.WriteString(se.Max.String())
+	}
+	out.WriteString("])")
+
+	return out.String()
+}
+func (se *SliceExpression) Pos() token.Position { return se.Left.Pos() }
+func (se *SliceExpression) End() token.Position {
+	switch {
+	case se.Max != nil:
+		return se.Max.End()
+	case se.High != nil:
+		return se.High.End()
+	case se.Low != nil:
+		return se.Low.End()
+	}
+	return se.Token.Position
+}
+
 // ArrayType, bir dizi tipini temsil eder.
 // Örnek: [5]int, []int
 type ArrayType struct {
@@ -630,6 +768,47 @@ func (fs *ForStatement) String() string {
 func (fs *ForStatement) Pos() token.Position { return fs.Token.Position }
 func (fs *ForStatement) End() token.Position { return fs.Body.End() }
 
+// RangeStatement, bir for-range döngüsünü temsil eder.
+// Örnek: for i, v := range xs { ... }
+type RangeStatement struct {
+	Token    token.Token // token.FOR token'ı
+	Key      Expression  // Opsiyonel indeks/anahtar (kanal için değer)
+	Value    Expression  // Opsiyonel değer
+	Define   bool        // Değişkenler := ile mi tanımlanıyor (aksi halde = ile atanır)
+	Iterable Expression  // Üzerinde dolaşılan dizi, slice, string, map, kanal veya tamsayı
+	Body     *BlockStatement
+}
+
+func (rs *RangeStatement) statementNode()       {}
+func (rs *RangeStatement) TokenLiteral() string { return rs.Token.Literal }
+func (rs *RangeStatement) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("for ")
+
+	if rs.Key != nil {
+		out.WriteString(rs.Key.String())
+		if rs.Value != nil {
+			out.WriteString(", ")
+			out.WriteString(rs.Value.String())
+		}
+		if rs.Define {
+			out.WriteString(" := ")
+		} else {
+			out.WriteString(" = ")
+		}
+	}
+
+	out.WriteString("range ")
+	out.WriteString(rs.Iterable.String())
+	out.WriteString(" ")
+	out.WriteString(rs.Body.String())
+
+	return out.String()
+}
+func (rs *RangeStatement) Pos() token.Position { return rs.Token.Position }
+func (rs *RangeStatement) End() token.Position { return rs.Body.End() }
+
 // WhileStatement, bir while döngüsünü temsil eder.
 // Örnek: while (x < 10) { ... }
 type WhileStatement struct {
@@ -732,10 +911,58 @@ func (cc *CaseClause) End() token.Position {
 	return cc.Token.Position
 }
 
+// BranchStatement, bir break, continue veya goto ifadesini temsil eder.
+// Örnek: break, continue dis, goto son
+type BranchStatement struct {
+	Token token.Token // token.BREAK, token.CONTINUE veya token.GOTO token'ı
+	Label *Identifier // Opsiyonel etiket (goto için zorunlu)
+}
+
+func (bs *BranchStatement) statementNode()       {}
+func (bs *BranchStatement) TokenLiteral() string { return bs.Token.Literal }
+func (bs *BranchStatement) String() string {
+	if bs.Label != nil {
+		return bs.Token.Literal + " " + bs.Label.String()
+	}
+	return bs.Token.Literal
+}
+func (bs *BranchStatement) Pos() token.Position { return bs.Token.Position }
+func (bs *BranchStatement) End() token.Position {
+	if bs.Label != nil {
+		return bs.Label.End()
+	}
+	return bs.Token.Position
+}
+
+// LabeledStatement, etiketli bir ifadeyi temsil eder.
+// Örnek: dis: for { ... }
+type LabeledStatement struct {
+	Token     token.Token // Etiketin token.IDENT token'ı
+	Label     *Identifier
+	Statement Statement // Etiketlenen ifade (boş ifade için nil)
+}
+
+func (ls *LabeledStatement) statementNode()       {}
+func (ls *LabeledStatement) TokenLiteral() string { return ls.Token.Literal }
+func (ls *LabeledStatement) String() string {
+	if ls.Statement != nil {
+		return ls.Label.String() + ": " + ls.Statement.String()
+	}
+	return ls.Label.String() + ":"
+}
+func (ls *LabeledStatement) Pos() token.Position { return ls.Token.Position }
+func (ls *LabeledStatement) End() token.Position {
+	if ls.Statement != nil {
+		return ls.Statement.End()
+	}
+	return ls.Label.End()
+}
+
 // ClassStatement, bir sınıf tanımını temsil eder.
 // Örnek: class Person { ... }
 type ClassStatement struct {
-	Token      token.Token // token.CLASS token'ı
+	Token      token.Token   // token.CLASS token'ı
+	Doc        *CommentGroup // Opsiyonel belge yorumu
 	Name       *Identifier
 	Extends    *Identifier   // Opsiyonel kalıtım
 	Implements []*Identifier // Opsiyonel arayüz uygulamaları
@@ -773,14 +1000,18 @@ func (cs *ClassStatement) Pos() token.Position { return cs.Token.Position }
 func (cs *ClassStatement) End() token.Position { return cs.Body.End() }
 
 // MethodStatement, bir metot tanımını temsil eder.
-// Örnek: func (p Person) sayHello() { ... }
+// Örnek: func (p Person) sayHello() { ... }, func (p *Person) rename(n string) { ... }
 type MethodStatement struct {
-	Token      token.Token // token.FUNC token'ı
-	Receiver   *Identifier
-	Name       *Identifier
-	Parameters []*Identifier
-	ReturnType Expression // Opsiyonel dönüş tipi
-	Body       *BlockStatement
+	Token        token.Token   // token.FUNC token'ı
+	Doc          *CommentGroup // Opsiyonel belge yorumu
+	ReceiverName *Identifier   // Opsiyonel alıcı adı (func (Person) ... için nil)
+	Pointer      bool          // Alıcı bir işaretçi mi? (func (p *Person) ...)
+	Receiver     *Identifier   // Alıcı tipi (işaretçi alıcılarda gösterilen tip)
+	TypeParams   []*Identifier // Şablon sınıf alıcısının tip parametreleri (*Channel<T>)
+	Name         *Identifier
+	Parameters   []*Parameter
+	ReturnType   Expression // Opsiyonel dönüş tipi
+	Body         *BlockStatement
 }
 
 func (ms *MethodStatement) statementNode()       {}
@@ -795,7 +1026,20 @@ func (ms *MethodStatement) String() string {
 
 	out.WriteString(ms.TokenLiteral())
 	out.WriteString(" (")
+	if ms.ReceiverName != nil {
+		out.WriteString(ms.ReceiverName.String() + " ")
+	}
+	if ms.Pointer {
+		out.WriteString("*")
+	}
 	out.WriteString(ms.Receiver.String())
+	if len(ms.TypeParams) > 0 {
+		typeParams := []string{}
+		for _, tp := range ms.TypeParams {
+			typeParams = append(typeParams, tp.String())
+		}
+		out.WriteString("<" + strings.Join(typeParams, ", ") + ">")
+	}
 	out.WriteString(") ")
 	out.WriteString(ms.Name.String())
 	out.WriteString("(")
@@ -930,6 +1174,138 @@ func (ss *ScopeStatement) String() string {
 func (ss *ScopeStatement) Pos() token.Position { return ss.Token.Position }
 func (ss *ScopeStatement) End() token.Position { return ss.Body.End() }
 
+// UnsafeStatement, manuel bellek yönetimine (alloc, free, delete) ve
+// işaretçi aritmetiğine izin veren bir bloğu temsil eder.
+// Örnek: unsafe { p := alloc(int, 4); free(p) }
+type UnsafeStatement struct {
+	Token token.Token // token.UNSAFE token'ı
+	Body  *BlockStatement
+}
+
+func (us *UnsafeStatement) statementNode()       {}
+func (us *UnsafeStatement) TokenLiteral() string { return us.Token.Literal }
+func (us *UnsafeStatement) String() string {
+	return us.TokenLiteral() + " " + us.Body.String()
+}
+func (us *UnsafeStatement) Pos() token.Position { return us.Token.Position }
+func (us *UnsafeStatement) End() token.Position { return us.Body.End() }
+
+// DeferStatement, çevreleyen fonksiyon dönerken çalıştırılacak bir çağrıyı
+// temsil eder. Çağrılan fonksiyon ve argümanlar defer deyiminde
+// değerlendirilir; ertelenen çağrılar ters sırayla çalışır.
+// Örnek: defer f.Close()
+type DeferStatement struct {
+	Token token.Token // token.DEFER token'ı
+	Call  *CallExpression
+}
+
+func (ds *DeferStatement) statementNode()       {}
+func (ds *DeferStatement) TokenLiteral() string { return ds.Token.Literal }
+func (ds *DeferStatement) String() string {
+	return ds.TokenLiteral() + " " + ds.Call.String() + ";"
+}
+func (ds *DeferStatement) Pos() token.Position { return ds.Token.Position }
+func (ds *DeferStatement) End() token.Position { return ds.Call.End() }
+
+// GoStatement, bir fonksiyon çağrısını yeni bir goroutine'de başlatan deyimi
+// temsil eder. Çağrılan fonksiyon ve argümanlar go deyiminde değerlendirilir.
+// Örnek: go worker(jobs)
+type GoStatement struct {
+	Token token.Token // token.GO token'ı
+	Call  *CallExpression
+}
+
+func (gs *GoStatement) statementNode()       {}
+func (gs *GoStatement) TokenLiteral() string { return gs.Token.Literal }
+func (gs *GoStatement) String() string {
+	return gs.TokenLiteral() + " " + gs.Call.String() + ";"
+}
+func (gs *GoStatement) Pos() token.Position { return gs.Token.Position }
+func (gs *GoStatement) End() token.Position { return gs.Call.End() }
+
+// SendStatement, bir kanala değer gönderen deyimi temsil eder.
+// Örnek: ch <- v
+type SendStatement struct {
+	Token   token.Token // token.LARROW token'ı
+	Channel Expression
+	Value   Expression
+}
+
+func (ss *SendStatement) statementNode()       {}
+func (ss *SendStatement) TokenLiteral() string { return ss.Token.Literal }
+func (ss *SendStatement) String() string {
+	return ss.Channel.String() + " <- " + ss.Value.String()
+}
+func (ss *SendStatement) Pos() token.Position { return ss.Channel.Pos() }
+func (ss *SendStatement) End() token.Position { return ss.Value.End() }
+
+// SelectStatement, bir select deyimini temsil eder. Hazır olan iletişim
+// durumlarından biri rastgele seçilip çalıştırılır.
+// Örnek: select { case v := <-ch: ...; default: ... }
+type SelectStatement struct {
+	Token   token.Token // token.SELECT token'ı
+	Cases   []*CommClause
+	Closing token.Token // Kapanış '}' token'ı
+}
+
+func (ss *SelectStatement) statementNode()       {}
+func (ss *SelectStatement) TokenLiteral() string { return ss.Token.Literal }
+func (ss *SelectStatement) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("select {\n")
+	for _, c := range ss.Cases {
+		out.WriteString(c.String())
+	}
+	out.WriteString("}")
+
+	return out.String()
+}
+func (ss *SelectStatement) Pos() token.Position { return ss.Token.Position }
+func (ss *SelectStatement) End() token.Position { return ss.Closing.Position }
+
+// CommClause, bir select deyimindeki case veya default durumunu temsil eder.
+// Comm bir *SendStatement ya da alma işlemi içeren bir deyimdir (<-ch,
+// v := <-ch, v, ok = <-ch); default için nil'dir.
+type CommClause struct {
+	Token token.Token // token.CASE veya token.DEFAULT token'ı
+	Comm  Statement
+	Body  []Statement
+}
+
+func (cc *CommClause) statementNode()       {}
+func (cc *CommClause) TokenLiteral() string { return cc.Token.Literal }
+func (cc *CommClause) String() string {
+	var out bytes.Buffer
+
+	if cc.Comm == nil {
+		out.WriteString("default:")
+	} else {
+		out.WriteString("case ")
+		out.WriteString(cc.Comm.String())
+		out.WriteString(":")
+	}
+
+	out.WriteString("\n")
+	for _, stmt := range cc.Body {
+		out.WriteString("\t")
+		out.WriteString(stmt.String())
+		out.WriteString("\n")
+	}
+
+	return out.String()
+}
+func (cc *CommClause) Pos() token.Position { return cc.Token.Position }
+func (cc *CommClause) End() token.Position {
+	if len(cc.Body) > 0 {
+		return cc.Body[len(cc.Body)-1].End()
+	}
+	if cc.Comm != nil {
+		return cc.Comm.End()
+	}
+	return cc.Token.Position
+}
+
 // TemplateExpression, bir şablon ifadesini temsil eder.
 // Örnek: template<T> func add(a T, b T) T { return a + b; }
 type TemplateExpression struct {
@@ -0,0 +1,92 @@
+package ast
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// Comment, tek bir `//` veya `/* */` yorumunu temsil eder.
+type Comment struct {
+	Token token.Token // token.COMMENT token'ı
+	Text  string      // Yorum işaretleri dahil yorum metni
+}
+
+// Pos, yorumun başlangıç konumunu döndürür.
+func (c *Comment) Pos() token.Position {
+	return c.Token.Position
+}
+
+// End, yorumun bitiş konumunu (son karakterden hemen sonrası) döndürür.
+func (c *Comment) End() token.Position {
+	end := c.Token.Position
+	end.Offset += len(c.Text)
+	if i := strings.LastIndexByte(c.Text, '\n'); i >= 0 {
+		end.Line += strings.Count(c.Text, "\n")
+		end.Column = utf8.RuneCountInString(c.Text[i+1:]) + 1
+	} else {
+		end.Column += utf8.RuneCountInString(c.Text)
+	}
+	return end
+}
+
+// CommentGroup, aralarında boş satır bulunmayan ardışık yorumları temsil eder.
+// Bildirimlerin hemen önündeki grup, bildirimin belge yorumu (Doc) olarak kullanılır.
+type CommentGroup struct {
+	List []*Comment
+}
+
+// Pos, grubun ilk yorumunun konumunu döndürür.
+func (g *CommentGroup) Pos() token.Position {
+	if g == nil || len(g.List) == 0 {
+		return token.Position{}
+	}
+	return g.List[0].Pos()
+}
+
+// End, grubun son yorumunun bitiş konumunu döndürür.
+func (g *CommentGroup) End() token.Position {
+	if g == nil || len(g.List) == 0 {
+		return token.Position{}
+	}
+	return g.List[len(g.List)-1].End()
+}
+
+// Text, yorum işaretleri (`//`, `/*`, `*/`) çıkarılmış grup metnini döndürür.
+// Baştaki ve sondaki boş satırlar atılır, satırlar '\n' ile biter.
+// Grup nil ise boş string döner.
+func (g *CommentGroup) Text() string {
+	if g == nil {
+		return ""
+	}
+
+	var lines []string
+	for _, c := range g.List {
+		text := c.Text
+		switch {
+		case strings.HasPrefix(text, "//"):
+			text = text[2:]
+		case strings.HasPrefix(text, "/*"):
+			text = strings.TrimSuffix(text[2:], "*/")
+		}
+		// "// yorum" ve "/* yorum */" biçimlerindeki baştaki tek boşluğu kaldır
+		text = strings.TrimPrefix(text, " ")
+		for _, line := range strings.Split(text, "\n") {
+			lines = append(lines, strings.TrimRight(line, " \t\r"))
+		}
+	}
+
+	// Baştaki ve sondaki boş satırları at
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
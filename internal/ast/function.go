@@ -9,10 +9,11 @@ import (
 // FunctionStatement, bir fonksiyon tanımını temsil eder.
 // Örnek: func add(x, y int) int { return x + y; }
 type FunctionStatement struct {
-	Token      token.Token // token.FUNCTION token'ı
+	Token      token.Token   // token.FUNCTION token'ı
+	Doc        *CommentGroup // Opsiyonel belge yorumu
 	Name       *Identifier
-	Parameters []*Identifier
-	ReturnType Expression // Opsiyonel dönüş tipi
+	Parameters []*Parameter
+	ReturnType Expression // Opsiyonel dönüş tipi; birden çok veya adlandırılmış sonuç için *ResultList
 	Body       *BlockStatement
 }
 
@@ -53,3 +54,76 @@ func (fs *FunctionStatement) End() token.Position {
 	}
 	return fs.Token.Position
 }
+
+// Parameter, bir fonksiyon parametresini veya sonucunu temsil eder. Gruplanmış
+// parametrelerin (a, b int) her biri tipi paylaşan ayrı bir Parameter'dır.
+// Örnek: a int, s string
+type Parameter struct {
+	Name *Identifier // Adsız sonuçlarda nil
+	Type Expression  // Tipi yazılmamış parametrelerde nil
+}
+
+func (p *Parameter) String() string {
+	switch {
+	case p.Name == nil:
+		return p.Type.String()
+	case p.Type == nil:
+		return p.Name.String()
+	}
+	return p.Name.String() + " " + p.Type.String()
+}
+
+// Pos, parametrenin konumunu döndürür.
+func (p *Parameter) Pos() token.Position {
+	if p.Name != nil {
+		return p.Name.Pos()
+	}
+	return p.Type.Pos()
+}
+
+// IsVariadic, son parametrenin ...T biçiminde variadic olup olmadığını
+// bildirir.
+func IsVariadic(params []*Parameter) bool {
+	if len(params) == 0 {
+		return false
+	}
+	_, ok := params[len(params)-1].Type.(*Ellipsis)
+	return ok
+}
+
+// ResultList, birden çok veya adlandırılmış sonuç döndüren bir fonksiyonun
+// sonuç listesini temsil eder. Tek ve adsız bir sonuç doğrudan tipiyle
+// yazılır.
+// Örnek: (int, error), (n int, err error)
+type ResultList struct {
+	Token   token.Token // '(' token'ı
+	Results []*Parameter
+	Closing token.Token // ')' token'ı
+}
+
+func (rl *ResultList) expressionNode()      {}
+func (rl *ResultList) TokenLiteral() string { return rl.Token.Literal }
+func (rl *ResultList) String() string {
+	results := []string{}
+	for _, r := range rl.Results {
+		results = append(results, r.String())
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+// Pos, düğümün konumunu döndürür.
+func (rl *ResultList) Pos() token.Position {
+	return rl.Token.Position
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (rl *ResultList) End() token.Position {
+	return rl.Closing.Position
+}
+
+// Named, sonuçların adlandırılmış olup olmadığını bildirir. Adlandırılmış
+// sonuçlar fonksiyon gövdesinde değişken olarak kullanılabilir ve yalın bir
+// return ile döndürülür.
+func (rl *ResultList) Named() bool {
+	return len(rl.Results) > 0 && rl.Results[0].Name != nil
+}
@@ -48,3 +48,40 @@ func (ts *TemplateStatement) End() token.Position {
 		return ts.Token.Position
 	}
 }
+
+// TemplateInstantiation, bir şablonun tip argümanlarıyla örneklenmesini temsil eder.
+// Örnek: Foo<T> (Foo<T>(x) çağrısında çağrılan ifade)
+type TemplateInstantiation struct {
+	Token         token.Token // '<' token'ı
+	Template      Expression  // Örneklenen şablon (Identifier veya MemberExpression)
+	TypeArguments []Expression
+	Closing       token.Token // '>' token'ı
+}
+
+func (ti *TemplateInstantiation) expressionNode()      {}
+func (ti *TemplateInstantiation) TokenLiteral() string { return ti.Token.Literal }
+func (ti *TemplateInstantiation) String() string {
+	var out bytes.Buffer
+
+	args := []string{}
+	for _, a := range ti.TypeArguments {
+		args = append(args, a.String())
+	}
+
+	out.WriteString(ti.Template.String())
+	out.WriteString("<")
+	out.WriteString(strings.Join(args, ", "))
+	out.WriteString(">")
+
+	return out.String()
+}
+
+// Pos, düğümün konumunu döndürür.
+func (ti *TemplateInstantiation) Pos() token.Position {
+	return ti.Template.Pos()
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (ti *TemplateInstantiation) End() token.Position {
+	return ti.Closing.Position
+}
@@ -0,0 +1,478 @@
+package ast
+
+import (
+	"bytes"
+	"github.com/inkbytefo/go-minus/internal/token"
+	"strings"
+)
+
+// TypeStatement, bir tip bildirimini temsil eder. Alias true ise bildirim
+// yeni bir tip tanımlamaz; ad, hedef tipin başka bir adıdır.
+// Örnek: type Point struct { x, y int }, struct Point { x, y int }, type Alias = Other
+type TypeStatement struct {
+	Token token.Token   // token.TYPE veya kısa yazımda token.STRUCT token'ı
+	Doc   *CommentGroup // Opsiyonel belge yorumu
+	Name  *Identifier
+	Alias bool       // type Ad = Tip biçimi
+	Type  Expression // Tanımlanan tip (ör. *StructType)
+}
+
+func (ts *TypeStatement) statementNode()       {}
+func (ts *TypeStatement) TokenLiteral() string { return ts.Token.Literal }
+func (ts *TypeStatement) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("type ")
+	out.WriteString(ts.Name.String())
+	if ts.Alias {
+		out.WriteString(" =")
+	}
+	if ts.Type != nil {
+		out.WriteString(" ")
+		out.WriteString(ts.Type.String())
+	}
+
+	return out.String()
+}
+
+// Pos, düğümün konumunu döndürür.
+func (ts *TypeStatement) Pos() token.Position {
+	return ts.Token.Position
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (ts *TypeStatement) End() token.Position {
+	if ts.Type != nil {
+		return ts.Type.End()
+	}
+	return ts.Name.End()
+}
+
+// StructField, bir struct alanını temsil eder. Gömülü alanlarda Name nil'dir
+// ve alanın adı tipin adıdır.
+// Örnek: x int `json:"x"`, Base
+type StructField struct {
+	Name *Identifier    // Alan adı (gömülü alanlar için nil)
+	Type Expression     // Alan tipi
+	Tag  *StringLiteral // Opsiyonel alan etiketi
+}
+
+// Embedded, alanın gömülü olup olmadığını döndürür.
+func (sf *StructField) Embedded() bool {
+	return sf.Name == nil
+}
+
+// FieldName, alanın erişimde kullanılan adını döndürür. Gömülü alanlar için
+// bu, nitelikli adlarda son bileşen olmak üzere tipin (*T için T'nin) adıdır.
+func (sf *StructField) FieldName() string {
+	if sf.Name != nil {
+		return sf.Name.Value
+	}
+	typ := sf.Type
+	if pointer, ok := typ.(*PointerType); ok {
+		typ = pointer.Elem
+	}
+	switch t := typ.(type) {
+	case *Identifier:
+		return t.Value
+	case *MemberExpression:
+		return t.Member.String()
+	}
+	return ""
+}
+
+func (sf *StructField) String() string {
+	var out bytes.Buffer
+
+	if sf.Name != nil {
+		out.WriteString(sf.Name.String() + " ")
+	}
+	out.WriteString(sf.Type.String())
+	if sf.Tag != nil {
+		// Etiketler geleneksel olarak ham string olarak yazılır
+		out.WriteString(" `" + sf.Tag.Value + "`")
+	}
+
+	return out.String()
+}
+
+// StructType, bir struct tipini temsil eder.
+// Örnek: struct { x, y int; Base }
+type StructType struct {
+	Token   token.Token // token.STRUCT token'ı
+	Fields  []*StructField
+	Closing token.Token // Kapanış '}' token'ı
+}
+
+func (st *StructType) expressionNode()      {}
+func (st *StructType) TokenLiteral() string { return st.Token.Literal }
+func (st *StructType) String() string {
+	var out bytes.Buffer
+
+	fields := []string{}
+	for _, f := range st.Fields {
+		fields = append(fields, f.String())
+	}
+
+	out.WriteString("struct { ")
+	out.WriteString(strings.Join(fields, "; "))
+	out.WriteString(" }")
+
+	return out.String()
+}
+
+// Pos, düğümün konumunu döndürür.
+func (st *StructType) Pos() token.Position {
+	return st.Token.Position
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (st *StructType) End() token.Position {
+	return st.Closing.Position
+}
+
+// CompositeLiteral, bir bileşik değişmez değeri temsil eder. Elemanlar
+// konumsal ifadeler veya alan adıyla verilen *KeyValueExpression'lardır.
+// Örnek: Point{x: 1, y: 2}, Point{1, 2}
+type CompositeLiteral struct {
+	Token    token.Token // token.LBRACE token'ı
+	Type     Expression  // Değişmezin tipi
+	Elements []Expression
+	Closing  token.Token // Kapanış '}' token'ı
+}
+
+func (cl *CompositeLiteral) expressionNode()      {}
+func (cl *CompositeLiteral) TokenLiteral() string { return cl.Token.Literal }
+func (cl *CompositeLiteral) String() string {
+	var out bytes.Buffer
+
+	elements := []string{}
+	for _, el := range cl.Elements {
+		elements = append(elements, el.String())
+	}
+
+	out.WriteString(cl.Type.String())
+	out.WriteString("{")
+	out.WriteString(strings.Join(elements, ", "))
+	out.WriteString("}")
+
+	return out.String()
+}
+
+// Pos, düğümün konumunu döndürür.
+func (cl *CompositeLiteral) Pos() token.Position {
+	return cl.Type.Pos()
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (cl *CompositeLiteral) End() token.Position {
+	return cl.Closing.Position
+}
+
+// KeyValueExpression, bileşik değişmezlerdeki anahtar: değer çiftini temsil eder.
+// Örnek: x: 1
+type KeyValueExpression struct {
+	Token token.Token // token.COLON token'ı
+	Key   Expression
+	Value Expression
+}
+
+func (kv *KeyValueExpression) expressionNode()      {}
+func (kv *KeyValueExpression) TokenLiteral() string { return kv.Token.Literal }
+func (kv *KeyValueExpression) String() string {
+	return kv.Key.String() + ": " + kv.Value.String()
+}
+
+// Pos, düğümün konumunu döndürür.
+func (kv *KeyValueExpression) Pos() token.Position {
+	return kv.Key.Pos()
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (kv *KeyValueExpression) End() token.Position {
+	return kv.Value.End()
+}
+
+// InterfaceMethod, bir arayüz tipindeki metot imzasını temsil eder.
+// Örnek: Area() int
+type InterfaceMethod struct {
+	Name       *Identifier
+	Parameters []*Parameter
+	ReturnType Expression // Opsiyonel dönüş tipi
+}
+
+func (im *InterfaceMethod) String() string {
+	params := []string{}
+	for _, p := range im.Parameters {
+		params = append(params, p.String())
+	}
+
+	out := im.Name.String() + "(" + strings.Join(params, ", ") + ")"
+	if im.ReturnType != nil {
+		out += " " + im.ReturnType.String()
+	}
+	return out
+}
+
+// InterfaceType, bir arayüz tipini temsil eder. Gömülü arayüzler metotlarıyla
+// birlikte arayüze katılır.
+// Örnek: interface { Area() int; Stringer }
+type InterfaceType struct {
+	Token   token.Token // token.INTERFACE token'ı
+	Methods []*InterfaceMethod
+	Embeds  []Expression // Gömülü arayüz tipleri
+	Closing token.Token  // Kapanış '}' token'ı
+}
+
+func (it *InterfaceType) expressionNode()      {}
+func (it *InterfaceType) TokenLiteral() string { return it.Token.Literal }
+func (it *InterfaceType) String() string {
+	var out bytes.Buffer
+
+	elements := []string{}
+	for _, e := range it.Embeds {
+		elements = append(elements, e.String())
+	}
+	for _, m := range it.Methods {
+		elements = append(elements, m.String())
+	}
+
+	if len(elements) == 0 {
+		return "interface{}"
+	}
+	out.WriteString("interface { ")
+	out.WriteString(strings.Join(elements, "; "))
+	out.WriteString(" }")
+
+	return out.String()
+}
+
+// Pos, düğümün konumunu döndürür.
+func (it *InterfaceType) Pos() token.Position {
+	return it.Token.Position
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (it *InterfaceType) End() token.Position {
+	return it.Closing.Position
+}
+
+// TypeAssertExpression, bir tip iddiasını temsil eder. Tip switch'lerinde
+// kullanılan x.(type) biçiminde Type nil'dir.
+// Örnek: x.(Point), x.(type)
+type TypeAssertExpression struct {
+	Token      token.Token // token.DOT token'ı
+	Expression Expression
+	Type       Expression  // x.(type) için nil
+	Closing    token.Token // Kapanış ')' token'ı
+}
+
+func (ta *TypeAssertExpression) expressionNode()      {}
+func (ta *TypeAssertExpression) TokenLiteral() string { return ta.Token.Literal }
+func (ta *TypeAssertExpression) String() string {
+	typ := "type"
+	if ta.Type != nil {
+		typ = ta.Type.String()
+	}
+	return ta.Expression.String() + ".(" + typ + ")"
+}
+
+// Pos, düğümün konumunu döndürür.
+func (ta *TypeAssertExpression) Pos() token.Position {
+	return ta.Expression.Pos()
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (ta *TypeAssertExpression) End() token.Position {
+	return ta.Closing.Position
+}
+
+// TypeSwitchStatement, bir tip switch ifadesini temsil eder. Case değerleri
+// tiplerdir (veya nil); Binding verilmişse her case'te iddia edilen tipte
+// tanımlanır.
+// Örnek: switch v := x.(type) { case int: ... case nil: ... }
+type TypeSwitchStatement struct {
+	Token   token.Token // token.SWITCH token'ı
+	Binding *Identifier // Opsiyonel değişken (v := x.(type))
+	Subject Expression  // Tipi incelenen arayüz değeri
+	Cases   []*CaseClause
+	Closing token.Token // Kapanış '}' token'ı
+}
+
+func (ts *TypeSwitchStatement) statementNode()       {}
+func (ts *TypeSwitchStatement) TokenLiteral() string { return ts.Token.Literal }
+func (ts *TypeSwitchStatement) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("switch ")
+	if ts.Binding != nil {
+		out.WriteString(ts.Binding.String())
+		out.WriteString(" := ")
+	}
+	out.WriteString(ts.Subject.String())
+	out.WriteString(".(type) {\n")
+
+	for _, c := range ts.Cases {
+		out.WriteString(c.String())
+	}
+
+	out.WriteString("}")
+
+	return out.String()
+}
+
+// Pos, düğümün konumunu döndürür.
+func (ts *TypeSwitchStatement) Pos() token.Position {
+	return ts.Token.Position
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (ts *TypeSwitchStatement) End() token.Position {
+	return ts.Closing.Position
+}
+
+// Ellipsis, variadic bir parametrenin ...T biçimindeki tipini temsil eder.
+// Parametre, fonksiyon içinde T elemanlı bir slice'tır.
+// Örnek: ...int
+type Ellipsis struct {
+	Token   token.Token // token.ELLIPSIS token'ı
+	Element Expression
+}
+
+func (e *Ellipsis) expressionNode()      {}
+func (e *Ellipsis) TokenLiteral() string { return e.Token.Literal }
+func (e *Ellipsis) String() string       { return "..." + e.Element.String() }
+
+// Pos, düğümün konumunu döndürür.
+func (e *Ellipsis) Pos() token.Position {
+	return e.Token.Position
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (e *Ellipsis) End() token.Position {
+	return e.Element.End()
+}
+
+// FuncType, bir fonksiyon tipini temsil eder. Parametreler yalnızca tipleriyle
+// tutulur; tip ifadelerinde verilen parametre adları atılır.
+// Örnek: func(int, string) error
+type FuncType struct {
+	Token      token.Token // token.FUNC token'ı
+	Parameters []Expression
+	ReturnType Expression  // Opsiyonel dönüş tipi
+	Closing    token.Token // Parametre listesini kapatan ')' token'ı
+}
+
+func (ft *FuncType) expressionNode()      {}
+func (ft *FuncType) TokenLiteral() string { return ft.Token.Literal }
+func (ft *FuncType) String() string {
+	params := []string{}
+	for _, p := range ft.Parameters {
+		params = append(params, p.String())
+	}
+
+	out := "func(" + strings.Join(params, ", ") + ")"
+	if ft.ReturnType != nil {
+		out += " " + ft.ReturnType.String()
+	}
+	return out
+}
+
+// Pos, düğümün konumunu döndürür.
+func (ft *FuncType) Pos() token.Position {
+	return ft.Token.Position
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (ft *FuncType) End() token.Position {
+	if ft.ReturnType != nil {
+		return ft.ReturnType.End()
+	}
+	return ft.Closing.Position
+}
+
+// ChanDir, bir kanal tipinin yönüdür. Çift yönlü kanallarda her iki bit de
+// kümelidir.
+type ChanDir int
+
+const (
+	SEND ChanDir = 1 << iota // Yalnızca gönderilebilir (chan<- T)
+	RECV                     // Yalnızca alınabilir (<-chan T)
+)
+
+// ChanType, bir kanal tipini temsil eder.
+// Örnek: chan int, chan<- string, <-chan error
+type ChanType struct {
+	Token token.Token // token.CHAN veya '<-chan' için token.LARROW token'ı
+	Dir   ChanDir
+	Value Expression // Eleman tipi
+}
+
+func (ct *ChanType) expressionNode()      {}
+func (ct *ChanType) TokenLiteral() string { return ct.Token.Literal }
+func (ct *ChanType) String() string {
+	switch ct.Dir {
+	case SEND:
+		return "chan<- " + ct.Value.String()
+	case RECV:
+		return "<-chan " + ct.Value.String()
+	}
+	return "chan " + ct.Value.String()
+}
+
+// Pos, düğümün konumunu döndürür.
+func (ct *ChanType) Pos() token.Position {
+	return ct.Token.Position
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (ct *ChanType) End() token.Position {
+	return ct.Value.End()
+}
+
+// MapType, bir map tipini temsil eder.
+// Örnek: map[string]int
+type MapType struct {
+	Token token.Token // token.MAP token'ı
+	Key   Expression  // Anahtar tipi
+	Value Expression  // Değer tipi
+}
+
+func (mt *MapType) expressionNode()      {}
+func (mt *MapType) TokenLiteral() string { return mt.Token.Literal }
+func (mt *MapType) String() string {
+	return "map[" + mt.Key.String() + "]" + mt.Value.String()
+}
+
+// Pos, düğümün konumunu döndürür.
+func (mt *MapType) Pos() token.Position {
+	return mt.Token.Position
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (mt *MapType) End() token.Position {
+	return mt.Value.End()
+}
+
+// PointerType, bir işaretçi tipini temsil eder.
+// Örnek: *int, *Point
+type PointerType struct {
+	Token token.Token // '*' token'ı
+	Elem  Expression  // Gösterilen tip
+}
+
+func (pt *PointerType) expressionNode()      {}
+func (pt *PointerType) TokenLiteral() string { return pt.Token.Literal }
+func (pt *PointerType) String() string {
+	return "*" + pt.Elem.String()
+}
+
+// Pos, düğümün konumunu döndürür.
+func (pt *PointerType) Pos() token.Position {
+	return pt.Token.Position
+}
+
+// End, düğümün bitiş konumunu döndürür.
+func (pt *PointerType) End() token.Position {
+	return pt.Elem.End()
+}
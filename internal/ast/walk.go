@@ -0,0 +1,258 @@
+package ast
+
+// Inspect, AST'yi derinlik öncelikli olarak dolaşır. f önce düğümün
+// kendisiyle çağrılır; f true döndürürse düğümün çocukları sırayla dolaşılır.
+// nil düğümler atlanır.
+func Inspect(node Node, f func(Node) bool) {
+	if node == nil || !f(node) {
+		return
+	}
+
+	switch n := node.(type) {
+	case *Program:
+		inspectStatements(n.Statements, f)
+	case *BlockStatement:
+		inspectStatements(n.Statements, f)
+	case *VarStatement:
+		inspectIdentifier(n.Name, f)
+		Inspect(n.Type, f)
+		Inspect(n.Value, f)
+	case *ConstStatement:
+		inspectIdentifier(n.Name, f)
+		Inspect(n.Type, f)
+		Inspect(n.Value, f)
+	case *ReturnStatement:
+		inspectExpressions(n.ReturnValues, f)
+	case *ExpressionStatement:
+		Inspect(n.Expression, f)
+	case *PrefixExpression:
+		Inspect(n.Right, f)
+	case *InfixExpression:
+		Inspect(n.Left, f)
+		Inspect(n.Right, f)
+	case *AssignExpression:
+		Inspect(n.Left, f)
+		Inspect(n.Value, f)
+	case *MultiAssignStatement:
+		inspectExpressions(n.Left, f)
+		inspectExpressions(n.Values, f)
+	case *PostfixExpression:
+		Inspect(n.Left, f)
+	case *IfExpression:
+		Inspect(n.Condition, f)
+		inspectBlock(n.Consequence, f)
+		inspectBlock(n.Alternative, f)
+	case *FunctionLiteral:
+		inspectParameters(n.Parameters, f)
+		Inspect(n.ReturnType, f)
+		inspectBlock(n.Body, f)
+	case *CallExpression:
+		Inspect(n.Function, f)
+		inspectExpressions(n.Arguments, f)
+	case *ArrayLiteral:
+		inspectExpressions(n.Elements, f)
+	case *IndexExpression:
+		Inspect(n.Left, f)
+		Inspect(n.Index, f)
+	case *SliceExpression:
+		Inspect(n.Left, f)
+		Inspect(n.Low, f)
+		Inspect(n.High, f)
+		Inspect(n.Max, f)
+	case *ArrayType:
+		Inspect(n.Size, f)
+		Inspect(n.ElementType, f)
+	case *HashLiteral:
+		for key, val := range n.Pairs {
+			Inspect(key, f)
+			Inspect(val, f)
+		}
+	case *ForStatement:
+		Inspect(n.Init, f)
+		Inspect(n.Condition, f)
+		Inspect(n.Post, f)
+		inspectBlock(n.Body, f)
+	case *RangeStatement:
+		Inspect(n.Key, f)
+		Inspect(n.Value, f)
+		Inspect(n.Iterable, f)
+		inspectBlock(n.Body, f)
+	case *WhileStatement:
+		Inspect(n.Condition, f)
+		inspectBlock(n.Body, f)
+	case *SwitchStatement:
+		Inspect(n.Tag, f)
+		for _, c := range n.Cases {
+			Inspect(c, f)
+		}
+	case *CaseClause:
+		inspectExpressions(n.Values, f)
+		inspectStatements(n.Body, f)
+	case *BranchStatement:
+		inspectIdentifier(n.Label, f)
+	case *LabeledStatement:
+		inspectIdentifier(n.Label, f)
+		Inspect(n.Statement, f)
+	case *ClassStatement:
+		inspectIdentifier(n.Name, f)
+		inspectIdentifier(n.Extends, f)
+		for _, ident := range n.Implements {
+			inspectIdentifier(ident, f)
+		}
+		inspectBlock(n.Body, f)
+	case *MethodStatement:
+		inspectIdentifier(n.ReceiverName, f)
+		inspectIdentifier(n.Receiver, f)
+		inspectIdentifier(n.Name, f)
+		inspectParameters(n.Parameters, f)
+		Inspect(n.ReturnType, f)
+		inspectBlock(n.Body, f)
+	case *FunctionStatement:
+		inspectIdentifier(n.Name, f)
+		inspectParameters(n.Parameters, f)
+		Inspect(n.ReturnType, f)
+		inspectBlock(n.Body, f)
+	case *TryCatchStatement:
+		inspectBlock(n.Try, f)
+		for _, c := range n.Catches {
+			Inspect(c, f)
+		}
+		inspectBlock(n.Finally, f)
+	case *CatchClause:
+		inspectIdentifier(n.Parameter, f)
+		Inspect(n.Type, f)
+		inspectBlock(n.Body, f)
+	case *ThrowStatement:
+		Inspect(n.Value, f)
+	case *ScopeStatement:
+		inspectBlock(n.Body, f)
+	case *UnsafeStatement:
+		inspectBlock(n.Body, f)
+	case *DeferStatement:
+		if n.Call != nil {
+			Inspect(n.Call, f)
+		}
+	case *GoStatement:
+		if n.Call != nil {
+			Inspect(n.Call, f)
+		}
+	case *SendStatement:
+		Inspect(n.Channel, f)
+		Inspect(n.Value, f)
+	case *SelectStatement:
+		for _, c := range n.Cases {
+			Inspect(c, f)
+		}
+	case *CommClause:
+		Inspect(n.Comm, f)
+		inspectStatements(n.Body, f)
+	case *TemplateExpression:
+		for _, ident := range n.Parameters {
+			inspectIdentifier(ident, f)
+		}
+		Inspect(n.Body, f)
+	case *NewExpression:
+		Inspect(n.Class, f)
+		inspectExpressions(n.Arguments, f)
+	case *MemberExpression:
+		Inspect(n.Object, f)
+		Inspect(n.Member, f)
+	case *PackageStatement:
+		inspectIdentifier(n.Name, f)
+	case *ImportStatement:
+		if n.Path != nil {
+			Inspect(n.Path, f)
+		}
+	case *ResultList:
+		inspectParameters(n.Results, f)
+	case *TemplateStatement:
+		for _, ident := range n.TypeParameters {
+			inspectIdentifier(ident, f)
+		}
+		Inspect(n.Node, f)
+	case *TemplateInstantiation:
+		Inspect(n.Template, f)
+		inspectExpressions(n.TypeArguments, f)
+	case *TryExpression:
+		Inspect(n.Expression, f)
+	case *TypeStatement:
+		inspectIdentifier(n.Name, f)
+		Inspect(n.Type, f)
+	case *StructType:
+		for _, field := range n.Fields {
+			inspectIdentifier(field.Name, f)
+			Inspect(field.Type, f)
+			if field.Tag != nil {
+				Inspect(field.Tag, f)
+			}
+		}
+	case *CompositeLiteral:
+		Inspect(n.Type, f)
+		inspectExpressions(n.Elements, f)
+	case *KeyValueExpression:
+		Inspect(n.Key, f)
+		Inspect(n.Value, f)
+	case *InterfaceType:
+		for _, method := range n.Methods {
+			inspectIdentifier(method.Name, f)
+			inspectParameters(method.Parameters, f)
+			Inspect(method.ReturnType, f)
+		}
+		inspectExpressions(n.Embeds, f)
+	case *TypeAssertExpression:
+		Inspect(n.Expression, f)
+		Inspect(n.Type, f)
+	case *TypeSwitchStatement:
+		inspectIdentifier(n.Binding, f)
+		Inspect(n.Subject, f)
+		for _, c := range n.Cases {
+			Inspect(c, f)
+		}
+	case *Ellipsis:
+		Inspect(n.Element, f)
+	case *FuncType:
+		inspectExpressions(n.Parameters, f)
+		Inspect(n.ReturnType, f)
+	case *ChanType:
+		Inspect(n.Value, f)
+	case *MapType:
+		Inspect(n.Key, f)
+		Inspect(n.Value, f)
+	case *PointerType:
+		Inspect(n.Elem, f)
+	}
+}
+
+// inspectIdentifier, nil olmayan bir tanımlayıcıyı dolaşır.
+func inspectIdentifier(ident *Identifier, f func(Node) bool) {
+	if ident != nil {
+		Inspect(ident, f)
+	}
+}
+
+// inspectBlock, nil olmayan bir bloğu dolaşır.
+func inspectBlock(block *BlockStatement, f func(Node) bool) {
+	if block != nil {
+		Inspect(block, f)
+	}
+}
+
+// inspectParameters, parametrelerin adlarını ve tiplerini dolaşır.
+func inspectParameters(params []*Parameter, f func(Node) bool) {
+	for _, param := range params {
+		inspectIdentifier(param.Name, f)
+		Inspect(param.Type, f)
+	}
+}
+
+func inspectExpressions(exprs []Expression, f func(Node) bool) {
+	for _, expr := range exprs {
+		Inspect(expr, f)
+	}
+}
+
+func inspectStatements(stmts []Statement, f func(Node) bool) {
+	for _, stmt := range stmts {
+		Inspect(stmt, f)
+	}
+}
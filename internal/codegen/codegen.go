@@ -1,6 +1,7 @@
 package codegen
 
 import (
+	"embed"
 	"fmt"
 	"os"
 	"os/exec"
@@ -9,6 +10,17 @@ import (
 	"strings"
 )
 
+// runtimeSources, programlarla birlikte derlenen C runtime'ıdır: channel.c
+// kanalları ve select deyimini, goroutine.c goroutine zamanlayıcısını, map.c
+// map tipini, string.c string işlemlerini uygular.
+//
+//go:embed runtime/*.c
+var runtimeSources embed.FS
+
+// runtimeFilePrefix, runtime kaynaklarının derleme sırasında yazıldığı
+// dosyaların adlarının önekidir (gominus_channel.c).
+const runtimeFilePrefix = "gominus_"
+
 // OutputFormat, çıktı formatını belirtir.
 type OutputFormat int
 
@@ -244,6 +256,13 @@ func (cg *CodeGenerator) generateExecutable(inputFile, outputPath, targetTriple
 	args = append(args, inputFile)
 	args = append(args, "-o", outputPath)
 
+	// Runtime kaynakları program ile birlikte derlenir
+	runtimeFiles, err := cg.writeRuntime(filepath.Dir(inputFile))
+	if err != nil {
+		return err
+	}
+	args = append(args, runtimeFiles...)
+
 	// Target triple belirt (eğer varsa)
 	if targetTriple != "" {
 		args = append(args, "-target", targetTriple)
@@ -258,8 +277,8 @@ func (cg *CodeGenerator) generateExecutable(inputFile, outputPath, targetTriple
 		// Legacy stdio functions için
 		args = append(args, "-llegacy_stdio_definitions")
 	case Linux:
-		// Linux için C runtime library
-		args = append(args, "-lc")
+		// Linux için C runtime library; goroutine'ler POSIX iş parçacıklarında çalışır
+		args = append(args, "-pthread", "-lc")
 	case MacOS:
 		// macOS için system libraries
 		args = append(args, "-lSystem")
@@ -303,3 +322,32 @@ func (cg *CodeGenerator) generateExecutable(inputFile, outputPath, targetTriple
 
 	return nil
 }
+
+// runtimeSourceNames, hedef işletim sistemi için derlenecek runtime
+// kaynaklarının adlarını döndürür. Kanal ve goroutine runtime'ları POSIX iş
+// parçacıklarını kullandığından Windows'ta derlenmez.
+func (cg *CodeGenerator) runtimeSourceNames() []string {
+	if cg.targetOS == Windows {
+		return []string{"map.c", "string.c"}
+	}
+	return []string{"channel.c", "goroutine.c", "map.c", "string.c"}
+}
+
+// writeRuntime, runtime kaynaklarını dir dizinine yazar ve dosyaların
+// yollarını döndürür.
+func (cg *CodeGenerator) writeRuntime(dir string) ([]string, error) {
+	var files []string
+	for _, name := range cg.runtimeSourceNames() {
+		source, err := runtimeSources.ReadFile("runtime/" + name)
+		if err == nil {
+			file := filepath.Join(dir, runtimeFilePrefix+name)
+			err = os.WriteFile(file, source, 0644)
+			files = append(files, file)
+		}
+		if err != nil {
+			cg.ReportError("Runtime kaynağı %s yazılamadı: %v", name, err)
+			return nil, fmt.Errorf("runtime kaynağı %s yazılamadı: %v", name, err)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,369 @@
+// GO-Minus Kanal Runtime'ı
+// Bu dosya kanalları ve select deyimini uygular. Derleyici kanal işlemlerini
+// buradaki gominus_* fonksiyonlarına çağrı olarak üretir; dosya
+// çalıştırılabilir dosyalara programla birlikte derlenip bağlanır.
+//
+// Tüm kanallar tek bir kilitle korunur. Hazır olmayan bir işlem, işlemin
+// her kanalının bekleme kuyruğuna bir bekleyici ekler ve kendi koşul
+// değişkeninde uyur; karşı taraftaki işlem bekleyiciyi kuyruktan alır,
+// değeri doğrudan bekleyicinin belleğine (veya belleğinden) kopyalar ve
+// bekleyiciyi uyandırır. Tek bir gönderme veya alma işlemi tek case'li bir
+// select olarak yürütülür.
+
+#include <pthread.h>
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+#include <time.h>
+
+typedef struct gominus_chan gominus_chan;
+
+// Zamanlayıcı kancaları; goroutine.c'de tanımlıdır.
+void gominus_blockenter(void);
+void gominus_blockexit(void);
+
+// Select case türleri; derleyicinin ürettiği değerlerle aynı olmalıdır.
+enum {
+    CASE_SEND = 1,
+    CASE_RECV = 2,
+};
+
+// gominus_scase, select deyimindeki bir case'tir. Derleyici case'leri
+// { kanal, eleman, tür, ok } yapılarından oluşan bir dizi olarak verir.
+typedef struct {
+    gominus_chan *chan;
+    void *elem;   // Gönderilecek değerin veya alınan değerin yazılacağı adres
+    int32_t kind; // CASE_SEND veya CASE_RECV
+    int32_t ok;   // Alma: değer bir göndericiden mi geldi; gönderme: kanal açık mıydı
+} gominus_scase;
+
+// selstate, bloke olan bir select'in durumudur.
+typedef struct {
+    pthread_cond_t cond;
+    int32_t done; // Seçilen case'in indeksi; henüz seçilmediyse -1
+    int32_t ok;
+} selstate;
+
+// waiter, bir kanalın bekleme kuyruğundaki bir case'tir.
+typedef struct waiter {
+    struct waiter *next;
+    struct waiter *prev;
+    gominus_chan *chan;
+    selstate *sel;
+    void *elem;
+    int32_t index;
+    int32_t queued;
+} waiter;
+
+typedef struct {
+    waiter *first;
+    waiter *last;
+} waitq;
+
+struct gominus_chan {
+    int64_t elemsize;
+    int64_t cap;
+    int64_t count; // Tampondaki eleman sayısı
+    int64_t head;  // Tampondaki ilk elemanın indeksi
+    char *buf;
+    int32_t closed;
+    waitq sendq;
+    waitq recvq;
+};
+
+static pthread_mutex_t chanlock = PTHREAD_MUTEX_INITIALIZER;
+
+// Adil seçim için iş parçacığına özel xorshift üreteci.
+static __thread uint32_t randstate;
+
+static uint32_t fastrand(void) {
+    if (randstate == 0) {
+        randstate = (uint32_t)(uintptr_t)&randstate ^ (uint32_t)time(NULL) ^ 0x9e3779b9u;
+    }
+    randstate ^= randstate << 13;
+    randstate ^= randstate >> 17;
+    randstate ^= randstate << 5;
+    return randstate;
+}
+
+static void enqueue(waitq *q, waiter *w) {
+    w->next = NULL;
+    w->prev = q->last;
+    if (q->last != NULL) {
+        q->last->next = w;
+    } else {
+        q->first = w;
+    }
+    q->last = w;
+    w->queued = 1;
+}
+
+static void unlink_waiter(waitq *q, waiter *w) {
+    if (!w->queued) {
+        return;
+    }
+    if (w->prev != NULL) {
+        w->prev->next = w->next;
+    } else {
+        q->first = w->next;
+    }
+    if (w->next != NULL) {
+        w->next->prev = w->prev;
+    } else {
+        q->last = w->prev;
+    }
+    w->queued = 0;
+}
+
+// dequeue, kuyruktaki ilk bekleyen select'i döndürür. Başka bir case'i
+// seçilmiş select'lerin bekleyicileri atlanarak kuyruktan çıkarılır.
+static waiter *dequeue(waitq *q) {
+    while (q->first != NULL) {
+        waiter *w = q->first;
+        unlink_waiter(q, w);
+        if (w->sel->done < 0) {
+            return w;
+        }
+    }
+    return NULL;
+}
+
+// wake, bekleyicinin select'ini case'i seçilmiş olarak uyandırır.
+static void wake(waiter *w, int32_t ok) {
+    w->sel->done = w->index;
+    w->sel->ok = ok;
+    pthread_cond_signal(&w->sel->cond);
+}
+
+static void *slot(gominus_chan *c, int64_t i) {
+    return c->buf + ((c->head + i) % c->cap) * c->elemsize;
+}
+
+static void copy_elem(gominus_chan *c, void *dst, const void *src) {
+    if (dst != NULL && c->elemsize > 0) {
+        memcpy(dst, src, (size_t)c->elemsize);
+    }
+}
+
+static void zero_elem(gominus_chan *c, void *dst) {
+    if (dst != NULL && c->elemsize > 0) {
+        memset(dst, 0, (size_t)c->elemsize);
+    }
+}
+
+// trysend, kilit tutulurken gönderme işlemini bloke olmadan dener. İşlem
+// tamamlandıysa 1 döner; kanal kapalıysa *ok 0 yapılır.
+static int trysend(gominus_chan *c, void *elem, int32_t *ok) {
+    if (c->closed) {
+        *ok = 0;
+        return 1;
+    }
+    waiter *w = dequeue(&c->recvq);
+    if (w != NULL) {
+        copy_elem(c, w->elem, elem);
+        wake(w, 1);
+        *ok = 1;
+        return 1;
+    }
+    if (c->count < c->cap) {
+        copy_elem(c, slot(c, c->count), elem);
+        c->count++;
+        *ok = 1;
+        return 1;
+    }
+    return 0;
+}
+
+// tryrecv, kilit tutulurken alma işlemini bloke olmadan dener. İşlem
+// tamamlandıysa 1 döner; kanal kapalı ve boşsa değer sıfırlanır ve *ok 0
+// yapılır.
+static int tryrecv(gominus_chan *c, void *elem, int32_t *ok) {
+    waiter *w = dequeue(&c->sendq);
+    if (w != NULL) {
+        if (c->cap == 0) {
+            copy_elem(c, elem, w->elem);
+        } else {
+            // Tampon doludur: baştaki eleman alınır, göndericinin değeri
+            // boşalan yere yazılır
+            copy_elem(c, elem, slot(c, 0));
+            copy_elem(c, slot(c, 0), w->elem);
+            c->head = (c->head + 1) % c->cap;
+        }
+        wake(w, 1);
+        *ok = 1;
+        return 1;
+    }
+    if (c->count > 0) {
+        copy_elem(c, elem, slot(c, 0));
+        c->head = (c->head + 1) % c->cap;
+        c->count--;
+        *ok = 1;
+        return 1;
+    }
+    if (c->closed) {
+        zero_elem(c, elem);
+        *ok = 0;
+        return 1;
+    }
+    return 0;
+}
+
+// gominus_makechan, elemsize boyutunda elemanlar taşıyan ve cap kapasiteli
+// bir kanal oluşturur. Kapasite 0 ise kanal tamponlanmamıştır.
+gominus_chan *gominus_makechan(int64_t elemsize, int64_t cap) {
+    gominus_chan *c = calloc(1, sizeof(gominus_chan));
+    if (c == NULL) {
+        return NULL;
+    }
+    c->elemsize = elemsize;
+    c->cap = cap;
+    if (cap > 0 && elemsize > 0) {
+        c->buf = calloc((size_t)cap, (size_t)elemsize);
+        if (c->buf == NULL) {
+            free(c);
+            return NULL;
+        }
+    }
+    return c;
+}
+
+// gominus_select, case'lerden hazır olanlardan birini rastgele seçip
+// yürütür ve indeksini döndürür. Hiçbir case hazır değilse block 0 ise -1
+// döner, aksi halde bir case hazır olana kadar beklenir. nil kanallı
+// case'ler hiçbir zaman hazır olmaz.
+int32_t gominus_select(gominus_scase *cases, int32_t n, int32_t block) {
+    int32_t stackorder[8];
+    int32_t *order = stackorder;
+    if (n > 8) {
+        order = malloc(sizeof(int32_t) * (size_t)n);
+    }
+
+    // Fisher-Yates karıştırması ile case'lerin denenme sırası belirlenir
+    for (int32_t i = 0; i < n; i++) {
+        int32_t j = (int32_t)(fastrand() % (uint32_t)(i + 1));
+        order[i] = order[j];
+        order[j] = i;
+    }
+
+    pthread_mutex_lock(&chanlock);
+
+    int32_t chosen = -1;
+    for (int32_t i = 0; i < n && chosen < 0; i++) {
+        gominus_scase *sc = &cases[order[i]];
+        if (sc->chan == NULL) {
+            continue;
+        }
+        int ready = sc->kind == CASE_SEND ? trysend(sc->chan, sc->elem, &sc->ok)
+                                          : tryrecv(sc->chan, sc->elem, &sc->ok);
+        if (ready) {
+            chosen = order[i];
+        }
+    }
+
+    if (chosen < 0 && block) {
+        selstate sel;
+        pthread_cond_init(&sel.cond, NULL);
+        sel.done = -1;
+        sel.ok = 0;
+
+        waiter *waiters = calloc((size_t)(n > 0 ? n : 1), sizeof(waiter));
+        for (int32_t i = 0; i < n; i++) {
+            gominus_scase *sc = &cases[i];
+            if (sc->chan == NULL) {
+                continue;
+            }
+            waiters[i].chan = sc->chan;
+            waiters[i].sel = &sel;
+            waiters[i].elem = sc->elem;
+            waiters[i].index = i;
+            enqueue(sc->kind == CASE_SEND ? &sc->chan->sendq : &sc->chan->recvq, &waiters[i]);
+        }
+
+        // Case'i olmayan veya yalnızca nil kanallı bir select sonsuza dek bekler.
+        // Bekleyen işçi, zamanlayıcıya bloke olarak bildirilir.
+        gominus_blockenter();
+        while (sel.done < 0) {
+            pthread_cond_wait(&sel.cond, &chanlock);
+        }
+        gominus_blockexit();
+
+        for (int32_t i = 0; i < n; i++) {
+            gominus_scase *sc = &cases[i];
+            if (sc->chan != NULL) {
+                unlink_waiter(sc->kind == CASE_SEND ? &sc->chan->sendq : &sc->chan->recvq, &waiters[i]);
+            }
+        }
+        free(waiters);
+        pthread_cond_destroy(&sel.cond);
+
+        chosen = sel.done;
+        cases[chosen].ok = sel.ok;
+    }
+
+    pthread_mutex_unlock(&chanlock);
+
+    if (order != stackorder) {
+        free(order);
+    }
+    return chosen;
+}
+
+// gominus_chansend, elem adresindeki değeri kanala gönderir; gerekirse bir
+// alıcı veya tamponda yer olana kadar bekler. Kanal kapalıysa 0 döner.
+int32_t gominus_chansend(gominus_chan *c, void *elem) {
+    gominus_scase sc = {c, elem, CASE_SEND, 0};
+    gominus_select(&sc, 1, 1);
+    return sc.ok;
+}
+
+// gominus_chanrecv, kanaldan bir değer alıp elem adresine yazar; gerekirse
+// bir gönderici olana kadar bekler. Kanal kapalı ve boşsa sıfır değer
+// yazılır ve 0 döner.
+int32_t gominus_chanrecv(gominus_chan *c, void *elem) {
+    gominus_scase sc = {c, elem, CASE_RECV, 0};
+    gominus_select(&sc, 1, 1);
+    return sc.ok;
+}
+
+// gominus_chanclose, kanalı kapatır ve bekleyen tüm alıcı ve göndericileri
+// uyandırır. Kanal nil ise 1, zaten kapalıysa 2, aksi halde 0 döner.
+int32_t gominus_chanclose(gominus_chan *c) {
+    if (c == NULL) {
+        return 1;
+    }
+
+    pthread_mutex_lock(&chanlock);
+    if (c->closed) {
+        pthread_mutex_unlock(&chanlock);
+        return 2;
+    }
+    c->closed = 1;
+
+    waiter *w;
+    while ((w = dequeue(&c->recvq)) != NULL) {
+        zero_elem(c, w->elem);
+        wake(w, 0);
+    }
+    // Bekleyen göndericiler kapalı kanala gönderme nedeniyle panic başlatır
+    while ((w = dequeue(&c->sendq)) != NULL) {
+        wake(w, 0);
+    }
+    pthread_mutex_unlock(&chanlock);
+    return 0;
+}
+
+// gominus_chanlen, kanalın tamponundaki eleman sayısını döndürür.
+int64_t gominus_chanlen(gominus_chan *c) {
+    if (c == NULL) {
+        return 0;
+    }
+    pthread_mutex_lock(&chanlock);
+    int64_t n = c->count;
+    pthread_mutex_unlock(&chanlock);
+    return n;
+}
+
+// gominus_chancap, kanalın kapasitesini döndürür.
+int64_t gominus_chancap(gominus_chan *c) {
+    return c == NULL ? 0 : c->cap;
+}
@@ -0,0 +1,174 @@
+// GO-Minus Goroutine Runtime'ı
+// Bu dosya goroutine zamanlayıcısını uygular. Derleyici go deyimlerini
+// buradaki gominus_spawn çağrısına dönüştürür; dosya çalıştırılabilir
+// dosyalara programla birlikte derlenip bağlanır.
+//
+// Goroutine'ler tek bir çalışma kuyruğuna eklenir ve sabit sayıda işçi iş
+// parçacığı tarafından sırayla çalıştırılır. İşçiler ilk go deyiminde, en
+// fazla çevrimiçi işlemci sayısı kadar başlatılır ve kuyruk boşken koşul
+// değişkeninde uyur. Bir goroutine tamamlanana kadar onu çalıştıran işçiyi
+// bırakmaz; bu yüzden bir kanal işleminde bekleyen işçi bloke sayılır. Tüm
+// işçiler bloke olduğunda kuyruktaki goroutine'ler çalışabilsin diye geçici
+// bir işçi başlatılır; bloke işçiler uyandığında fazla işçiler eldeki
+// goroutine'i bitirip sonlanır ve havuz yeniden sabit boyutuna döner.
+// Gosched de kuyrukta bekleyen goroutine varken boşta işçi yoksa geçici bir
+// işçi başlatır; böylece Gosched ile dönen goroutine'ler kuyruğu aç bırakmaz.
+
+#include <pthread.h>
+#include <sched.h>
+#include <stdint.h>
+#include <stdlib.h>
+#include <unistd.h>
+
+// task, çalışma kuyruğundaki bir goroutine'dir. entry, derleyicinin ürettiği
+// gominus.goroutine giriş fonksiyonudur.
+typedef struct task {
+    struct task *next;
+    void *(*entry)(void *);
+    void *arg;
+} task;
+
+static pthread_mutex_t schedlock = PTHREAD_MUTEX_INITIALIZER;
+static pthread_cond_t schedcond = PTHREAD_COND_INITIALIZER;
+
+static task *runqhead;
+static task *runqtail;
+
+static int32_t maxworkers; // Havuzun sabit boyutu; ilk go deyiminde belirlenir
+static int32_t nworkers;   // Çalışan işçi sayısı (geçici işçiler dahil)
+static int32_t nidle;      // Kuyrukta iş bekleyen işçi sayısı
+static int32_t nblocked;   // Bir kanal işleminde bekleyen işçi sayısı
+static int32_t nstarting;  // Başlatılmış ama henüz kuyruğa bakmamış işçi sayısı
+
+// Yalnızca işçi iş parçacıklarında 1'dir; ana goroutine'in beklemesi
+// havuzu etkilemez.
+static __thread int32_t isworker;
+
+static void *worker(void *unused);
+
+// startworker, yeni bir ayrık işçi başlatır. schedlock tutulurken çağrılır.
+static int startworker(void) {
+    pthread_t thread;
+    if (pthread_create(&thread, NULL, worker, NULL) != 0) {
+        return 0;
+    }
+    pthread_detach(thread);
+    nworkers++;
+    nstarting++;
+    return 1;
+}
+
+// runnable, bloke olmayan işçi sayısını döndürür.
+static int32_t runnable(void) {
+    return nworkers - nblocked;
+}
+
+static void *worker(void *unused) {
+    (void)unused;
+    isworker = 1;
+
+    pthread_mutex_lock(&schedlock);
+    nstarting--;
+    for (;;) {
+        while (runqhead == NULL) {
+            nidle++;
+            pthread_cond_wait(&schedcond, &schedlock);
+            nidle--;
+        }
+        task *t = runqhead;
+        runqhead = t->next;
+        if (runqhead == NULL) {
+            runqtail = NULL;
+        }
+        pthread_mutex_unlock(&schedlock);
+
+        t->entry(t->arg);
+        free(t);
+
+        pthread_mutex_lock(&schedlock);
+        // Bloke işçiler için başlatılan fazla işçiler sonlanır
+        if (runnable() > maxworkers) {
+            nworkers--;
+            pthread_mutex_unlock(&schedlock);
+            return NULL;
+        }
+    }
+}
+
+// numcpu, çevrimiçi işlemci sayısını döndürür.
+static int32_t numcpu(void) {
+    long n = sysconf(_SC_NPROCESSORS_ONLN);
+    return n > 0 ? (int32_t)n : 1;
+}
+
+// gominus_spawn, entry(arg) çağrısını yeni bir goroutine olarak çalışma
+// kuyruğuna ekler. Boşta bir işçi varsa uyandırılır; yoksa havuz dolana
+// kadar veya tüm işçiler bloke ise yeni bir işçi başlatılır. Goroutine'i
+// çalıştıracak bir işçi yoksa ve başlatılamıyorsa 0 döner.
+int32_t gominus_spawn(void *(*entry)(void *), void *arg) {
+    task *t = malloc(sizeof(task));
+    if (t == NULL) {
+        return 0;
+    }
+    t->next = NULL;
+    t->entry = entry;
+    t->arg = arg;
+
+    pthread_mutex_lock(&schedlock);
+    if (maxworkers == 0) {
+        maxworkers = numcpu();
+    }
+    if (nidle == 0 && (nworkers < maxworkers || runnable() == 0) && !startworker() && runnable() == 0) {
+        pthread_mutex_unlock(&schedlock);
+        free(t);
+        return 0;
+    }
+    if (runqtail != NULL) {
+        runqtail->next = t;
+    } else {
+        runqhead = t;
+    }
+    runqtail = t;
+    pthread_cond_signal(&schedcond);
+    pthread_mutex_unlock(&schedlock);
+    return 1;
+}
+
+// gominus_blockenter, çağıran iş parçacığının bir kanal işleminde beklemeye
+// başladığını bildirir. Çalışabilecek işçi kalmadıysa kuyruktaki
+// goroutine'ler için yeni bir işçi başlatılır.
+void gominus_blockenter(void) {
+    if (!isworker) {
+        return;
+    }
+    pthread_mutex_lock(&schedlock);
+    nblocked++;
+    if (runqhead != NULL && nidle == 0 && runnable() == 0) {
+        startworker();
+    }
+    pthread_mutex_unlock(&schedlock);
+}
+
+// gominus_blockexit, beklemenin bittiğini bildirir.
+void gominus_blockexit(void) {
+    if (!isworker) {
+        return;
+    }
+    pthread_mutex_lock(&schedlock);
+    nblocked--;
+    pthread_mutex_unlock(&schedlock);
+}
+
+// gominus_gosched, işlemciyi diğer iş parçacıklarına bırakır. Kuyrukta
+// goroutine varken tüm işçiler meşgulse, çağıran işçi onları beklemeden
+// dönmeye devam edebileceğinden kuyruk için geçici bir işçi başlatılır.
+void gominus_gosched(void) {
+    if (isworker) {
+        pthread_mutex_lock(&schedlock);
+        if (runqhead != NULL && nidle == 0 && nstarting == 0) {
+            startworker();
+        }
+        pthread_mutex_unlock(&schedlock);
+    }
+    sched_yield();
+}
@@ -0,0 +1,305 @@
+// GO-Minus Map Runtime'ı
+// Bu dosya map tipini uygular. Derleyici map işlemlerini buradaki gominus_*
+// fonksiyonlarına çağrı olarak üretir; dosya çalıştırılabilir dosyalara
+// programla birlikte derlenip bağlanır.
+//
+// Girdiler eklenme sırasıyla yoğun bir dizide tutulur; açık adreslemeli bir
+// indeks tablosu anahtarın özetinden girdinin dizideki konumuna gider.
+// Silinen girdiler diziden çıkarılmaz, yalnızca ölü olarak işaretlenir ve
+// dizi büyütülürken sıkıştırılır. Anahtarlar derleyicinin her anahtar tipi
+// için ürettiği özet ve eşitlik fonksiyonlarıyla karşılaştırılır; böylece
+// string, tamsayı ve struct anahtarlar aynı kodla saklanır.
+//
+// Dolaşma sırasında map değişebilir: yeni girdiler dolaşmada görülebilir ya
+// da görülmeyebilir, henüz ulaşılmamış silinen girdiler görülmez. Her girdi
+// artan bir sıra numarası taşır; sıkıştırma sonrasında yineleyici kaldığı
+// yeri sıra numarasıyla yeniden bulur.
+
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+
+// Özet ve eşitlik fonksiyonları anahtarın adresini alır.
+typedef uint64_t (*gominus_hashfn)(void *key);
+typedef int32_t (*gominus_eqfn)(void *a, void *b);
+
+// entry, yoğun dizideki bir girdinin başlığıdır; başlığı anahtar ve değer
+// izler.
+typedef struct {
+    uint64_t hash;
+    uint64_t seq;  // Eklenme sıra numarası; dizide artan sıradadır
+    int64_t live;  // Girdi silindiyse 0
+} entry;
+
+typedef struct {
+    int64_t keysize;
+    int64_t valsize;
+    int64_t keyoff;
+    int64_t valoff;
+    int64_t entrysize;
+    gominus_hashfn hash;
+    gominus_eqfn eq;
+    int64_t count;    // Canlı girdi sayısı
+    int64_t used;     // Ölüler dahil dizideki girdi sayısı
+    int64_t capacity; // Dizinin girdi kapasitesi
+    char *entries;
+    int64_t *slots;   // Girdi indeksi + 1; boş yuvalar 0'dır
+    int64_t nslots;   // 2'nin kuvveti
+    uint64_t nextseq;
+    uint64_t epoch;   // Her sıkıştırmada artar
+} gominus_map;
+
+// gominus_mapiter, range döngüsünün yineleyicisidir. Derleyici yapıyı
+// { map, index, seq, epoch } olarak ayırır.
+typedef struct {
+    gominus_map *m;
+    int64_t index;  // Sıradaki girdinin indeksi
+    uint64_t seq;   // Son döndürülen girdinin sıra numarası
+    uint64_t epoch;
+} gominus_mapiter;
+
+static int64_t align8(int64_t n) {
+    return (n + 7) & ~(int64_t)7;
+}
+
+// mix, derleyicinin ürettiği özeti indeks tablosunda dağılması için karıştırır.
+static uint64_t mix(uint64_t h) {
+    h ^= h >> 30;
+    h *= 0xbf58476d1ce4e5b9ULL;
+    h ^= h >> 27;
+    h *= 0x94d049bb133111ebULL;
+    h ^= h >> 31;
+    return h;
+}
+
+static entry *entry_at(gominus_map *m, int64_t i) {
+    return (entry *)(m->entries + i * m->entrysize);
+}
+
+static void *entry_key(gominus_map *m, entry *e) {
+    return (char *)e + m->keyoff;
+}
+
+static void *entry_val(gominus_map *m, entry *e) {
+    return (char *)e + m->valoff;
+}
+
+static void *xmalloc(size_t size) {
+    void *p = calloc(1, size ? size : 1);
+    if (p == NULL) {
+        abort();
+    }
+    return p;
+}
+
+// rebuild_slots, indeks tablosunu dizideki canlı girdilerden yeniden kurar.
+static void rebuild_slots(gominus_map *m) {
+    int64_t nslots = 8;
+    while (nslots < m->capacity * 2) {
+        nslots *= 2;
+    }
+    free(m->slots);
+    m->slots = xmalloc(sizeof(int64_t) * (size_t)nslots);
+    m->nslots = nslots;
+
+    for (int64_t i = 0; i < m->used; i++) {
+        entry *e = entry_at(m, i);
+        if (!e->live) {
+            continue;
+        }
+        uint64_t s = e->hash & (uint64_t)(nslots - 1);
+        while (m->slots[s] != 0) {
+            s = (s + 1) & (uint64_t)(nslots - 1);
+        }
+        m->slots[s] = i + 1;
+    }
+}
+
+// grow, bir girdi daha eklenebilmesi için diziyi sıkıştırır ve gerekirse
+// kapasitesini iki katına çıkarır.
+static void grow(gominus_map *m) {
+    int64_t capacity = m->capacity;
+    if (m->count >= capacity / 2) {
+        capacity = capacity ? capacity * 2 : 8;
+    }
+
+    char *entries = xmalloc((size_t)(capacity * m->entrysize));
+    int64_t n = 0;
+    for (int64_t i = 0; i < m->used; i++) {
+        entry *e = entry_at(m, i);
+        if (e->live) {
+            memcpy(entries + n * m->entrysize, e, (size_t)m->entrysize);
+            n++;
+        }
+    }
+    if (n != m->used) {
+        m->epoch++;
+    }
+
+    free(m->entries);
+    m->entries = entries;
+    m->used = n;
+    m->capacity = capacity;
+    rebuild_slots(m);
+}
+
+// lookup, anahtarın indeks tablosundaki yuvasını döndürür. Anahtar yoksa
+// girdinin ekleneceği boş yuva döndürülür.
+static int64_t lookup(gominus_map *m, void *key, uint64_t hash) {
+    uint64_t s = hash & (uint64_t)(m->nslots - 1);
+    for (;;) {
+        int64_t slot = m->slots[s];
+        if (slot == 0) {
+            return (int64_t)s;
+        }
+        entry *e = entry_at(m, slot - 1);
+        if (e->live && e->hash == hash && m->eq(entry_key(m, e), key)) {
+            return (int64_t)s;
+        }
+        s = (s + 1) & (uint64_t)(m->nslots - 1);
+    }
+}
+
+// find, anahtarın canlı girdisini döndürür; anahtar yoksa NULL döner.
+static entry *find(gominus_map *m, void *key) {
+    if (m == NULL || m->count == 0) {
+        return NULL;
+    }
+    int64_t slot = m->slots[lookup(m, key, mix(m->hash(key)))];
+    return slot ? entry_at(m, slot - 1) : NULL;
+}
+
+// gominus_makemap, boş bir map oluşturur. hint, beklenen girdi sayısıdır.
+gominus_map *gominus_makemap(int64_t keysize, int64_t valsize, gominus_hashfn hash, gominus_eqfn eq, int64_t hint) {
+    gominus_map *m = xmalloc(sizeof(gominus_map));
+    m->keysize = keysize;
+    m->valsize = valsize;
+    m->keyoff = (int64_t)sizeof(entry);
+    m->valoff = m->keyoff + align8(keysize);
+    m->entrysize = m->valoff + align8(valsize);
+    m->hash = hash;
+    m->eq = eq;
+    m->nextseq = 1;
+
+    m->capacity = 8;
+    while (m->capacity < hint) {
+        m->capacity *= 2;
+    }
+    m->entries = xmalloc((size_t)(m->capacity * m->entrysize));
+    rebuild_slots(m);
+    return m;
+}
+
+// gominus_mapaccess, anahtarın değerinin adresini döndürür. Anahtar yoksa
+// veya map nil ise NULL döner.
+void *gominus_mapaccess(gominus_map *m, void *key) {
+    entry *e = find(m, key);
+    return e ? entry_val(m, e) : NULL;
+}
+
+// gominus_mapassign, anahtarın değerinin yazılacağı adresi döndürür. Anahtar
+// yoksa sıfır değerli yeni bir girdi eklenir. Map nil ise NULL döner;
+// derleyici bu durumda panic başlatır. Döndürülen adres map'e yapılan bir
+// sonraki eklemeye kadar geçerlidir.
+void *gominus_mapassign(gominus_map *m, void *key) {
+    if (m == NULL) {
+        return NULL;
+    }
+
+    uint64_t hash = mix(m->hash(key));
+    int64_t s = lookup(m, key, hash);
+    if (m->slots[s] != 0) {
+        return entry_val(m, entry_at(m, m->slots[s] - 1));
+    }
+
+    if (m->used == m->capacity) {
+        grow(m);
+        s = lookup(m, key, hash);
+    }
+
+    entry *e = entry_at(m, m->used);
+    memset(e, 0, (size_t)m->entrysize);
+    e->hash = hash;
+    e->seq = m->nextseq++;
+    e->live = 1;
+    memcpy(entry_key(m, e), key, (size_t)m->keysize);
+    m->slots[s] = m->used + 1;
+    m->used++;
+    m->count++;
+    return entry_val(m, e);
+}
+
+// gominus_mapdelete, anahtarın girdisini siler. Anahtar yoksa veya map nil
+// ise bir şey yapmaz.
+void gominus_mapdelete(gominus_map *m, void *key) {
+    entry *e = find(m, key);
+    if (e == NULL) {
+        return;
+    }
+    // Yuva, sondalama zincirini korumak için ölü girdiyi göstermeye devam eder
+    e->live = 0;
+    m->count--;
+}
+
+// gominus_maplen, map'teki girdi sayısını döndürür.
+int64_t gominus_maplen(gominus_map *m) {
+    return m ? m->count : 0;
+}
+
+// gominus_mapiterinit, map üzerinde dolaşmak için bir yineleyici hazırlar.
+void gominus_mapiterinit(gominus_map *m, gominus_mapiter *it) {
+    it->m = m;
+    it->index = 0;
+    it->seq = 0;
+    it->epoch = m ? m->epoch : 0;
+}
+
+// gominus_mapiternext, sıradaki canlı girdinin anahtar ve değer adreslerini
+// yazar ve 1 döndürür; girdi kalmadıysa 0 döner.
+int32_t gominus_mapiternext(gominus_mapiter *it, void **key, void **val) {
+    gominus_map *m = it->m;
+    if (m == NULL) {
+        return 0;
+    }
+
+    // Dizi sıkıştırıldıysa son döndürülen girdiden sonraki ilk girdi bulunur
+    if (it->epoch != m->epoch) {
+        int64_t lo = 0, hi = m->used;
+        while (lo < hi) {
+            int64_t mid = lo + (hi - lo) / 2;
+            if (entry_at(m, mid)->seq <= it->seq) {
+                lo = mid + 1;
+            } else {
+                hi = mid;
+            }
+        }
+        it->index = lo;
+        it->epoch = m->epoch;
+    }
+
+    while (it->index < m->used) {
+        entry *e = entry_at(m, it->index++);
+        if (e->live) {
+            it->seq = e->seq;
+            *key = entry_key(m, e);
+            *val = entry_val(m, e);
+            return 1;
+        }
+    }
+    return 0;
+}
+
+// gominus_strhash, string anahtarların özetini hesaplar (FNV-1a).
+uint64_t gominus_strhash(const char *s, int64_t len) {
+    uint64_t h = 0xcbf29ce484222325ULL;
+    for (int64_t i = 0; i < len; i++) {
+        h ^= (unsigned char)s[i];
+        h *= 0x100000001b3ULL;
+    }
+    return h;
+}
+
+// gominus_strequal, iki string anahtarı karşılaştırır.
+int32_t gominus_strequal(const char *a, int64_t alen, const char *b, int64_t blen) {
+    return alen == blen && (alen == 0 || memcmp(a, b, (size_t)alen) == 0);
+}
@@ -0,0 +1,286 @@
+// GO-Minus String Runtime'ı
+// Bu dosya string işlemlerini uygular. String'ler derleyicide { veri, uzunluk }
+// çifti olarak tutulur ve NUL ile sonlanmak zorunda değildir; dilimleme veriyi
+// kopyalamadan aynı belleği paylaşır. String'ler değişmez olduğundan bir
+// tamponun kullanılan kısmı hiçbir zaman yeniden yazılmaz.
+//
+// Birleştirme tüm parçaları tek seferde kopyalar; a + b + c ara sonuç
+// üretmez. Her iş parçacığı son birleştirmenin tamponunu hatırlar: sonu bu
+// tamponun kullanılan kısmının sonunda biten bir string'e yapılan ekleme
+// (s += x) tamponu yerinde uzatır, sığmazsa iki katı kapasiteli yeni bir
+// tampon ayrılır. Böylece döngüde büyüyen string'ler her adımda yeni bellek
+// ayırmaz.
+//
+// Çöp toplayıcı olmadığından değişkenlerde saklanan string'ler program
+// sonuna kadar yaşar. Derleyici yalnızca bir ifadenin içinde tüketilen geçici
+// string'leri (ör. fmt.Println(a + b) veya a + b == c) tüketildikten hemen
+// sonra gominus_releasestring ile serbest bırakır. Birleştirme, sonucun yeni
+// ayrılmış bir tampona mı yoksa başka bir string'in belleğine mi ait olduğunu
+// döndürür; yalnızca sonuca ait tamponlar serbest bırakılır.
+
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+
+typedef struct {
+    const char *data;
+    int64_t len;
+} gominus_string;
+
+// gominus_slice, derleyicinin slice başlığıdır: { veri, uzunluk, kapasite }.
+typedef struct {
+    void *data;
+    int32_t len;
+    int32_t cap;
+} gominus_slice;
+
+static _Thread_local char *lastbuf;
+static _Thread_local int64_t lastused;
+static _Thread_local int64_t lastcap;
+
+static void *xmalloc(size_t size) {
+    void *p = malloc(size ? size : 1);
+    if (p == NULL) {
+        abort();
+    }
+    return p;
+}
+
+// gominus_concatstrings, n parçayı birleştirip sonucu out'a yazar. Boş
+// olmayan tek bir parça varsa kopyalanmadan döndürülür. Sonuç yeni ayrılmış
+// bir tampondaysa 1, parçalardan birinin belleğini paylaşıyorsa 0 döner.
+int32_t gominus_concatstrings(gominus_string *out, gominus_string *parts, int64_t n) {
+    int64_t total = 0, nonempty = 0, last = 0;
+    for (int64_t i = 0; i < n; i++) {
+        if (parts[i].len > 0) {
+            total += parts[i].len;
+            nonempty++;
+            last = i;
+        }
+    }
+    if (nonempty == 0) {
+        out->data = NULL;
+        out->len = 0;
+        return 0;
+    }
+    if (nonempty == 1) {
+        *out = parts[last];
+        return 0;
+    }
+
+    // İlk parça son tamponun kullanılan kısmının sonunda bitiyorsa kalan
+    // parçalar tamponun boş kısmına yazılabilir
+    gominus_string first = parts[0];
+    int extends = lastbuf != NULL && first.len > 0 && first.data >= lastbuf &&
+                  first.data + first.len == lastbuf + lastused;
+    if (extends && lastused + (total - first.len) <= lastcap) {
+        char *p = lastbuf + lastused;
+        for (int64_t i = 1; i < n; i++) {
+            memcpy(p, parts[i].data, (size_t)parts[i].len);
+            p += parts[i].len;
+        }
+        lastused += total - first.len;
+        out->data = first.data;
+        out->len = total;
+        return 0;
+    }
+
+    // Yerinde uzatılamayan bir ekleme büyüyen bir string'e işaret eder;
+    // sonraki eklemeler için yer bırakılır
+    int64_t cap = extends ? total * 2 : total;
+    char *buf = xmalloc((size_t)cap);
+    char *p = buf;
+    for (int64_t i = 0; i < n; i++) {
+        if (parts[i].len > 0) {
+            memcpy(p, parts[i].data, (size_t)parts[i].len);
+            p += parts[i].len;
+        }
+    }
+    lastbuf = buf;
+    lastused = total;
+    lastcap = cap;
+    out->data = buf;
+    out->len = total;
+    return 1;
+}
+
+// gominus_releasestring, bir ifadede tüketilen geçici bir string'in
+// tamponunu serbest bırakır. owned 0 ise tampon başka bir string'e aittir ve
+// dokunulmaz. Tampon son birleştirmenin tamponuysa artık yerinde uzatılamaz.
+void gominus_releasestring(const char *data, int32_t owned) {
+    if (!owned) {
+        return;
+    }
+    if (data == lastbuf) {
+        lastbuf = NULL;
+        lastused = 0;
+        lastcap = 0;
+    }
+    free((void *)data);
+}
+
+// gominus_cmpstring, iki string'i bayt bayt karşılaştırır; a < b ise -1,
+// a == b ise 0, a > b ise 1 döner.
+int32_t gominus_cmpstring(const char *a, int64_t alen, const char *b, int64_t blen) {
+    int64_t n = alen < blen ? alen : blen;
+    if (n > 0 && a != b) {
+        int c = memcmp(a, b, (size_t)n);
+        if (c != 0) {
+            return c < 0 ? -1 : 1;
+        }
+    }
+    if (alen == blen) {
+        return 0;
+    }
+    return alen < blen ? -1 : 1;
+}
+
+// gominus_cstring, bir string'in NUL ile sonlanan kopyasını döndürür. C
+// fonksiyonlarına (ör. panic mesajları) string geçirmek için kullanılır.
+char *gominus_cstring(const char *s, int64_t len) {
+    char *buf = xmalloc((size_t)len + 1);
+    if (len > 0) {
+        memcpy(buf, s, (size_t)len);
+    }
+    buf[len] = '\0';
+    return buf;
+}
+
+// encoderune, bir rune'u UTF-8 olarak buf'a yazar ve yazılan bayt sayısını
+// döndürür. Geçersiz kod noktaları U+FFFD olarak yazılır.
+static int encoderune(char *buf, int64_t r) {
+    if (r < 0 || r > 0x10FFFF || (r >= 0xD800 && r <= 0xDFFF)) {
+        r = 0xFFFD;
+    }
+    if (r < 0x80) {
+        buf[0] = (char)r;
+        return 1;
+    }
+    if (r < 0x800) {
+        buf[0] = (char)(0xC0 | (r >> 6));
+        buf[1] = (char)(0x80 | (r & 0x3F));
+        return 2;
+    }
+    if (r < 0x10000) {
+        buf[0] = (char)(0xE0 | (r >> 12));
+        buf[1] = (char)(0x80 | ((r >> 6) & 0x3F));
+        buf[2] = (char)(0x80 | (r & 0x3F));
+        return 3;
+    }
+    buf[0] = (char)(0xF0 | (r >> 18));
+    buf[1] = (char)(0x80 | ((r >> 12) & 0x3F));
+    buf[2] = (char)(0x80 | ((r >> 6) & 0x3F));
+    buf[3] = (char)(0x80 | (r & 0x3F));
+    return 4;
+}
+
+// decoderune, s[pos:] başındaki rune'u çözer ve genişliğini size'a yazar.
+// Geçersiz veya eksik kodlamalar Go'da olduğu gibi {U+FFFD, 1} olarak çözülür.
+static int32_t decoderune(const unsigned char *s, int64_t len, int64_t pos, int *size) {
+    unsigned char b0 = s[pos];
+    *size = 1;
+    if (b0 < 0x80) {
+        return b0;
+    }
+
+    int width;
+    int32_t r;
+    if (b0 < 0xC2 || b0 > 0xF4) {
+        return 0xFFFD;
+    } else if (b0 < 0xE0) {
+        width = 2;
+        r = b0 & 0x1F;
+    } else if (b0 < 0xF0) {
+        width = 3;
+        r = b0 & 0x0F;
+    } else {
+        width = 4;
+        r = b0 & 0x07;
+    }
+    if (pos + width > len) {
+        return 0xFFFD;
+    }
+    for (int k = 1; k < width; k++) {
+        unsigned char b = s[pos + k];
+        if ((b & 0xC0) != 0x80) {
+            return 0xFFFD;
+        }
+        r = (r << 6) | (b & 0x3F);
+    }
+
+    // Fazla uzun kodlamalar, vekil kod noktaları ve U+10FFFF üstü geçersizdir
+    static const int32_t min[] = {0, 0, 0x80, 0x800, 0x10000};
+    if (r < min[width] || r > 0x10FFFF || (r >= 0xD800 && r <= 0xDFFF)) {
+        return 0xFFFD;
+    }
+    *size = width;
+    return r;
+}
+
+static gominus_slice *newslice(size_t elemsize, int64_t len) {
+    gominus_slice *s = xmalloc(sizeof(gominus_slice));
+    s->data = xmalloc(elemsize * (size_t)len);
+    s->len = (int32_t)len;
+    s->cap = (int32_t)len;
+    return s;
+}
+
+// gominus_intstring, string(r) dönüşümünü uygular: rune'un UTF-8 kodlaması.
+void gominus_intstring(gominus_string *out, int64_t r) {
+    char *buf = xmalloc(4);
+    out->len = encoderune(buf, r);
+    out->data = buf;
+}
+
+// gominus_slicebytetostring, string(b) dönüşümünü uygular; baytlar kopyalanır.
+void gominus_slicebytetostring(gominus_string *out, gominus_slice *b) {
+    int64_t len = b ? b->len : 0;
+    if (len == 0) {
+        out->data = NULL;
+        out->len = 0;
+        return;
+    }
+    char *buf = xmalloc((size_t)len);
+    memcpy(buf, b->data, (size_t)len);
+    out->data = buf;
+    out->len = len;
+}
+
+// gominus_stringtoslicebyte, []byte(s) dönüşümünü uygular; baytlar kopyalanır.
+gominus_slice *gominus_stringtoslicebyte(const char *s, int64_t len) {
+    gominus_slice *b = newslice(1, len);
+    if (len > 0) {
+        memcpy(b->data, s, (size_t)len);
+    }
+    return b;
+}
+
+// gominus_slicerunetostring, string(r) dönüşümünü []rune için uygular.
+void gominus_slicerunetostring(gominus_string *out, gominus_slice *r) {
+    int64_t n = r ? r->len : 0;
+    char *buf = xmalloc((size_t)n * 4);
+    int64_t len = 0;
+    for (int64_t i = 0; i < n; i++) {
+        len += encoderune(buf + len, ((int32_t *)r->data)[i]);
+    }
+    out->data = buf;
+    out->len = len;
+}
+
+// gominus_stringtoslicerune, []rune(s) dönüşümünü uygular.
+gominus_slice *gominus_stringtoslicerune(const char *s, int64_t len) {
+    const unsigned char *u = (const unsigned char *)s;
+    int64_t n = 0;
+    int size;
+    for (int64_t pos = 0; pos < len; pos += size) {
+        decoderune(u, len, pos, &size);
+        n++;
+    }
+
+    gominus_slice *r = newslice(sizeof(int32_t), n);
+    int64_t i = 0;
+    for (int64_t pos = 0; pos < len; pos += size) {
+        ((int32_t *)r->data)[i++] = decoderune(u, len, pos, &size);
+    }
+    return r;
+}
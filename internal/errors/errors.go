@@ -57,25 +57,21 @@ type CompilerError struct {
 }
 
 // Error implements the error interface.
+// Errors with position information are formatted as "file.gom:12:5: Type: message".
 func (e *CompilerError) Error() string {
-	var parts []string
-
-	// Add error type
-	parts = append(parts, e.Type.String())
+	var result string
 
 	// Add position information if available
-	if e.Position.IsValid() {
-		if e.File != "" {
-			parts = append(parts, fmt.Sprintf("at %s:%d:%d", e.File, e.Position.Line, e.Position.Column))
-		} else {
-			parts = append(parts, fmt.Sprintf("at line %d, column %d", e.Position.Line, e.Position.Column))
-		}
-	} else if e.File != "" {
-		parts = append(parts, fmt.Sprintf("in %s", e.File))
+	pos := e.Position
+	if pos.Filename == "" {
+		pos.Filename = e.File
+	}
+	if pos.IsValid() || pos.Filename != "" {
+		result = pos.String() + ": "
 	}
 
-	// Add main message
-	result := strings.Join(parts, " ") + ": " + e.Message
+	// Add error type and main message
+	result += e.Type.String() + ": " + e.Message
 
 	// Add hint if available
 	if e.Hint != "" {
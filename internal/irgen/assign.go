@@ -0,0 +1,96 @@
+package irgen
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// generateAddress, atanabilir bir ifadenin (değişken, a[i], p.x) adresini ve
+// adresin gösterdiği tipi üretir. Alt ifadeler yalnızca bir kez değerlendirilir.
+func (g *IRGenerator) generateAddress(expr ast.Expression) (value.Value, types.Type) {
+	switch e := expr.(type) {
+	case *ast.Identifier:
+		if _, exists := g.symbolTable[e.Value]; !exists {
+			g.ReportError("Tanımlanmamış tanımlayıcı: %s", e.Value)
+			return nil, nil
+		}
+		if addr, elemType := g.variableAddress(e.Value); addr != nil {
+			return addr, elemType
+		}
+		g.ReportError("Atama yapılamaz: %s bir değişken değil", e.Value)
+		return nil, nil
+	case *ast.IndexExpression:
+		return g.generateIndexAddress(e)
+	case *ast.MemberExpression:
+		fieldPtr, fieldType, _ := g.generateMemberAccess(e)
+		if fieldPtr == nil {
+			g.ReportError("Atama yapılamaz: %s bir alan değil", e.String())
+			return nil, nil
+		}
+		return fieldPtr, fieldType
+	case *ast.PrefixExpression:
+		if e.Operator == "*" {
+			return g.dereferenceAddress(e)
+		}
+		g.ReportError("Atama operatörünün sol tarafı bir değişken olmalıdır")
+		return nil, nil
+	default:
+		g.ReportError("Atama operatörünün sol tarafı bir değişken olmalıdır")
+		return nil, nil
+	}
+}
+
+// generateAssignExpression, bir atama ifadesi için IR üretir. Bileşik atamalar
+// (x op= y) load/işlem/store olarak üretilir; sol tarafın adresi yalnızca bir
+// kez hesaplanır, böylece a[f()] += 1 ifadesinde f bir kez çağrılır.
+func (g *IRGenerator) generateAssignExpression(expr *ast.AssignExpression) value.Value {
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, atama ifadesi değerlendirilemiyor")
+		return nil
+	}
+
+	var addr value.Value
+	var elemType types.Type
+	if index, ok := expr.Left.(*ast.IndexExpression); ok {
+		container := g.generateIndexContainer(index)
+		if container == nil {
+			return nil
+		}
+		if info := g.mapInfoOf(container.Type()); info != nil {
+			return g.generateMapAssign(expr, index, info, container)
+		}
+		addr, elemType = g.indexAddress(container, index)
+	} else {
+		addr, elemType = g.generateAddress(expr.Left)
+	}
+	if addr == nil {
+		return nil
+	}
+
+	val := g.generateExpression(expr.Value)
+	if val == nil {
+		return nil
+	}
+
+	// Tamsayı değerler hedefin genişliğine (veya ondalık tipine) uyarlanır
+	val = g.convertAssignedValue(val, elemType, g.isUnsignedExpr(expr.Value))
+
+	if operator := expr.BinaryOperator(); operator != "" {
+		current := g.currentBB.NewLoad(elemType, addr)
+		operation := &ast.InfixExpression{
+			Token:    expr.Token,
+			Left:     expr.Left,
+			Operator: operator,
+			Right:    expr.Value,
+		}
+		val = g.generateBinaryOperation(operation, current, val)
+		if val == nil {
+			return nil
+		}
+	}
+
+	g.currentBB.NewStore(val, addr)
+	return val
+}
@@ -0,0 +1,166 @@
+package irgen
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// isUnsignedTypeName, verilen tip adının işaretsiz bir tamsayı tipi olup olmadığını kontrol eder.
+func isUnsignedTypeName(name string) bool {
+	switch name {
+	case "uint", "uint8", "uint16", "uint32", "uint64", "uintptr", "byte":
+		return true
+	}
+	return false
+}
+
+// isUnsignedExpr, bir tamsayı ifadesinin işaretsiz tipte olup olmadığını belirler.
+// LLVM tamsayı tipleri işaret bilgisi taşımadığından bilgi, değişken
+// bildirimlerinde kaydedilen tip adlarından çıkarılır.
+func (g *IRGenerator) isUnsignedExpr(expr ast.Expression) bool {
+	switch e := expr.(type) {
+	case *ast.Identifier:
+		return g.unsignedVars[e.Value]
+	case *ast.PrefixExpression:
+		return e.Operator != "!" && g.isUnsignedExpr(e.Right)
+	case *ast.InfixExpression:
+		switch e.Operator {
+		case "<<", ">>":
+			// Kaydırmanın sonucu kaydırılan değerin tipindedir
+			return g.isUnsignedExpr(e.Left)
+		case "+", "-", "*", "/", "%", "&", "|", "^", "&^":
+			return g.isUnsignedExpr(e.Left) || g.isUnsignedExpr(e.Right)
+		}
+	case *ast.IndexExpression:
+		// String'lerin baytları işaretsizdir
+		return g.isStringType(g.getExpressionType(e.Left))
+	}
+	return false
+}
+
+// convertIntWidth, bir tamsayı değerini verilen genişlikteki tamsayı tipine dönüştürür.
+// Genişletme işaretsiz değerler için sıfırla, işaretli değerler için işaretle yapılır.
+func (g *IRGenerator) convertIntWidth(val value.Value, to *types.IntType, unsigned bool) value.Value {
+	from := val.Type().(*types.IntType)
+	if from.BitSize == to.BitSize {
+		return val
+	}
+	if c, ok := val.(*constant.Int); ok {
+		return constant.NewInt(to, c.X.Int64())
+	}
+	if from.BitSize > to.BitSize {
+		return g.currentBB.NewTrunc(val, to)
+	}
+	if unsigned {
+		return g.currentBB.NewZExt(val, to)
+	}
+	return g.currentBB.NewSExt(val, to)
+}
+
+// generateBitwiseExpression, &, |, ^, &^, << ve >> operatörleri için IR üretir.
+func (g *IRGenerator) generateBitwiseExpression(expr *ast.InfixExpression, left, right value.Value) value.Value {
+	leftType, okLeft := left.Type().(*types.IntType)
+	rightType, okRight := right.Type().(*types.IntType)
+	if !okLeft || !okRight || leftType.BitSize == 1 || rightType.BitSize == 1 {
+		g.ReportError("%s operatörü sadece tamsayı tiplerinde kullanılabilir", expr.Operator)
+		return nil
+	}
+
+	unsigned := g.isUnsignedExpr(expr)
+
+	switch expr.Operator {
+	case "<<", ">>":
+		count := g.shiftCount(right, !g.isUnsignedExpr(expr.Right))
+		return g.generateShift(expr.Operator, left, count, leftType, unsigned)
+	}
+
+	// Tipsiz sabitler diğer işlenenin tipine uyarlanır
+	if _, isConst := left.(*constant.Int); isConst {
+		left = g.convertIntWidth(left, rightType, unsigned)
+	} else {
+		right = g.convertIntWidth(right, leftType, unsigned)
+	}
+
+	switch expr.Operator {
+	case "&":
+		return g.currentBB.NewAnd(left, right)
+	case "|":
+		return g.currentBB.NewOr(left, right)
+	case "^":
+		return g.currentBB.NewXor(left, right)
+	case "&^":
+		// x &^ y == x & ^y
+		mask := g.currentBB.NewXor(right, constant.NewInt(right.Type().(*types.IntType), -1))
+		return g.currentBB.NewAnd(left, mask)
+	}
+
+	g.ReportError("Desteklenmeyen bit operatörü: %s", expr.Operator)
+	return nil
+}
+
+// shiftCount, kaydırma sayısını tam genişliğiyle karşılaştırılabilmesi için
+// i64'e genişletir. İşaretli sayılar negatifse program panic ile sonlanır.
+func (g *IRGenerator) shiftCount(count value.Value, signed bool) value.Value {
+	if c, ok := count.(*constant.Int); ok {
+		if c.X.Sign() >= 0 || !signed {
+			return g.convertIntWidth(count, types.I64, !signed)
+		}
+	}
+	count = g.convertIntWidth(count, types.I64, !signed)
+	if signed {
+		negative := g.currentBB.NewICmp(enum.IPredSLT, count, constant.NewInt(types.I64, 0))
+		g.generatePanicIf(negative, "shift", "runtime error: negative shift amount")
+	}
+	return count
+}
+
+// generateShift, i64'e genişletilmiş bir kaydırma sayısıyla kaydırma işlemi
+// için IR üretir. Sağa kaydırma işaretli değerlerde aritmetik (ashr),
+// işaretsiz değerlerde mantıksal (lshr) yapılır. LLVM'de bit genişliğinden
+// büyük kaydırmalar tanımsız olduğundan, Go semantiğine uygun olarak sonuç 0
+// (işaretli sağa kaydırmada işaret biti) olur. Sayı bit genişliğiyle
+// daraltılmadan önce karşılaştırılır; böylece 1<<32 + 1 gibi sayılar 1'e
+// dönüşmez.
+func (g *IRGenerator) generateShift(operator string, left, count value.Value, typ *types.IntType, unsigned bool) value.Value {
+	signedRight := operator == ">>" && !unsigned
+	if c, ok := count.(*constant.Int); ok {
+		// Sabit kaydırma sayıları için kontrol gerekmez
+		if c.X.IsUint64() && c.X.Uint64() < typ.BitSize {
+			count = constant.NewInt(typ, c.X.Int64())
+		} else if signedRight {
+			count = constant.NewInt(typ, int64(typ.BitSize-1))
+		} else {
+			return constant.NewInt(typ, 0)
+		}
+		if signedRight {
+			return g.currentBB.NewAShr(left, count)
+		}
+		if operator == ">>" {
+			return g.currentBB.NewLShr(left, count)
+		}
+		return g.currentBB.NewShl(left, count)
+	}
+
+	inRange := g.currentBB.NewICmp(enum.IPredULT, count, constant.NewInt(types.I64, int64(typ.BitSize)))
+	narrow := g.convertIntWidth(count, typ, true)
+
+	switch {
+	case signedRight:
+		// Kaydırma sayısını bit genişliği - 1 ile sınırla
+		narrow = g.currentBB.NewSelect(inRange, narrow, constant.NewInt(typ, int64(typ.BitSize-1)))
+		return g.currentBB.NewAShr(left, narrow)
+	case operator == ">>":
+		return g.guardShift(g.currentBB.NewLShr(left, narrow), inRange, typ)
+	default:
+		return g.guardShift(g.currentBB.NewShl(left, narrow), inRange, typ)
+	}
+}
+
+// guardShift, kaydırma sayısı bit genişliğine eşit veya büyükse sonucu 0 yapar.
+func (g *IRGenerator) guardShift(shifted, inRange value.Value, typ *types.IntType) value.Value {
+	return g.currentBB.NewSelect(inRange, shifted, constant.NewInt(typ, 0))
+}
@@ -0,0 +1,156 @@
+package irgen
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+
+	"github.com/llir/llvm/ir"
+)
+
+// branchTarget, break/continue ifadelerinin dallanabileceği bir döngü veya
+// switch ifadesinin bloklarını tutar.
+type branchTarget struct {
+	label         string    // Hedefin etiketi (etiketsizse boş)
+	breakBlock    *ir.Block // break'in gideceği blok
+	continueBlock *ir.Block // continue'nun gideceği blok (switch için nil)
+	tryDepth      int       // Hedefin içinde bulunduğu try bloklarının sayısı
+}
+
+// pushBranchTarget, üretilmekte olan döngü veya switch için bir hedef ekler.
+// Bekleyen bir etiket varsa hedefe atanır ve tüketilir.
+func (g *IRGenerator) pushBranchTarget(breakBlock, continueBlock *ir.Block) {
+	g.branchTargets = append(g.branchTargets, &branchTarget{
+		label:         g.pendingLabel,
+		breakBlock:    breakBlock,
+		continueBlock: continueBlock,
+		tryDepth:      len(g.exceptionStack),
+	})
+	g.pendingLabel = ""
+}
+
+// popBranchTarget, en içteki hedefi kaldırır.
+func (g *IRGenerator) popBranchTarget() {
+	g.branchTargets = g.branchTargets[:len(g.branchTargets)-1]
+}
+
+// findBranchTarget, içten dışa doğru verilen etikete (boşsa en içtekine) uyan
+// hedefi arar. isContinue ise yalnızca döngüler dikkate alınır.
+func (g *IRGenerator) findBranchTarget(label string, isContinue bool) *branchTarget {
+	for i := len(g.branchTargets) - 1; i >= 0; i-- {
+		target := g.branchTargets[i]
+		if isContinue && target.continueBlock == nil {
+			continue
+		}
+		if label == "" || target.label == label {
+			return target
+		}
+	}
+	return nil
+}
+
+// labelBlock, geçerli fonksiyondaki bir etiketin bloğunu döndürür. İleriye
+// dönük goto'lar için blok, etiket üretilmeden önce oluşturulabilir.
+func (g *IRGenerator) labelBlock(name string) *ir.Block {
+	blocks, exists := g.labelBlocks[g.currentFunc]
+	if !exists {
+		blocks = make(map[string]*ir.Block)
+		g.labelBlocks[g.currentFunc] = blocks
+	}
+
+	block, exists := blocks[name]
+	if !exists {
+		block = g.currentFunc.NewBlock("label." + name)
+		blocks[name] = block
+	}
+	return block
+}
+
+// definedLabels, bir fonksiyon gövdesinde tanımlanan etiketleri döndürür.
+// İç içe fonksiyon değişmezlerinin etiketleri kendi gövdelerine aittir.
+func definedLabels(body *ast.BlockStatement) map[string]bool {
+	labels := make(map[string]bool)
+	if body == nil {
+		return labels
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch s := n.(type) {
+		case *ast.FunctionLiteral:
+			return false
+		case *ast.LabeledStatement:
+			labels[s.Label.Value] = true
+		}
+		return true
+	})
+	return labels
+}
+
+// generateBranchStatement, bir break, continue veya goto ifadesi için IR üretir.
+// Dallanmadan sonra gelen (erişilemeyen) ifadeler yeni bir bloğa üretilir;
+// böylece bu ifadeler arasındaki etiketlere goto ile hâlâ ulaşılabilir.
+func (g *IRGenerator) generateBranchStatement(stmt *ast.BranchStatement) {
+	if g.currentFunc == nil || g.currentBB == nil {
+		g.ReportError("Geçerli bir fonksiyon yok, %s ifadesi değerlendirilemiyor", stmt.Token.Literal)
+		return
+	}
+
+	label := ""
+	if stmt.Label != nil {
+		label = stmt.Label.Value
+	}
+
+	var dest *ir.Block
+	switch stmt.Token.Type {
+	case token.GOTO:
+		// İleriye dönük blok yalnızca fonksiyonda tanımlı etiketler için
+		// oluşturulur; aksi halde blok hiç sonlandırılmazdı
+		if !g.functionLabels[label] {
+			g.ReportError("goto %s: tanımsız etiket", label)
+			return
+		}
+		dest = g.labelBlock(label)
+	case token.BREAK:
+		if target := g.findBranchTarget(label, false); target != nil {
+			g.leaveTryBlocks(target.tryDepth)
+			dest = target.breakBlock
+		}
+	case token.CONTINUE:
+		if target := g.findBranchTarget(label, true); target != nil {
+			g.leaveTryBlocks(target.tryDepth)
+			dest = target.continueBlock
+		}
+	}
+
+	if dest == nil {
+		g.ReportError("%s ifadesi için geçerli bir hedef yok", stmt.String())
+		return
+	}
+
+	g.currentBB.NewBr(dest)
+	g.currentBB = g.currentFunc.NewBlock("")
+}
+
+// generateLabeledStatement, etiketli bir ifade için IR üretir. Etiket kendi
+// bloğunu başlatır; etiketlenen döngü veya switch etiketi hedef olarak alır.
+func (g *IRGenerator) generateLabeledStatement(stmt *ast.LabeledStatement) {
+	if g.currentFunc == nil || g.currentBB == nil {
+		g.ReportError("Geçerli bir fonksiyon yok, %s etiketi değerlendirilemiyor", stmt.Label.Value)
+		return
+	}
+
+	block := g.labelBlock(stmt.Label.Value)
+	if g.currentBB.Term == nil {
+		g.currentBB.NewBr(block)
+	}
+	g.currentBB = block
+
+	if stmt.Statement == nil {
+		return
+	}
+
+	switch stmt.Statement.(type) {
+	case *ast.ForStatement, *ast.RangeStatement, *ast.WhileStatement, *ast.SwitchStatement:
+		g.pendingLabel = stmt.Label.Value
+	}
+	g.generateStatement(stmt.Statement)
+	g.pendingLabel = ""
+}
@@ -0,0 +1,469 @@
+package irgen
+
+import (
+	"fmt"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// Kanallar, programla birlikte bağlanan C runtime'ında (codegen/runtime/channel.c)
+// uygulanır. Bir kanal değeri runtime'ın kanal yapısını gösteren bir
+// işaretçidir; sıfır değeri nil kanaldır. Her eleman tipi için ayrı bir opak
+// tip (gominus.chan.N) tanımlanır; böylece kanal değerinin tipi eleman tipini
+// taşır. Değerler runtime'a kopyalanacakları veya runtime'ın yazacağı bellek
+// adresleriyle verilir.
+
+// chanInfo, bir kanal tipini ve eleman tipini tutar.
+type chanInfo struct {
+	Type *types.PointerType
+	Elem types.Type
+}
+
+// Select case türleri; runtime'daki CASE_SEND ve CASE_RECV ile aynıdır.
+const (
+	caseSend = 1
+	caseRecv = 2
+)
+
+// scaseType, runtime'a verilen bir select case'idir: { kanal, eleman, tür, ok }.
+var scaseType = types.NewStruct(bytePtr, bytePtr, types.I32, types.I32)
+
+// chanType, verilen eleman tipindeki kanalların tipini döndürür.
+func (g *IRGenerator) chanType(elem types.Type) *types.PointerType {
+	for _, info := range g.chanTypes {
+		if info.Elem.Equal(elem) {
+			return info.Type
+		}
+	}
+
+	opaque := types.NewStruct()
+	opaque.Opaque = true
+	g.module.NewTypeDef(fmt.Sprintf("gominus.chan.%d", len(g.chanTypes)), opaque)
+	info := &chanInfo{Type: types.NewPointer(opaque), Elem: elem}
+	g.chanTypes = append(g.chanTypes, info)
+	return info.Type
+}
+
+// chanElemType, t bir kanal tipiyse eleman tipini, değilse nil döndürür.
+func (g *IRGenerator) chanElemType(t types.Type) types.Type {
+	ptr, ok := t.(*types.PointerType)
+	if !ok {
+		return nil
+	}
+	name := ptr.ElemType.Name()
+	for _, info := range g.chanTypes {
+		if name != "" && info.Type.ElemType.Name() == name {
+			return info.Elem
+		}
+	}
+	return nil
+}
+
+// chanRuntimeFunc, runtime'ın kanal fonksiyonlarından birini bildirir.
+func (g *IRGenerator) chanRuntimeFunc(name string) *ir.Func {
+	switch name {
+	case "gominus_makechan":
+		return g.getExternalFunction(name, bytePtr, ir.NewParam("elemsize", types.I64), ir.NewParam("cap", types.I64))
+	case "gominus_chansend", "gominus_chanrecv":
+		return g.getExternalFunction(name, types.I32, ir.NewParam("c", bytePtr), ir.NewParam("elem", bytePtr))
+	case "gominus_chanclose":
+		return g.getExternalFunction(name, types.I32, ir.NewParam("c", bytePtr))
+	case "gominus_chanlen", "gominus_chancap":
+		return g.getExternalFunction(name, types.I64, ir.NewParam("c", bytePtr))
+	case "gominus_select":
+		return g.getExternalFunction(name, types.I32, ir.NewParam("cases", types.NewPointer(scaseType)),
+			ir.NewParam("n", types.I32), ir.NewParam("block", types.I32))
+	}
+	panic("bilinmeyen kanal runtime fonksiyonu: " + name)
+}
+
+// generateMakeChan, make(chan T, kapasite) için IR üretir. Negatif bir
+// kapasite panic başlatır.
+func (g *IRGenerator) generateMakeChan(t *ast.ChanType, args []ast.Expression) value.Value {
+	if len(args) > 2 {
+		g.ReportError("make() kanal için en fazla 2 argüman alır, %d verildi", len(args))
+		return nil
+	}
+	chanType, ok := g.resolveType(t).(*types.PointerType)
+	if !ok {
+		return nil
+	}
+
+	var capacity value.Value = constant.NewInt(types.I64, 0)
+	if len(args) == 2 {
+		val := g.generateExpression(args[1])
+		if val == nil {
+			return nil
+		}
+		if _, isInt := val.Type().(*types.IntType); !isInt {
+			g.ReportError("Kanal kapasitesi tamsayı olmalıdır")
+			return nil
+		}
+		capacity = g.convertIntWidth(val, types.I64, g.isUnsignedExpr(args[1]))
+		negative := g.currentBB.NewICmp(enum.IPredSLT, capacity, constant.NewInt(types.I64, 0))
+		g.generatePanicIf(negative, "makechan", "makechan: size out of range")
+	}
+
+	ch := g.currentBB.NewCall(g.chanRuntimeFunc("gominus_makechan"), sizeOf(g.chanElemType(chanType)), capacity)
+	return g.currentBB.NewBitCast(ch, chanType)
+}
+
+// channelOperand, kanal işlemlerinde kullanılan kanalın eleman tipini
+// döndürür; değer bir kanal değilse hata raporlanır ve nil döner.
+func (g *IRGenerator) channelOperand(ch value.Value, op string) types.Type {
+	elem := g.chanElemType(ch.Type())
+	if elem == nil {
+		g.ReportError("%s işlemi bir kanal gerektirir: %s", op, ch.Type())
+	}
+	return elem
+}
+
+// generateReceive, <-ch için IR üretir ve alınan değerle birlikte değerin
+// bir göndericiden gelip gelmediğini (kanal kapalıysa false) döndürür.
+func (g *IRGenerator) generateReceive(ch value.Value) (value.Value, value.Value) {
+	elem := g.channelOperand(ch, "Alma")
+	if elem == nil {
+		return nil, nil
+	}
+
+	g.labelCounter++
+	slot := g.entryAlloca(elem, fmt.Sprintf("recv.%d", g.labelCounter))
+	ok := g.currentBB.NewCall(g.chanRuntimeFunc("gominus_chanrecv"),
+		g.currentBB.NewBitCast(ch, bytePtr), g.currentBB.NewBitCast(slot, bytePtr))
+	val := g.currentBB.NewLoad(elem, slot)
+	return val, g.currentBB.NewICmp(enum.IPredNE, ok, constant.NewInt(types.I32, 0))
+}
+
+// isReceiveExpression, bir ifadenin kanaldan alma işlemi (<-ch) olup
+// olmadığını belirler.
+func isReceiveExpression(expr ast.Expression) bool {
+	prefix, ok := expr.(*ast.PrefixExpression)
+	return ok && prefix.Operator == "<-"
+}
+
+// receiveOkValues, v, ok := <-ch biçimindeki atamalar için alınan değeri ve
+// başarıyı döndürür. İfade bir alma işlemi değilse nil döner.
+func (g *IRGenerator) receiveOkValues(expr ast.Expression) []value.Value {
+	if !isReceiveExpression(expr) {
+		return nil
+	}
+	ch := g.generateExpression(expr.(*ast.PrefixExpression).Right)
+	if ch == nil {
+		return nil
+	}
+	val, ok := g.generateReceive(ch)
+	if val == nil {
+		return nil
+	}
+	return []value.Value{val, ok}
+}
+
+// sendSlot, gönderilecek değeri kanalın eleman tipine uyarlayıp runtime'a
+// adresi verilecek geçici bir belleğe yazar.
+func (g *IRGenerator) sendSlot(elem types.Type, val value.Value, unsigned bool) value.Value {
+	g.labelCounter++
+	slot := g.entryAlloca(elem, fmt.Sprintf("send.%d", g.labelCounter))
+	g.currentBB.NewStore(g.convertAssignedValue(val, elem, unsigned), slot)
+	return g.currentBB.NewBitCast(slot, bytePtr)
+}
+
+// generateSendStatement, ch <- v deyimi için IR üretir. Kapalı bir kanala
+// gönderme panic başlatır.
+func (g *IRGenerator) generateSendStatement(stmt *ast.SendStatement) {
+	if g.currentFunc == nil || g.currentBB == nil {
+		g.ReportError("Gönderme işlemi sadece fonksiyon içinde kullanılabilir")
+		return
+	}
+
+	ch := g.generateExpression(stmt.Channel)
+	val := g.generateExpression(stmt.Value)
+	if ch == nil || val == nil {
+		return
+	}
+	elem := g.channelOperand(ch, "Gönderme")
+	if elem == nil {
+		return
+	}
+
+	slot := g.sendSlot(elem, val, g.isUnsignedExpr(stmt.Value))
+	ok := g.currentBB.NewCall(g.chanRuntimeFunc("gominus_chansend"), g.currentBB.NewBitCast(ch, bytePtr), slot)
+	g.generatePanicIf(g.currentBB.NewICmp(enum.IPredEQ, ok, constant.NewInt(types.I32, 0)),
+		"chansend", "send on closed channel")
+}
+
+// generateCloseCall, close() built-in function için IR üretir. nil veya
+// zaten kapalı bir kanalı kapatmak panic başlatır.
+func (g *IRGenerator) generateCloseCall(args []ast.Expression) value.Value {
+	if len(args) != 1 {
+		g.ReportError("close() fonksiyonu tam olarak 1 argüman alır, %d verildi", len(args))
+		return nil
+	}
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, close() çağrısı yapılamıyor")
+		return nil
+	}
+
+	ch := g.generateExpression(args[0])
+	if ch == nil || g.channelOperand(ch, "Kapatma") == nil {
+		return nil
+	}
+
+	result := g.currentBB.NewCall(g.chanRuntimeFunc("gominus_chanclose"), g.currentBB.NewBitCast(ch, bytePtr))
+	g.generatePanicIf(g.currentBB.NewICmp(enum.IPredEQ, result, constant.NewInt(types.I32, 1)),
+		"chanclose", "close of nil channel")
+	g.generatePanicIf(g.currentBB.NewICmp(enum.IPredEQ, result, constant.NewInt(types.I32, 2)),
+		"chanclose", "close of closed channel")
+	return nil
+}
+
+// generateChanLength, len(ch) veya cap(ch) için IR üretir.
+func (g *IRGenerator) generateChanLength(ch value.Value, funcName string) value.Value {
+	n := g.currentBB.NewCall(g.chanRuntimeFunc(funcName), g.currentBB.NewBitCast(ch, bytePtr))
+	return g.currentBB.NewTrunc(n, types.I32)
+}
+
+// selectRecv, select'teki bir alma case'inin parçalarıdır: v, ok := <-ch.
+type selectRecv struct {
+	channel ast.Expression
+	targets []ast.Expression // Alınan değer ve ok hedefleri (olmayabilir)
+	define  bool             // Hedefler := ile tanımlanıyor
+}
+
+// commReceive, select case'indeki bir alma işlemini parçalarına ayırır.
+func commReceive(comm ast.Statement) *selectRecv {
+	channelOf := func(expr ast.Expression) ast.Expression {
+		if isReceiveExpression(expr) {
+			return expr.(*ast.PrefixExpression).Right
+		}
+		return nil
+	}
+
+	switch s := comm.(type) {
+	case *ast.ExpressionStatement:
+		switch e := s.Expression.(type) {
+		case *ast.PrefixExpression:
+			return &selectRecv{channel: channelOf(e)}
+		case *ast.InfixExpression:
+			return &selectRecv{channel: channelOf(e.Right), targets: []ast.Expression{e.Left}, define: true}
+		case *ast.AssignExpression:
+			return &selectRecv{channel: channelOf(e.Value), targets: []ast.Expression{e.Left}}
+		}
+	case *ast.MultiAssignStatement:
+		if len(s.Values) == 1 {
+			return &selectRecv{channel: channelOf(s.Values[0]), targets: s.Left, define: s.Operator == ":="}
+		}
+	}
+	return nil
+}
+
+// generateSelectStatement, bir select deyimi için IR üretir.
+//
+// Case'lerin kanal ve gönderilecek değer ifadeleri kaynak sırasıyla bir kez
+// değerlendirilip bir case dizisine yazılır. Runtime hazır case'lerden birini
+// rastgele seçip işlemi yürütür ve indeksini döndürür; default varsa ve
+// hiçbir case hazır değilse -1 döner. Seçilen case'in bloğunda alınan değer
+// hedeflere atanır ve gövde üretilir.
+func (g *IRGenerator) generateSelectStatement(stmt *ast.SelectStatement) {
+	if g.currentFunc == nil || g.currentBB == nil {
+		g.ReportError("Geçerli bir fonksiyon yok, select deyimi değerlendirilemiyor")
+		return
+	}
+
+	g.labelCounter++
+	labelSuffix := fmt.Sprintf("%d", g.labelCounter)
+	i32 := func(v int64) constant.Constant { return constant.NewInt(types.I32, v) }
+
+	var commClauses []*ast.CommClause
+	var defaultClause *ast.CommClause
+	for _, clause := range stmt.Cases {
+		if clause.Comm == nil {
+			defaultClause = clause
+		} else {
+			commClauses = append(commClauses, clause)
+		}
+	}
+
+	casesType := types.NewArray(uint64(len(commClauses)), scaseType)
+	cases := g.entryAlloca(casesType, "select.cases."+labelSuffix)
+	slots := make([]value.Value, len(commClauses))
+	elems := make([]types.Type, len(commClauses))
+	for i, clause := range commClauses {
+		var chExpr ast.Expression
+		send, isSend := clause.Comm.(*ast.SendStatement)
+		if isSend {
+			chExpr = send.Channel
+		} else if recv := commReceive(clause.Comm); recv != nil && recv.channel != nil {
+			chExpr = recv.channel
+		} else {
+			g.ReportError("select case'i bir gönderme veya alma işlemi olmalıdır: %s", clause.Comm.String())
+			return
+		}
+
+		ch := g.generateExpression(chExpr)
+		if ch == nil {
+			return
+		}
+		if elems[i] = g.channelOperand(ch, "Select"); elems[i] == nil {
+			return
+		}
+
+		kind := int64(caseRecv)
+		if isSend {
+			val := g.generateExpression(send.Value)
+			if val == nil {
+				return
+			}
+			kind = caseSend
+			slots[i] = g.sendSlot(elems[i], val, g.isUnsignedExpr(send.Value))
+		} else {
+			slots[i] = g.entryAlloca(elems[i], fmt.Sprintf("select.recv.%d.%s", i, labelSuffix))
+		}
+
+		scase := g.currentBB.NewGetElementPtr(casesType, cases, i32(0), i32(int64(i)))
+		fields := []value.Value{g.currentBB.NewBitCast(ch, bytePtr), g.currentBB.NewBitCast(slots[i], bytePtr), i32(kind), i32(0)}
+		for j, field := range fields {
+			g.currentBB.NewStore(field, g.currentBB.NewGetElementPtr(scaseType, scase, i32(0), i32(int64(j))))
+		}
+	}
+
+	block := i32(1)
+	if defaultClause != nil {
+		block = i32(0)
+	}
+	first := g.currentBB.NewGetElementPtr(casesType, cases, i32(0), i32(0))
+	chosen := g.currentBB.NewCall(g.chanRuntimeFunc("gominus_select"), first, i32(int64(len(commClauses))), block)
+
+	endBlock := g.currentFunc.NewBlock("select.end." + labelSuffix)
+	defaultBlock := endBlock
+	if defaultClause != nil {
+		defaultBlock = g.currentFunc.NewBlock("select.default." + labelSuffix)
+	}
+	caseBlocks := make([]*ir.Block, len(commClauses))
+	targets := make([]*ir.Case, len(commClauses))
+	for i := range commClauses {
+		caseBlocks[i] = g.currentFunc.NewBlock(fmt.Sprintf("select.case.%d.%s", i, labelSuffix))
+		targets[i] = ir.NewCase(i32(int64(i)), caseBlocks[i])
+	}
+	g.currentBB.NewSwitch(chosen, defaultBlock, targets...)
+
+	// Case gövdeleri; break end bloğuna gider
+	g.pushBranchTarget(endBlock, nil)
+	for i, clause := range commClauses {
+		g.currentBB = caseBlocks[i]
+		ok := g.currentBB.NewLoad(types.I32, g.currentBB.NewGetElementPtr(casesType, cases, i32(0), i32(int64(i)), i32(3)))
+
+		restore := func() {}
+		if _, isSend := clause.Comm.(*ast.SendStatement); isSend {
+			g.generatePanicIf(g.currentBB.NewICmp(enum.IPredEQ, ok, i32(0)), "chansend", "send on closed channel")
+		} else {
+			received := []value.Value{
+				g.currentBB.NewLoad(elems[i], slots[i]),
+				g.currentBB.NewICmp(enum.IPredNE, ok, i32(0)),
+			}
+			restore = g.bindSelectReceive(commReceive(clause.Comm), received, labelSuffix)
+		}
+
+		g.generateSelectBody(clause, endBlock)
+		restore()
+	}
+	if defaultClause != nil {
+		g.currentBB = defaultBlock
+		g.generateSelectBody(defaultClause, endBlock)
+	}
+	g.popBranchTarget()
+
+	g.currentBB = endBlock
+}
+
+// bindSelectReceive, seçilen alma case'inde alınan değeri ve başarıyı
+// hedeflere atar. := ile tanımlanan hedefler case kapsamındadır; önceki
+// tanımları geri yükleyen fonksiyon döndürülür.
+func (g *IRGenerator) bindSelectReceive(recv *selectRecv, received []value.Value, labelSuffix string) func() {
+	var saved []func()
+	for i, target := range recv.targets {
+		if i >= len(received) {
+			break
+		}
+		var slot value.Value
+		if recv.define {
+			slot, saved = g.defineRangeVariable(target, received[i].Type(), false, labelSuffix, saved)
+		}
+		g.assignRangeVariable(target, slot, received[i], false)
+	}
+
+	return func() {
+		for i := len(saved) - 1; i >= 0; i-- {
+			saved[i]()
+		}
+	}
+}
+
+// generateSelectBody, bir select case'inin gövdesini üretir ve gövdeyi end
+// bloğuna bağlar.
+func (g *IRGenerator) generateSelectBody(clause *ast.CommClause, endBlock *ir.Block) {
+	for _, bodyStmt := range clause.Body {
+		g.generateStatement(bodyStmt)
+		if g.currentBB.Term != nil {
+			break
+		}
+	}
+	if g.currentBB.Term == nil {
+		g.currentBB.NewBr(endBlock)
+	}
+}
+
+// generateChanRange, bir kanal üzerinde range döngüsü için IR üretir. Döngü
+// her adımda kanaldan bir değer alır ve kanal kapanıp boşaldığında biter.
+func (g *IRGenerator) generateChanRange(stmt *ast.RangeStatement, ch value.Value, elem types.Type) {
+	if stmt.Value != nil {
+		g.ReportError("Kanal üzerinde range en fazla bir değişken alabilir")
+		return
+	}
+
+	g.labelCounter++
+	labelSuffix := fmt.Sprintf("%d", g.labelCounter)
+
+	condBlock := g.currentFunc.NewBlock("range.recv." + labelSuffix)
+	bodyBlock := g.currentFunc.NewBlock("range.body." + labelSuffix)
+	endBlock := g.currentFunc.NewBlock("range.end." + labelSuffix)
+
+	g.currentBB.NewBr(condBlock)
+
+	// Koşul: kanal kapanana kadar değer al
+	g.currentBB = condBlock
+	val, ok := g.generateReceive(ch)
+	g.currentBB.NewCondBr(ok, bodyBlock, endBlock)
+
+	// := ile tanımlanan değişken her adımda yeniden tanımlanır
+	g.currentBB = bodyBlock
+	var slot value.Value
+	restore := func() {}
+	if stmt.Define {
+		var saved []func()
+		slot, saved = g.defineRangeVariable(stmt.Key, elem, false, labelSuffix, saved)
+		restore = func() {
+			for i := len(saved) - 1; i >= 0; i-- {
+				saved[i]()
+			}
+		}
+	}
+	g.assignRangeVariable(stmt.Key, slot, val, false)
+
+	g.pushBranchTarget(endBlock, condBlock)
+	if stmt.Body != nil {
+		g.generateBlockStatement(stmt.Body)
+	}
+	g.popBranchTarget()
+	if g.currentBB.Term == nil {
+		g.currentBB.NewBr(condBlock)
+	}
+
+	restore()
+	g.currentBB = endBlock
+}
@@ -63,12 +63,12 @@ func (g *IRGenerator) generateClassStatement(stmt *ast.ClassStatement) {
 		}
 	}
 
-	// Arayüzleri işle
+	// Arayüzleri işle; uygulama denetimi semantik analizde yapılır
 	for _, iface := range stmt.Implements {
 		ifaceName := iface.Value
 		if ifaceInfo, exists := g.classTable[ifaceName]; exists {
 			classInfo.Interfaces = append(classInfo.Interfaces, ifaceInfo)
-		} else {
+		} else if g.interfaceInfo(g.typeTable[ifaceName]) == nil {
 			g.ReportError("Arayüz bulunamadı: %s", ifaceName)
 		}
 	}
@@ -163,7 +163,7 @@ func (g *IRGenerator) generateClassStatement(stmt *ast.ClassStatement) {
 
 				// Parametreleri ekle
 				for i, param := range funcStmt.Parameters {
-					paramName := param.Value
+					paramName := parameterName(param)
 					paramType := paramTypes[i+1] // +1 çünkü ilk parametre this
 					method.Params = append(method.Params, ir.NewParam(paramName, paramType))
 				}
@@ -318,10 +318,25 @@ func (g *IRGenerator) getMallocFunction() *ir.Func {
 
 // generateMemberExpression, bir üye erişim ifadesi için IR üretir.
 func (g *IRGenerator) generateMemberExpression(expr *ast.MemberExpression) value.Value {
-	// Nesneyi değerlendir
-	obj := g.generateExpression(expr.Object)
+	fieldPtr, fieldType, method := g.generateMemberAccess(expr)
+	if fieldPtr != nil {
+		// Struct içindeki dizilerin değeri adresleridir
+		if _, isArray := fieldType.(*types.ArrayType); isArray {
+			return fieldPtr
+		}
+		// Alanın değerini yükle
+		return g.currentBB.NewLoad(fieldType, fieldPtr)
+	}
+	return method
+}
+
+// generateMemberAccess, bir üye erişimini çözümler. Üye bir alan ise alanın
+// adresi ve tipi, bir metot ise metodun fonksiyonu döndürülür.
+func (g *IRGenerator) generateMemberAccess(expr *ast.MemberExpression) (value.Value, types.Type, value.Value) {
+	// Nesneyi değerlendir (struct'lar adresleri üzerinden)
+	obj := g.generateObjectAddress(expr.Object)
 	if obj == nil {
-		return nil
+		return nil, nil, nil
 	}
 
 	// Üye adını al
@@ -330,21 +345,27 @@ func (g *IRGenerator) generateMemberExpression(expr *ast.MemberExpression) value
 		memberName = memberIdent.Value
 	} else {
 		g.ReportError("Üye adı bir tanımlayıcı olmalıdır")
-		return nil
+		return nil, nil, nil
 	}
 
 	// Nesnenin tipini kontrol et
 	objType := obj.Type()
 	if !types.IsPointer(objType) {
 		g.ReportError("Üye erişimi için nesne bir işaretçi olmalıdır")
-		return nil
+		return nil, nil, nil
 	}
 
 	// Struct tipini al
 	structType, ok := objType.(*types.PointerType).ElemType.(*types.StructType)
 	if !ok {
 		g.ReportError("Üye erişimi için nesne bir struct işaretçisi olmalıdır")
-		return nil
+		return nil, nil, nil
+	}
+
+	// Struct alanı
+	if info, exists := g.structTable[structType]; exists {
+		fieldPtr, fieldType := g.generateStructFieldAccess(obj, info, memberName)
+		return fieldPtr, fieldType, nil
 	}
 
 	// Sınıf adını bul
@@ -358,14 +379,14 @@ func (g *IRGenerator) generateMemberExpression(expr *ast.MemberExpression) value
 
 	if className == "" {
 		g.ReportError("Sınıf adı bulunamadı")
-		return nil
+		return nil, nil, nil
 	}
 
 	// Sınıf bilgisini bul
 	classInfo, exists := g.classTable[className]
 	if !exists {
 		g.ReportError("Sınıf bilgisi bulunamadı: %s", className)
-		return nil
+		return nil, nil, nil
 	}
 
 	// Üye bir alan mı yoksa metot mu?
@@ -373,20 +394,18 @@ func (g *IRGenerator) generateMemberExpression(expr *ast.MemberExpression) value
 		// Alan erişimi
 		if g.currentBB == nil {
 			g.ReportError("Geçerli bir blok yok, alan erişimi değerlendirilemiyor")
-			return nil
+			return nil, nil, nil
 		}
 
 		// Alan işaretçisini al
 		fieldPtr := g.currentBB.NewGetElementPtr(structType, obj, constant.NewInt(types.I32, 0), constant.NewInt(types.I32, int64(fieldInfo.Index)))
-
-		// Alanın değerini yükle
-		return g.currentBB.NewLoad(fieldInfo.Type, fieldPtr)
+		return fieldPtr, fieldInfo.Type, nil
 	} else if methodInfo, exists := classInfo.Methods[memberName]; exists {
 		// Metot erişimi
 		// Metot çağrısı için bir fonksiyon işaretçisi döndür
-		return methodInfo.Function
+		return nil, nil, methodInfo.Function
 	}
 
 	g.ReportError("Üye bulunamadı: %s", memberName)
-	return nil
+	return nil, nil, nil
 }
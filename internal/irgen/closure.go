@@ -0,0 +1,338 @@
+package irgen
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// Fonksiyon değerleri (closure'lar) { fn, env } çiftleridir. fn, ilk
+// parametresi ortam işaretçisi (i8*) olan bir fonksiyonu gösterir; env,
+// closure'ın yakaladığı değişkenlerin adreslerini tutan ve yığında ayrılan
+// ortamı gösterir. Değişkenler referansla yakalanır: bir fonksiyonun
+// değişmezleri tarafından kullanılan yerel değişkenleri fonksiyonun
+// çerçevesinde değil yığında ayrılır, böylece fonksiyon döndükten sonra da
+// yaşarlar. Değer olarak kullanılan global fonksiyonlar ortamı yok sayan bir
+// sarmalayıcıyla closure'a dönüştürülür. nil fonksiyon değerinin fn'i null'dır.
+
+// closureType, ortam parametresi almış bir imza için closure tipini oluşturur.
+func closureType(sig *types.FuncType) *types.StructType {
+	return types.NewStruct(types.NewPointer(sig), bytePtr)
+}
+
+// closureSignature, t bir closure tipiyse çağrılan fonksiyonun ortam
+// parametresi dahil imzasını döndürür; aksi halde nil döner.
+func closureSignature(t types.Type) *types.FuncType {
+	st, ok := t.(*types.StructType)
+	if !ok || st.Name() != "" || len(st.Fields) != 2 || !st.Fields[1].Equal(bytePtr) {
+		return nil
+	}
+	ptr, ok := st.Fields[0].(*types.PointerType)
+	if !ok {
+		return nil
+	}
+	sig, ok := ptr.ElemType.(*types.FuncType)
+	if !ok || len(sig.Params) == 0 || !sig.Params[0].Equal(bytePtr) {
+		return nil
+	}
+	return sig
+}
+
+// funcValueType, verilen parametre ve dönüş tipleriyle bir fonksiyon
+// değerinin closure tipini oluşturur.
+func (g *IRGenerator) funcValueType(retType types.Type, params []types.Type, variadic bool) *types.StructType {
+	sig := types.NewFunc(retType, append([]types.Type{bytePtr}, params...)...)
+	g.variadicSigs[sig] = variadic
+	return closureType(sig)
+}
+
+// literalType, bir fonksiyon değişmezinin tipini kod üretmeden belirler.
+func (g *IRGenerator) literalType(lit *ast.FunctionLiteral) types.Type {
+	params := make([]types.Type, len(lit.Parameters))
+	for i, param := range lit.Parameters {
+		params[i] = g.parameterType(param)
+	}
+	return g.funcValueType(g.resultType(lit.ReturnType), params, ast.IsVariadic(lit.Parameters))
+}
+
+// sizeOf, bir tipin bayt cinsinden boyutunu sabit bir ifade olarak döndürür.
+func sizeOf(t types.Type) constant.Constant {
+	return constant.NewPtrToInt(constant.NewGetElementPtr(t, constant.NewNull(types.NewPointer(t)), constant.NewInt(types.I32, 1)), types.I64)
+}
+
+// isLocalVariable, bir sembolün bir fonksiyonun yerel değişkeni olup
+// olmadığını belirler; fonksiyonlar ve global değişkenler yerel değildir.
+func isLocalVariable(val value.Value) bool {
+	switch val.(type) {
+	case nil, *ir.Func, *ir.Global:
+		return false
+	}
+	return true
+}
+
+// referencedNames, bir düğümde değişken veya fonksiyon adı olarak geçen
+// tanımlayıcıları toplar. Üye erişimlerindeki alan ve metot adları atlanır.
+func referencedNames(node ast.Node) map[string]bool {
+	names := make(map[string]bool)
+	var visit func(ast.Node) bool
+	visit = func(n ast.Node) bool {
+		switch e := n.(type) {
+		case *ast.Identifier:
+			names[e.Value] = true
+		case *ast.MemberExpression:
+			ast.Inspect(e.Object, visit)
+			return false
+		}
+		return true
+	}
+	ast.Inspect(node, visit)
+	return names
+}
+
+// escapingNames, bir fonksiyon gövdesinde fonksiyon değişmezlerinin
+// kullandığı ve adresi alınan (&x, &x.f) adları döndürür. Gövdede bu adlarla
+// tanımlanan yerel değişkenler fonksiyondan uzun yaşayabileceğinden yığında
+// ayrılır; ad tabanlı bu analiz gölgelenen değişkenler için gereğinden fazla
+// değişkeni yığına taşıyabilir.
+func escapingNames(body *ast.BlockStatement) map[string]bool {
+	names := make(map[string]bool)
+	if body == nil {
+		return names
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch e := n.(type) {
+		case *ast.FunctionLiteral:
+			for name := range referencedNames(e) {
+				names[name] = true
+			}
+			return false
+		case *ast.PrefixExpression:
+			if e.Operator == "&" {
+				if root := addressRoot(e.Right); root != nil {
+					names[root.Value] = true
+				}
+			}
+		}
+		return true
+	})
+	return names
+}
+
+// addressRoot, adresi alınan bir ifadenin (x, x.f, x[i]) kök değişkenini
+// döndürür; kök bir değişken değilse nil döner.
+func addressRoot(expr ast.Expression) *ast.Identifier {
+	for {
+		switch e := expr.(type) {
+		case *ast.Identifier:
+			return e
+		case *ast.MemberExpression:
+			expr = e.Object
+		case *ast.IndexExpression:
+			expr = e.Left
+		default:
+			return nil
+		}
+	}
+}
+
+// newVariable, bir yerel değişken için bellek ayırır ve adresini döndürür.
+// Fonksiyon değişmezlerinin yakaladığı ve adresi alınan değişkenler yığında
+// (malloc), diğerleri fonksiyonun çerçevesinde (alloca) ayrılır.
+func (g *IRGenerator) newVariable(block *ir.Block, name, irName string, t types.Type) value.Value {
+	if g.escapingVars[name] {
+		cell := block.NewBitCast(block.NewCall(g.getMallocFunction(), sizeOf(t)), types.NewPointer(t))
+		cell.SetName(irName)
+		return cell
+	}
+	alloca := block.NewAlloca(t)
+	alloca.SetName(irName)
+	return alloca
+}
+
+// variableAddress, bir tanımlayıcı bir değişkeni gösteriyorsa değişkenin
+// adresini ve tipini döndürür; aksi halde nil döner.
+func (g *IRGenerator) variableAddress(name string) (value.Value, types.Type) {
+	val := g.symbolTable[name]
+	if _, isFunc := val.(*ir.Func); isFunc || val == nil {
+		return nil, nil
+	}
+	if global, ok := val.(*ir.Global); ok {
+		return global, global.ContentType
+	}
+	ptr, ok := val.Type().(*types.PointerType)
+	if !ok {
+		return nil, nil
+	}
+	return val, ptr.ElemType
+}
+
+// literalName, bir fonksiyon değişmezine içinde bulunduğu fonksiyonun
+// adından türetilen tekil bir ad verir (main.func1, main.func1.func1).
+func (g *IRGenerator) literalName() string {
+	prefix := "func"
+	if g.currentFunc != nil {
+		prefix = g.currentFunc.Name() + ".func"
+	}
+	for i := 1; ; i++ {
+		if name := prefix + strconv.Itoa(i); g.getFunction(name) == nil {
+			return name
+		}
+	}
+}
+
+// capturedVariables, bir fonksiyon değişmezinin kullandığı, içinde bulunduğu
+// fonksiyonun yerel değişkenlerini ad sırasıyla döndürür.
+func (g *IRGenerator) capturedVariables(lit *ast.FunctionLiteral) []string {
+	var captured []string
+	for name := range referencedNames(lit) {
+		if isLocalVariable(g.symbolTable[name]) {
+			captured = append(captured, name)
+		}
+	}
+	sort.Strings(captured)
+	return captured
+}
+
+// newEnvironment, yakalanan değişkenlerin adreslerini yığında ayrılan bir
+// ortama yazar. Ortamın tipi ve i8* olarak adresi döndürülür; yakalanan
+// değişken yoksa ortam null'dır.
+func (g *IRGenerator) newEnvironment(captured []string) (*types.StructType, value.Value) {
+	if len(captured) == 0 || g.currentBB == nil {
+		return nil, constant.NewNull(bytePtr)
+	}
+
+	fields := make([]types.Type, len(captured))
+	for i, name := range captured {
+		fields[i] = g.symbolTable[name].Type()
+	}
+	envType := types.NewStruct(fields...)
+	data := g.currentBB.NewCall(g.getMallocFunction(), sizeOf(envType))
+	env := g.currentBB.NewBitCast(data, types.NewPointer(envType))
+	for i, name := range captured {
+		slot := g.currentBB.NewGetElementPtr(envType, env,
+			constant.NewInt(types.I32, 0), constant.NewInt(types.I32, int64(i)))
+		g.currentBB.NewStore(g.symbolTable[name], slot)
+	}
+	return envType, data
+}
+
+// bindCaptured, bir fonksiyon değişmezinin gövdesinde içinde bulunduğu
+// fonksiyonun yerel değişkenlerini kaldırır ve yakalanan değişkenleri
+// ortamdaki adresleriyle yeniden tanımlar.
+func (g *IRGenerator) bindCaptured(entry *ir.Block, envParam value.Value, envType *types.StructType, captured []string) {
+	for name, val := range g.symbolTable {
+		if isLocalVariable(val) {
+			delete(g.symbolTable, name)
+		}
+	}
+	if envType == nil {
+		return
+	}
+
+	env := entry.NewBitCast(envParam, types.NewPointer(envType))
+	for i, name := range captured {
+		slot := entry.NewGetElementPtr(envType, env,
+			constant.NewInt(types.I32, 0), constant.NewInt(types.I32, int64(i)))
+		addr := entry.NewLoad(envType.Fields[i], slot)
+		addr.SetName(name)
+		g.symbolTable[name] = addr
+	}
+}
+
+// funcValue, global bir fonksiyonu closure değerine dönüştürür. Ortamı yok
+// sayarak fonksiyonu çağıran sarmalayıcı her fonksiyon için bir kez
+// oluşturulur.
+func (g *IRGenerator) funcValue(fn *ir.Func) constant.Constant {
+	name := fn.Name() + ".closure"
+	wrapper := g.getFunction(name)
+	if wrapper == nil {
+		params := []*ir.Param{ir.NewParam("env", bytePtr)}
+		args := make([]value.Value, len(fn.Params))
+		for i, param := range fn.Params {
+			p := ir.NewParam(param.LocalName, param.Typ)
+			params = append(params, p)
+			args[i] = p
+		}
+		wrapper = g.module.NewFunc(name, fn.Sig.RetType, params...)
+		g.variadicSigs[wrapper.Sig] = g.variadicSigs[fn.Sig]
+
+		entry := wrapper.NewBlock("entry")
+		result := entry.NewCall(fn, args...)
+		if fn.Sig.RetType.Equal(types.Void) {
+			entry.NewRet(nil)
+		} else {
+			entry.NewRet(result)
+		}
+	}
+	return constant.NewStruct(closureType(wrapper.Sig), wrapper, constant.NewNull(bytePtr))
+}
+
+// generateFunctionLiteral, bir fonksiyon değişmezini kendi fonksiyonu olarak
+// üretir ve yakaladığı değişkenlerin ortamıyla birlikte closure değerini
+// döndürür.
+func (g *IRGenerator) generateFunctionLiteral(expr *ast.FunctionLiteral) value.Value {
+	// Ad ve ortam, değişmezin bulunduğu fonksiyonda belirlenir
+	fn := g.newFunction(g.literalName(), expr.Parameters, expr.ReturnType, ir.NewParam("env", bytePtr))
+	captured := g.capturedVariables(expr)
+	envType, env := g.newEnvironment(captured)
+
+	// Önceki durumu kaydet
+	prevFunc := g.currentFunc
+	prevBB := g.currentBB
+	restore := g.enterFunctionScope(expr.Body)
+
+	// Yeni durumu ayarla
+	g.currentFunc = fn
+	entryBlock := fn.NewBlock("entry")
+	g.currentBB = entryBlock
+
+	// Yakalanan değişkenleri, parametreleri ve adlandırılmış sonuçları sembol tablosuna ekle
+	g.bindCaptured(entryBlock, fn.Params[0], envType, captured)
+	g.bindParameters(entryBlock, expr.Parameters, fn.Params[1:])
+	g.defineNamedResults(entryBlock, expr.ReturnType)
+	g.enterDeferFrame(expr.Body)
+
+	// Fonksiyon gövdesini işle
+	if expr.Body != nil {
+		g.generateBlockStatement(expr.Body)
+	}
+
+	// Eğer son blok bir dönüş ifadesi ile bitmiyorsa, varsayılan dönüş ekle
+	if g.currentBB.Term == nil {
+		g.generateBareReturn()
+	}
+
+	// Önceki durumu geri yükle
+	restore()
+	g.currentFunc = prevFunc
+	g.currentBB = prevBB
+
+	closure := constant.NewStruct(closureType(fn.Sig), fn, constant.NewNull(bytePtr))
+	if envType == nil {
+		return closure
+	}
+	return g.currentBB.NewInsertValue(closure, env, 1)
+}
+
+// generateClosureCall, bir fonksiyon değerini ortamını ilk argüman olarak
+// vererek çağırır. nil fonksiyon değerinin çağrılması panic ile sonlanır.
+func (g *IRGenerator) generateClosureCall(closure value.Value, call *ast.CallExpression) value.Value {
+	sig := closureSignature(closure.Type())
+	fn := g.currentBB.NewExtractValue(closure, 0)
+	isNil := g.currentBB.NewICmp(enum.IPredEQ, fn, constant.NewNull(types.NewPointer(sig)))
+	g.generatePanicIf(isNil, "nilcall", "runtime error: invalid memory address or nil pointer dereference")
+
+	args := g.generateArguments(sig.Params[1:], g.variadicSigs[sig], call)
+	if args == nil {
+		return nil
+	}
+	env := g.currentBB.NewExtractValue(closure, 1)
+	return g.currentBB.NewCall(fn, append([]value.Value{env}, args...)...)
+}
@@ -0,0 +1,692 @@
+package irgen
+
+import (
+	"strconv"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// Ertelenen çağrılar ve panic, iş parçacığına özel bir çerçeve zinciriyle
+// gerçeklenir. defer içeren her fonksiyon ve her try bloğu, yığın
+// çerçevesinde bir çerçeve ayırıp zincirin başına ekler ve setjmp ile bir
+// dönüş noktası kaydeder. defer deyimi çağrılan fonksiyonu ve argümanları
+// hemen değerlendirip bir ortama yazar; ortamla çağrıyı yapan bir thunk,
+// fonksiyon çerçevesinin listesinin başına eklenir, böylece çağrılar ters
+// sırayla çalışır. panic, çalışma zamanı hataları ve throw panic durumunu
+// kaydedip en içteki çerçeveye longjmp yapar. Fonksiyon çerçevesine dönüldüğünde
+// ertelenen çağrılar çalıştırılır; panic recover ile durdurulmadıysa bir
+// dıştaki çerçeveye devam eder, durdurulduysa fonksiyon sonuçlarının güncel
+// değerleriyle döner. try çerçevesine dönüldüğünde catch blokları denenir.
+// Çerçeve kalmadıysa mesaj yazdırılır ve program 2 koduyla sonlanır.
+// try blokları da LLVM landingpad'leri yerine bu çerçeve zincirini kullanır.
+
+// jmpBufWords, çerçevedeki jmp_buf alanının 64 bitlik sözcük sayısıdır;
+// desteklenen platformların jmp_buf'larından büyüktür.
+const jmpBufWords = 64
+
+// frameType, bir çerçevenin tipidir: { önceki çerçeve, ertelenen çağrı
+// listesi, jmp_buf }.
+var frameType = types.NewStruct(bytePtr, bytePtr, types.NewArray(jmpBufWords, types.I64))
+
+// deferThunkType, ertelenen çağrıyı ortamıyla yapan thunk'ların tipidir.
+var deferThunkType = types.NewFunc(types.Void, bytePtr)
+
+// deferRecordType, ertelenen bir çağrının kaydıdır: { sonraki kayıt, thunk, ortam }.
+var deferRecordType = types.NewStruct(bytePtr, types.NewPointer(deferThunkType), bytePtr)
+
+// Çalışma zamanı durumunu tutan iş parçacığına özel global değişkenler.
+const (
+	framesGlobalName     = "gominus.frames"     // En içteki çerçeve
+	panickingGlobalName  = "gominus.panicking"  // Süren bir panic var mı?
+	panicValueGlobalName = "gominus.panicvalue" // panic'e verilen değer (interface{})
+	panicMsgGlobalName   = "gominus.panicmsg"   // Yazdırılacak panic mesajı
+)
+
+// Çalışma zamanı fonksiyonlarının adları.
+const (
+	runtimePanicFuncName = "gominus.panic"
+	runDefersFuncName    = "gominus.rundefers"
+)
+
+// anyInterface, metotsuz interface{} tipinin paylaşılan bilgisini döndürür.
+// recover'ın sonucu ve panic değerleri bu tiptedir.
+func (g *IRGenerator) anyInterface() *InterfaceInfo {
+	if g.emptyInterface == nil {
+		g.emptyInterface = &InterfaceInfo{Type: newInterfaceType(), Methods: make(map[string]*types.FuncType)}
+		g.interfaceTable[g.emptyInterface.Type] = g.emptyInterface
+	}
+	return g.emptyInterface
+}
+
+// runtimeGlobal, çalışma zamanı durumunu tutan iş parçacığına özel bir
+// global değişkeni ilk kullanımda sıfır değeriyle tanımlar.
+func (g *IRGenerator) runtimeGlobal(name string, t types.Type) *ir.Global {
+	global := g.getGlobal(name)
+	if global == nil {
+		global = g.module.NewGlobalDef(name, zeroValue(t))
+		global.Linkage = enum.LinkageInternal
+		global.TLSModel = enum.TLSModelGeneric
+	}
+	return global
+}
+
+func (g *IRGenerator) framesGlobal() *ir.Global {
+	return g.runtimeGlobal(framesGlobalName, bytePtr)
+}
+
+func (g *IRGenerator) panickingGlobal() *ir.Global {
+	return g.runtimeGlobal(panickingGlobalName, types.I1)
+}
+
+func (g *IRGenerator) panicValueGlobal() *ir.Global {
+	return g.runtimeGlobal(panicValueGlobalName, g.anyInterface().Type)
+}
+
+func (g *IRGenerator) panicMsgGlobal() *ir.Global {
+	return g.runtimeGlobal(panicMsgGlobalName, bytePtr)
+}
+
+// getExternalFunction, bir C kütüphanesi fonksiyonunu ilk kullanımda bildirir.
+func (g *IRGenerator) getExternalFunction(name string, retType types.Type, params ...*ir.Param) *ir.Func {
+	if fn := g.getFunction(name); fn != nil {
+		return fn
+	}
+	fn := g.module.NewFunc(name, retType, params...)
+	g.symbolTable[name] = fn
+	return fn
+}
+
+func (g *IRGenerator) getSetjmpFunc() *ir.Func {
+	fn := g.getFunction("_setjmp")
+	if fn == nil {
+		fn = g.getExternalFunction("_setjmp", types.I32, ir.NewParam("env", bytePtr))
+		fn.FuncAttrs = append(fn.FuncAttrs, enum.FuncAttrReturnsTwice)
+	}
+	return fn
+}
+
+func (g *IRGenerator) getLongjmpFunc() *ir.Func {
+	fn := g.getFunction("longjmp")
+	if fn == nil {
+		fn = g.getExternalFunction("longjmp", types.Void, ir.NewParam("env", bytePtr), ir.NewParam("val", types.I32))
+		fn.FuncAttrs = append(fn.FuncAttrs, enum.FuncAttrNoReturn)
+	}
+	return fn
+}
+
+func (g *IRGenerator) getExitFunc() *ir.Func {
+	return g.getExternalFunction("exit", types.Void, ir.NewParam("status", types.I32))
+}
+
+func (g *IRGenerator) getSnprintfFunc() *ir.Func {
+	fn := g.getFunction("snprintf")
+	if fn == nil {
+		fn = g.getExternalFunction("snprintf", types.I32,
+			ir.NewParam("buf", bytePtr), ir.NewParam("size", types.I64), ir.NewParam("format", bytePtr))
+		fn.Sig.Variadic = true
+	}
+	return fn
+}
+
+// stringConstant, bir string için global sabit oluşturup ilk karakterinin
+// adresini döndürür. Çalışma zamanı fonksiyonları gibi geçerli bloğu
+// kullanmayan yerlerde kullanılır.
+func (g *IRGenerator) stringConstant(s string) constant.Constant {
+	global := g.module.NewGlobalDef("", constant.NewCharArrayFromString(s+"\x00"))
+	global.Immutable = true
+	zero := constant.NewInt(types.I32, 0)
+	return constant.NewGetElementPtr(global.ContentType, global, zero, zero)
+}
+
+// getRuntimePanicFunc, gominus.panic(msg, value) çalışma zamanı fonksiyonunu
+// döndürür. Fonksiyon panic durumunu kaydeder ve en içteki çerçeveye longjmp
+// yapar; çerçeve yoksa mesajı yazdırıp programı sonlandırır.
+func (g *IRGenerator) getRuntimePanicFunc() *ir.Func {
+	if fn := g.getFunction(runtimePanicFuncName); fn != nil {
+		return fn
+	}
+
+	msg := ir.NewParam("msg", bytePtr)
+	val := ir.NewParam("value", g.anyInterface().Type)
+	fn := g.module.NewFunc(runtimePanicFuncName, types.Void, msg, val)
+	fn.Linkage = enum.LinkageInternal
+	fn.FuncAttrs = append(fn.FuncAttrs, enum.FuncAttrNoReturn)
+	g.symbolTable[runtimePanicFuncName] = fn
+
+	entry := fn.NewBlock("entry")
+	unwind := fn.NewBlock("unwind")
+	abort := fn.NewBlock("abort")
+
+	entry.NewStore(constant.True, g.panickingGlobal())
+	entry.NewStore(val, g.panicValueGlobal())
+	entry.NewStore(msg, g.panicMsgGlobal())
+	frame := entry.NewLoad(bytePtr, g.framesGlobal())
+	entry.NewCondBr(entry.NewICmp(enum.IPredEQ, frame, constant.NewNull(bytePtr)), abort, unwind)
+
+	typed := unwind.NewBitCast(frame, types.NewPointer(frameType))
+	jmpBuf := unwind.NewGetElementPtr(frameType, typed,
+		constant.NewInt(types.I32, 0), constant.NewInt(types.I32, 2), constant.NewInt(types.I32, 0))
+	unwind.NewCall(g.getLongjmpFunc(), unwind.NewBitCast(jmpBuf, bytePtr), constant.NewInt(types.I32, 1))
+	unwind.NewUnreachable()
+
+	dprintf := g.getFunction("dprintf")
+	if dprintf == nil {
+		dprintf = g.getExternalFunction("dprintf", types.I32, ir.NewParam("fd", types.I32), ir.NewParam("format", bytePtr))
+		dprintf.Sig.Variadic = true
+	}
+	abort.NewCall(dprintf, constant.NewInt(types.I32, 2), g.stringConstant("panic: %s\n"), msg)
+	abort.NewCall(g.getExitFunc(), constant.NewInt(types.I32, 2))
+	abort.NewUnreachable()
+
+	return fn
+}
+
+// getPanicFunction, çalışma zamanı hatalarında çağrılan panic(message)
+// fonksiyonunu döndürür. NUL ile sonlanan mesaj, recover'a string değer
+// olarak iletilir.
+func (g *IRGenerator) getPanicFunction() *ir.Func {
+	if fn := g.getFunction("panic"); fn != nil {
+		return fn
+	}
+
+	message := ir.NewParam("message", bytePtr)
+	fn := g.module.NewFunc("panic", types.Void, message)
+	fn.Linkage = enum.LinkageInternal
+	fn.FuncAttrs = append(fn.FuncAttrs, enum.FuncAttrNoReturn)
+	g.symbolTable["panic"] = fn
+
+	anyInfo := g.anyInterface()
+	entry := fn.NewBlock("entry")
+	var str value.Value = constant.NewZeroInitializer(g.stringType())
+	str = entry.NewInsertValue(str, message, 0)
+	str = entry.NewInsertValue(str, entry.NewCall(g.getStrlenFunc(), message), 1)
+	data := entry.NewCall(g.getMallocFunction(), sizeOf(g.stringType()))
+	entry.NewStore(str, entry.NewBitCast(data, types.NewPointer(g.stringType())))
+	var boxed value.Value = constant.NewZeroInitializer(anyInfo.Type)
+	boxed = entry.NewInsertValue(boxed, g.buildItab("string", anyInfo, nil), 0)
+	boxed = entry.NewInsertValue(boxed, data, 1)
+	entry.NewCall(g.getRuntimePanicFunc(), message, boxed)
+	entry.NewUnreachable()
+
+	return fn
+}
+
+// getRunDefersFunc, gominus.rundefers(frame) çalışma zamanı fonksiyonunu
+// döndürür. Fonksiyon çerçevenin ertelenen çağrılarını ters sırayla çalıştırır
+// ve çerçeveyi zincirden çıkarır. Her kayıt çağrılmadan önce listeden
+// çıkarıldığından, ertelenen bir çağrıda başlayan panic çerçeveye döndüğünde
+// kalan çağrılar kaldıkları yerden çalıştırılır.
+func (g *IRGenerator) getRunDefersFunc() *ir.Func {
+	if fn := g.getFunction(runDefersFuncName); fn != nil {
+		return fn
+	}
+
+	param := ir.NewParam("frame", bytePtr)
+	fn := g.module.NewFunc(runDefersFuncName, types.Void, param)
+	fn.Linkage = enum.LinkageInternal
+	g.symbolTable[runDefersFuncName] = fn
+
+	i32 := func(v int64) constant.Constant { return constant.NewInt(types.I32, v) }
+	entry := fn.NewBlock("entry")
+	loop := fn.NewBlock("loop")
+	call := fn.NewBlock("call")
+	done := fn.NewBlock("done")
+
+	frame := entry.NewBitCast(param, types.NewPointer(frameType))
+	head := entry.NewGetElementPtr(frameType, frame, i32(0), i32(1))
+	entry.NewBr(loop)
+
+	record := loop.NewLoad(bytePtr, head)
+	loop.NewCondBr(loop.NewICmp(enum.IPredEQ, record, constant.NewNull(bytePtr)), done, call)
+
+	typed := call.NewBitCast(record, types.NewPointer(deferRecordType))
+	field := func(i int64) value.Value {
+		return call.NewGetElementPtr(deferRecordType, typed, i32(0), i32(i))
+	}
+	call.NewStore(call.NewLoad(bytePtr, field(0)), head)
+	thunk := call.NewLoad(types.NewPointer(deferThunkType), field(1))
+	call.NewCall(thunk, call.NewLoad(bytePtr, field(2)))
+	call.NewBr(loop)
+
+	prev := done.NewLoad(bytePtr, done.NewGetElementPtr(frameType, frame, i32(0), i32(0)))
+	done.NewStore(prev, g.framesGlobal())
+	done.NewRet(nil)
+
+	return fn
+}
+
+// entryAlloca, geçerli fonksiyonun giriş bloğunun başında bellek ayırır;
+// böylece döngülerde üretilen ayırmalar yığını büyütmez.
+func (g *IRGenerator) entryAlloca(t types.Type, name string) *ir.InstAlloca {
+	entry := g.currentFunc.Blocks[0]
+	alloca := ir.NewAlloca(t)
+	alloca.SetName(name)
+	entry.Insts = append([]ir.Instruction{alloca}, entry.Insts...)
+	return alloca
+}
+
+// pushFrame, yeni bir çerçeveyi zincirin başına ekler ve dönüş noktasını
+// kaydeder. Normal akış normal bloğunda, longjmp ile dönüş landing bloğunda
+// devam eder; geçerli blok sonlandırılır.
+func (g *IRGenerator) pushFrame(normal, landing *ir.Block) value.Value {
+	i32 := func(v int64) constant.Constant { return constant.NewInt(types.I32, v) }
+
+	frame := g.entryAlloca(frameType, "frame."+strconv.Itoa(g.labelCounter))
+	frame.Align = 16
+	frames := g.framesGlobal()
+	g.currentBB.NewStore(g.currentBB.NewLoad(bytePtr, frames), g.currentBB.NewGetElementPtr(frameType, frame, i32(0), i32(0)))
+	g.currentBB.NewStore(constant.NewNull(bytePtr), g.currentBB.NewGetElementPtr(frameType, frame, i32(0), i32(1)))
+	g.currentBB.NewStore(g.currentBB.NewBitCast(frame, bytePtr), frames)
+
+	jmpBuf := g.currentBB.NewGetElementPtr(frameType, frame, i32(0), i32(2), i32(0))
+	result := g.currentBB.NewCall(g.getSetjmpFunc(), g.currentBB.NewBitCast(jmpBuf, bytePtr))
+	g.currentBB.NewCondBr(g.currentBB.NewICmp(enum.IPredNE, result, i32(0)), landing, normal)
+	return frame
+}
+
+// popFrame, bir çerçeveyi ve içindeki çerçeveleri zincirden çıkarır.
+func (g *IRGenerator) popFrame(frame value.Value) {
+	prevPtr := g.currentBB.NewGetElementPtr(frameType, frame, constant.NewInt(types.I32, 0), constant.NewInt(types.I32, 0))
+	g.currentBB.NewStore(g.currentBB.NewLoad(bytePtr, prevPtr), g.framesGlobal())
+}
+
+// leaveTryBlocks, fonksiyondan veya döngüden çıkarken depth derinliğinin
+// içindeki try bloklarının çerçevelerini zincirden çıkarır.
+func (g *IRGenerator) leaveTryBlocks(depth int) {
+	for _, info := range g.exceptionStack[min(depth, len(g.exceptionStack)):] {
+		if info.Frame != nil {
+			g.popFrame(info.Frame)
+			return
+		}
+	}
+}
+
+// containsDefer, bir fonksiyon gövdesinin, içindeki fonksiyon değişmezleri
+// hariç, defer deyimi içerip içermediğini belirler.
+func containsDefer(body *ast.BlockStatement) bool {
+	found := false
+	if body == nil {
+		return found
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n.(type) {
+		case *ast.DeferStatement:
+			found = true
+		case *ast.FunctionLiteral:
+			return false
+		}
+		return !found
+	})
+	return found
+}
+
+// enterDeferFrame, gövdesinde defer bulunan bir fonksiyonun girişinde
+// fonksiyon çerçevesini oluşturur. Çerçeveye panic ile dönüldüğünde ertelenen
+// çağrılar çalıştırılır; panic durdurulmadıysa yeniden başlatılır, aksi halde
+// fonksiyon sonuçlarının güncel değerleriyle döner.
+func (g *IRGenerator) enterDeferFrame(body *ast.BlockStatement) {
+	if !containsDefer(body) {
+		return
+	}
+
+	g.labelCounter++
+	labelSuffix := "." + strconv.Itoa(g.labelCounter)
+	bodyBlock := g.currentFunc.NewBlock("body" + labelSuffix)
+	landing := g.currentFunc.NewBlock("defer.panic" + labelSuffix)
+	g.deferFrame = g.pushFrame(bodyBlock, landing)
+
+	g.currentBB = landing
+	g.currentBB.NewCall(g.getRunDefersFunc(), g.currentBB.NewBitCast(g.deferFrame, bytePtr))
+	g.generateRepanicIf(g.currentBB.NewLoad(types.I1, g.panickingGlobal()))
+	g.returnResults()
+
+	g.currentBB = bodyBlock
+}
+
+// generateRepanicIf, koşul doğruysa süren panic'i kaydedilen mesajı ve
+// değeriyle bir dıştaki çerçeveye iletir.
+func (g *IRGenerator) generateRepanicIf(cond value.Value) {
+	g.labelCounter++
+	labelSuffix := "." + strconv.Itoa(g.labelCounter)
+	repanic := g.currentFunc.NewBlock("repanic" + labelSuffix)
+	recovered := g.currentFunc.NewBlock("recovered" + labelSuffix)
+	g.currentBB.NewCondBr(cond, repanic, recovered)
+
+	g.currentBB = repanic
+	g.generateRepanic()
+
+	g.currentBB = recovered
+}
+
+// generateRepanic, süren panic'i bir dıştaki çerçeveye iletir.
+func (g *IRGenerator) generateRepanic() {
+	msg := g.currentBB.NewLoad(bytePtr, g.panicMsgGlobal())
+	val := g.currentBB.NewLoad(g.anyInterface().Type, g.panicValueGlobal())
+	g.currentBB.NewCall(g.getRuntimePanicFunc(), msg, val)
+	g.currentBB.NewUnreachable()
+}
+
+// runDefers, bir dönüşten önce fonksiyonun ertelenen çağrılarını çalıştırır.
+func (g *IRGenerator) runDefers() {
+	if g.deferFrame != nil {
+		g.currentBB.NewCall(g.getRunDefersFunc(), g.currentBB.NewBitCast(g.deferFrame, bytePtr))
+	}
+}
+
+// thunkName, bir defer veya go deyiminin thunk'ına içinde bulunduğu
+// fonksiyonun adından türetilen tekil bir ad verir (main.defer1, main.go1).
+func (g *IRGenerator) thunkName(kind string) string {
+	prefix := g.currentFunc.Name() + "." + kind
+	for i := 1; ; i++ {
+		if name := prefix + strconv.Itoa(i); g.getFunction(name) == nil {
+			return name
+		}
+	}
+}
+
+// isLiteral, bir ifadenin her değerlendirmede aynı sabiti üreten bir
+// değişmez olup olmadığını belirler.
+func isLiteral(expr ast.Expression) bool {
+	switch expr.(type) {
+	case *ast.IntegerLiteral, *ast.FloatLiteral, *ast.StringLiteral, *ast.CharLiteral,
+		*ast.BooleanLiteral, *ast.NullLiteral:
+		return true
+	}
+	return false
+}
+
+// deferredValue, defer deyiminde değerlendirilip ortama yazılan bir değerdir.
+type deferredValue struct {
+	name     string
+	val      value.Value
+	unsigned bool
+	named    *NamedInfo
+}
+
+// generateDeferStatement, bir defer deyimi için IR üretir. Çağrılan fonksiyon
+// değeri, alıcı ve argümanlar hemen değerlendirilip bir ortama yazılır.
+// Çağrı, ortamdaki değerleri değişken olarak kullanan bir thunk'ta üretilir
+// ve thunk fonksiyon çerçevesinin listesine eklenir.
+func (g *IRGenerator) generateDeferStatement(stmt *ast.DeferStatement) {
+	if g.currentFunc == nil || g.currentBB == nil || g.deferFrame == nil {
+		g.ReportError("defer yalnızca fonksiyon içinde kullanılabilir")
+		return
+	}
+
+	thunk, env := g.generateBoundCall(stmt.Token, stmt.Call, g.thunkName("defer"))
+	if thunk == nil {
+		return
+	}
+
+	// Kaydı listenin başına ekle
+	i32 := func(v int64) constant.Constant { return constant.NewInt(types.I32, v) }
+	data := g.currentBB.NewCall(g.getMallocFunction(), sizeOf(deferRecordType))
+	record := g.currentBB.NewBitCast(data, types.NewPointer(deferRecordType))
+	head := g.currentBB.NewGetElementPtr(frameType, g.deferFrame, i32(0), i32(1))
+	g.currentBB.NewStore(g.currentBB.NewLoad(bytePtr, head), g.currentBB.NewGetElementPtr(deferRecordType, record, i32(0), i32(0)))
+	g.currentBB.NewStore(thunk, g.currentBB.NewGetElementPtr(deferRecordType, record, i32(0), i32(1)))
+	g.currentBB.NewStore(env, g.currentBB.NewGetElementPtr(deferRecordType, record, i32(0), i32(2)))
+	g.currentBB.NewStore(data, head)
+}
+
+// generateBoundCall, defer ve go deyimlerinin çağrısını hemen değerlendirir.
+// Çağrılan fonksiyon değeri, alıcı ve argümanlar yığında ayrılan bir ortama
+// yazılır; çağrının kendisi ortamla çağrılan name adlı bir thunk'ta üretilir.
+// Değerlendirme başarısız olursa thunk nil'dir.
+func (g *IRGenerator) generateBoundCall(tok token.Token, stmtCall *ast.CallExpression, name string) (*ir.Func, value.Value) {
+	var captured []deferredValue
+	failed := false
+	capture := func(expr ast.Expression, name string) ast.Expression {
+		if isLiteral(expr) {
+			return expr
+		}
+		val := g.generateExpression(expr)
+		if val == nil {
+			failed = true
+			return expr
+		}
+		captured = append(captured, deferredValue{
+			name:     name,
+			val:      val,
+			unsigned: g.isUnsignedExpr(expr),
+			named:    g.namedTypeOf(expr),
+		})
+		return &ast.Identifier{Token: tok, Value: name}
+	}
+
+	// Fonksiyon değeri ve alıcı deyim anında belirlenir; global fonksiyonlar,
+	// yerleşik fonksiyonlar ve paket fonksiyonları adlarıyla çağrılır
+	call := &ast.CallExpression{Token: stmtCall.Token, Function: stmtCall.Function, Ellipsis: stmtCall.Ellipsis}
+	switch f := stmtCall.Function.(type) {
+	case *ast.Identifier:
+		if val, exists := g.symbolTable[f.Value]; exists {
+			if _, isFunc := val.(*ir.Func); !isFunc {
+				call.Function = capture(f, "defer.func")
+			}
+		}
+	case *ast.MemberExpression:
+		if ident, ok := f.Object.(*ast.Identifier); !ok || g.symbolTable[ident.Value] != nil {
+			call.Function = &ast.MemberExpression{Token: f.Token, Object: capture(f.Object, "defer.recv"), Member: f.Member}
+		}
+	default:
+		call.Function = capture(f, "defer.func")
+	}
+	for i, arg := range stmtCall.Arguments {
+		call.Arguments = append(call.Arguments, capture(arg, "defer.arg"+strconv.Itoa(i)))
+	}
+	if failed {
+		return nil, nil
+	}
+
+	thunk := g.generateDeferThunk(name, call, captured)
+
+	// Ortamı oluştur
+	var env value.Value = constant.NewNull(bytePtr)
+	if len(captured) > 0 {
+		fields := make([]types.Type, len(captured))
+		for i, c := range captured {
+			fields[i] = c.val.Type()
+		}
+		envType := types.NewStruct(fields...)
+		env = g.currentBB.NewCall(g.getMallocFunction(), sizeOf(envType))
+		typed := g.currentBB.NewBitCast(env, types.NewPointer(envType))
+		for i, c := range captured {
+			slot := g.currentBB.NewGetElementPtr(envType, typed,
+				constant.NewInt(types.I32, 0), constant.NewInt(types.I32, int64(i)))
+			g.currentBB.NewStore(c.val, slot)
+		}
+	}
+	return thunk, env
+}
+
+// generateDeferThunk, ertelenen veya goroutine'de başlatılan çağrıyı yapan
+// thunk'ı üretir. Thunk'ın gövdesinde yalnızca global semboller ve ortamdaki
+// değerler görünür.
+func (g *IRGenerator) generateDeferThunk(name string, call *ast.CallExpression, captured []deferredValue) *ir.Func {
+	fn := g.module.NewFunc(name, types.Void, ir.NewParam("env", bytePtr))
+	fn.Linkage = enum.LinkageInternal
+
+	prevFunc := g.currentFunc
+	prevBB := g.currentBB
+	restore := g.enterFunctionScope(nil)
+
+	g.currentFunc = fn
+	entryBlock := fn.NewBlock("entry")
+	g.currentBB = entryBlock
+
+	g.bindCaptured(entryBlock, nil, nil, nil)
+	if len(captured) > 0 {
+		fields := make([]types.Type, len(captured))
+		for i, c := range captured {
+			fields[i] = c.val.Type()
+		}
+		envType := types.NewStruct(fields...)
+		env := entryBlock.NewBitCast(fn.Params[0], types.NewPointer(envType))
+		for i, c := range captured {
+			slot := entryBlock.NewGetElementPtr(envType, env,
+				constant.NewInt(types.I32, 0), constant.NewInt(types.I32, int64(i)))
+			slot.SetName(c.name)
+			g.symbolTable[c.name] = slot
+			g.unsignedVars[c.name] = c.unsigned
+			g.namedVars[c.name] = c.named
+		}
+	}
+
+	g.generateCallExpression(call)
+	if g.currentBB.Term == nil {
+		g.currentBB.NewRet(nil)
+	}
+
+	restore()
+	g.currentFunc = prevFunc
+	g.currentBB = prevBB
+	return fn
+}
+
+// generatePanicCall, yerleşik panic(v) çağrısı için IR üretir.
+func (g *IRGenerator) generatePanicCall(args []ast.Expression) value.Value {
+	if len(args) != 1 {
+		g.ReportError("panic fonksiyonu 1 argüman alır, %d verildi", len(args))
+		return nil
+	}
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, panic çağrısı yapılamıyor")
+		return nil
+	}
+
+	val := g.generateExpression(args[0])
+	if val == nil {
+		return nil
+	}
+	g.generatePanic(val)
+	return nil
+}
+
+// generatePanic, bir değerle panic başlatır. Değer interface{} olarak
+// recover'a iletilir. panic'ten sonraki (erişilemeyen) ifadeler yeni bir
+// bloğa üretilir.
+func (g *IRGenerator) generatePanic(val value.Value) {
+	msg := g.panicMessage(val)
+	boxed := g.convertToInterface(val, g.anyInterface())
+	g.currentBB.NewCall(g.getRuntimePanicFunc(), msg, boxed)
+	g.currentBB.NewUnreachable()
+	g.currentBB = g.currentFunc.NewBlock("")
+}
+
+// panicMessage, panic'e verilen değer için yazdırılacak mesajı üretir.
+// string'ler aynen, error'lar Error() sonucuyla, sayılar ve bool'lar
+// değerleriyle, diğer değerler tiplerinin adıyla yazdırılır.
+func (g *IRGenerator) panicMessage(val value.Value) value.Value {
+	if _, isNull := val.(*constant.Null); isNull {
+		return g.stringConstant("nil")
+	}
+
+	t := val.Type()
+	if g.isStringType(t) {
+		return g.cString(val)
+	}
+	switch tt := t.(type) {
+	case *types.IntType:
+		if tt.BitSize == 1 {
+			return g.currentBB.NewSelect(val, g.stringConstant("true"), g.stringConstant("false"))
+		}
+		wide := value.Value(val)
+		if tt.BitSize < 64 {
+			wide = g.currentBB.NewSExt(val, types.I64)
+		}
+		return g.formatValue("%lld", wide)
+	case *types.FloatType:
+		wide := value.Value(val)
+		if tt.Kind != types.FloatKindDouble {
+			wide = g.currentBB.NewFPExt(val, types.Double)
+		}
+		return g.formatValue("%g", wide)
+	}
+
+	errorInfo := g.interfaceInfo(g.typeTable["error"])
+	if iface := g.interfaceInfo(t); iface != nil {
+		return g.interfaceMessage(val, iface, errorInfo)
+	}
+	itab, name := g.valueItab(val, errorInfo)
+	if itab != nil {
+		return g.cString(g.generateInterfaceMethodCall(g.convertToInterface(val, errorInfo), errorInfo, "Error", &ast.CallExpression{}))
+	}
+	return g.stringConstant(name)
+}
+
+// formatValue, tek bir değeri snprintf ile yığında ayrılan bir string'e yazar.
+func (g *IRGenerator) formatValue(format string, val value.Value) value.Value {
+	const size = 32
+	buf := g.currentBB.NewCall(g.getMallocFunction(), constant.NewInt(types.I64, size))
+	g.currentBB.NewCall(g.getSnprintfFunc(), buf, constant.NewInt(types.I64, size), g.stringConstant(format), val)
+	return buf
+}
+
+// interfaceMessage, bir arayüz değerinin panic mesajını üretir. Dinamik tipi
+// error'u uygulayan değerler için Error() çağrılır; string'ler aynen, diğer
+// değerler dinamik tiplerinin adıyla yazdırılır.
+func (g *IRGenerator) interfaceMessage(val value.Value, iface, errorInfo *InterfaceInfo) value.Value {
+	g.labelCounter++
+	labelSuffix := "." + strconv.Itoa(g.labelCounter)
+	errorBlock := g.currentFunc.NewBlock("panicmsg.error" + labelSuffix)
+	otherBlock := g.currentFunc.NewBlock("panicmsg.other" + labelSuffix)
+	stringBlock := g.currentFunc.NewBlock("panicmsg.string" + labelSuffix)
+	endBlock := g.currentFunc.NewBlock("panicmsg.end" + labelSuffix)
+
+	asError := g.convertInterface(val, iface, errorInfo)
+	isError := g.currentBB.NewICmp(enum.IPredNE, g.currentBB.NewExtractValue(asError, 0), constant.NewNull(bytePtr))
+	g.currentBB.NewCondBr(isError, errorBlock, otherBlock)
+
+	g.currentBB = errorBlock
+	errorMsg := g.cString(g.generateInterfaceMethodCall(asError, errorInfo, "Error", &ast.CallExpression{}))
+	errorEnd := g.currentBB
+	g.currentBB.NewBr(endBlock)
+
+	// Dinamik tipi olmayan (nil) arayüzler "nil" olarak yazdırılır
+	g.currentBB = otherBlock
+	desc := g.dynamicType(val)
+	isNil := g.currentBB.NewICmp(enum.IPredEQ, desc, constant.NewNull(bytePtr))
+	typeMsg := g.currentBB.NewSelect(isNil, g.stringConstant("nil"), desc)
+	isString := g.currentBB.NewICmp(enum.IPredEQ, desc, g.descriptorByName("string"))
+	g.currentBB.NewCondBr(isString, stringBlock, endBlock)
+
+	g.currentBB = stringBlock
+	stringMsg := g.cString(g.unboxInterface(val, g.stringType()))
+	g.currentBB.NewBr(endBlock)
+
+	g.currentBB = endBlock
+	return g.currentBB.NewPhi(
+		ir.NewIncoming(errorMsg, errorEnd),
+		ir.NewIncoming(typeMsg, otherBlock),
+		ir.NewIncoming(stringMsg, stringBlock))
+}
+
+// generateRecoverCall, yerleşik recover() çağrısı için IR üretir. Süren bir
+// panic varsa panic durdurulur ve panic'e verilen değer döndürülür; aksi
+// halde sonuç nil'dir. recover, ertelenen çağrıların çağırdığı
+// fonksiyonlarda da panic'i durdurur.
+func (g *IRGenerator) generateRecoverCall(args []ast.Expression) value.Value {
+	if len(args) != 0 {
+		g.ReportError("recover fonksiyonu argüman almaz, %d verildi", len(args))
+		return nil
+	}
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, recover çağrısı yapılamıyor")
+		return nil
+	}
+
+	anyType := g.anyInterface().Type
+	panicking := g.currentBB.NewLoad(types.I1, g.panickingGlobal())
+	val := g.currentBB.NewLoad(anyType, g.panicValueGlobal())
+	result := g.currentBB.NewSelect(panicking, val, constant.NewZeroInitializer(anyType))
+	g.currentBB.NewStore(constant.False, g.panickingGlobal())
+	g.currentBB.NewStore(constant.NewZeroInitializer(anyType), g.panicValueGlobal())
+	return result
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/inkbytefo/go-minus/internal/ast"
 
 	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
 	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
 )
 
 // ExceptionInfo, bir istisna bloğu hakkında bilgi tutar.
@@ -16,16 +18,24 @@ type ExceptionInfo struct {
 	FinallyBlock *ir.Block
 	LandingPad   *ir.Block
 	ResumeBlock  *ir.Block
+	Frame        value.Value // try bloğunun çerçevesi (bkz. defer.go)
 }
 
 // CatchBlockInfo, bir catch bloğu hakkında bilgi tutar.
 type CatchBlockInfo struct {
-	ExceptionType types.Type
+	ExceptionType types.Type // Tipsiz catch'lerde nil; her değeri yakalar
+	NamedType     *NamedInfo
 	Block         *ir.Block
 	Variable      string
 }
 
-// generateTryCatchStatement, bir try-catch deyimi için IR üretir.
+// generateTryCatchStatement, bir try-catch deyimi için IR üretir. try bloğu
+// defer ile aynı çerçeve zincirinde bir çerçeve oluşturur (bkz. defer.go);
+// blokta başlayan panic ve throw'lar landing pad'e döner. Landing pad
+// catch'leri sırayla dener: tipli catch'ler fırlatılan değerin dinamik
+// tipiyle eşleşir, tipsiz catch her değeri yakalar. Eşleşen catch panic'i
+// durdurur; eşleşen catch yoksa finally bloğundan sonra panic resume
+// bloğunda bir dıştaki çerçeveye iletilir.
 func (g *IRGenerator) generateTryCatchStatement(stmt *ast.TryCatchStatement) {
 	if g.currentFunc == nil {
 		g.ReportError("Geçerli bir fonksiyon yok, try-catch deyimi değerlendirilemiyor")
@@ -38,37 +48,39 @@ func (g *IRGenerator) generateTryCatchStatement(stmt *ast.TryCatchStatement) {
 	}
 
 	// Blokları oluştur
-	tryBlock := g.currentFunc.NewBlock("try")
-	landingPad := g.currentFunc.NewBlock("landingpad")
-	resumeBlock := g.currentFunc.NewBlock("resume")
-	endBlock := g.currentFunc.NewBlock("try.end")
+	g.labelCounter++
+	labelSuffix := fmt.Sprintf(".%d", g.labelCounter)
+	tryBlock := g.currentFunc.NewBlock("try" + labelSuffix)
+	landingPad := g.currentFunc.NewBlock("landingpad" + labelSuffix)
+	resumeBlock := g.currentFunc.NewBlock("resume" + labelSuffix)
+	endBlock := g.currentFunc.NewBlock("try.end" + labelSuffix)
 
 	// Catch blokları oluştur
 	catchBlocks := make([]*CatchBlockInfo, len(stmt.Catches))
 	for i, catch := range stmt.Catches {
-		catchBlock := g.currentFunc.NewBlock(fmt.Sprintf("catch.%d", i))
+		catchBlocks[i] = &CatchBlockInfo{
+			Block: g.currentFunc.NewBlock(fmt.Sprintf("catch.%d%s", i, labelSuffix)),
+		}
+		if catch.Parameter != nil {
+			catchBlocks[i].Variable = catch.Parameter.Value
+		}
 
 		// İstisna tipini belirle
-		var exceptionType types.Type = types.I8Ptr // Varsayılan olarak void*
 		if catch.Type != nil {
-			if typeIdent, ok := catch.Type.(*ast.Identifier); ok {
-				if t, exists := g.typeTable[typeIdent.Value]; exists {
-					exceptionType = t
-				}
+			if catchBlocks[i].ExceptionType = g.resolveType(catch.Type); catchBlocks[i].ExceptionType == nil {
+				return
 			}
-		}
-
-		catchBlocks[i] = &CatchBlockInfo{
-			ExceptionType: exceptionType,
-			Block:         catchBlock,
-			Variable:      catch.Parameter.Value,
+			catchBlocks[i].NamedType = g.namedTypeInfo(catch.Type)
 		}
 	}
 
-	// Finally bloğu oluştur
+	// Finally bloğu oluştur; eşleşen catch yoksa finally'den sonra panic sürer
 	var finallyBlock *ir.Block
+	var rethrow value.Value
 	if stmt.Finally != nil {
-		finallyBlock = g.currentFunc.NewBlock("finally")
+		finallyBlock = g.currentFunc.NewBlock("finally" + labelSuffix)
+		rethrow = g.entryAlloca(types.I1, "rethrow"+labelSuffix)
+		g.currentBB.NewStore(constant.False, rethrow)
 	}
 
 	// İstisna bilgisini oluştur
@@ -80,74 +92,77 @@ func (g *IRGenerator) generateTryCatchStatement(stmt *ast.TryCatchStatement) {
 		ResumeBlock:  resumeBlock,
 	}
 
+	// Try bloğunun çerçevesini oluştur
+	exceptionInfo.Frame = g.pushFrame(tryBlock, landingPad)
+
 	// İstisna bilgisini yığına ekle
 	g.exceptionStack = append(g.exceptionStack, exceptionInfo)
 
-	// Try bloğuna git
-	g.currentBB.NewBr(tryBlock)
+	// Eğer try bloğu bir dönüş ifadesi ile bitmiyorsa, finally bloğuna veya end bloğuna git
+	after := endBlock
+	if finallyBlock != nil {
+		after = finallyBlock
+	}
 
 	// Try bloğunu işle
 	g.currentBB = tryBlock
 	g.generateBlockStatement(stmt.Try)
+	if g.currentBB.Term == nil {
+		g.popFrame(exceptionInfo.Frame)
+		g.currentBB.NewBr(after)
+	}
 
-	// Eğer try bloğu bir dönüş ifadesi ile bitmiyorsa, finally bloğuna veya end bloğuna git
+	// İstisna bilgisini yığından çıkar
+	g.exceptionStack = g.exceptionStack[:len(g.exceptionStack)-1]
+
+	// Landing pad bloğunu işle: çerçeveyi çıkar ve catch'leri sırayla dene
+	g.currentBB = landingPad
+	g.popFrame(exceptionInfo.Frame)
+	anyInfo := g.anyInterface()
+	exception := g.currentBB.NewLoad(anyInfo.Type, g.panicValueGlobal())
+	desc := g.dynamicType(exception)
+	for i, catch := range catchBlocks {
+		if catch.ExceptionType == nil {
+			g.currentBB.NewBr(catch.Block)
+			break
+		}
+		matches := g.typeMatches(exception, anyInfo, desc, catch.ExceptionType, catch.NamedType)
+		nextBlock := g.currentFunc.NewBlock(fmt.Sprintf("catch.next.%d%s", i, labelSuffix))
+		g.currentBB.NewCondBr(matches, catch.Block, nextBlock)
+		g.currentBB = nextBlock
+	}
 	if g.currentBB.Term == nil {
 		if finallyBlock != nil {
+			g.currentBB.NewStore(constant.True, rethrow)
 			g.currentBB.NewBr(finallyBlock)
 		} else {
-			g.currentBB.NewBr(endBlock)
+			g.currentBB.NewBr(resumeBlock)
 		}
 	}
 
-	// Landing pad bloğunu işle
-	g.currentBB = landingPad
-
-	// TODO: LLVM API değişikliği nedeniyle geçici olarak devre dışı
-	// personalityFunc := g.getPersonalityFunction()
-	// catchType := types.NewStruct(types.I8Ptr, types.I32)
-	// landingPadInst := g.currentBB.NewLandingPad(catchType, personalityFunc)
-	// landingPadInst.Cleanup = true
-
-	for range catchBlocks {
-		// Catch tipi için bir filtre ekle
-		// TODO: LLVM API değişikliği nedeniyle geçici olarak devre dışı
-		// typeInfo := g.getTypeInfo(catch.ExceptionType)
-		// landingPadInst.Clauses = append(landingPadInst.Clauses, ir.NewCatch(typeInfo))
-	}
-
-	// İstisna tipine göre uygun catch bloğuna git
-	// Not: Basitleştirilmiş yaklaşım, gerçek implementasyonda daha karmaşık olabilir
-	// TODO: LLVM API değişikliği nedeniyle geçici olarak devre dışı
-	// exceptionPtr := g.currentBB.NewExtractValue(landingPadInst, 0)
-
 	// Catch bloklarını işle
 	for i, catch := range catchBlocks {
-		// Catch bloğunu işle
+		// Catch bloğunu işle; panic durdurulur
 		g.currentBB = catch.Block
+		g.currentBB.NewStore(constant.False, g.panickingGlobal())
 
 		// İstisna değişkenini tanımla
-		exceptionVar := g.currentBB.NewAlloca(catch.ExceptionType)
-		exceptionVar.SetName(catch.Variable)
-
-		// İstisna değerini değişkene ata
-		// TODO: LLVM API değişikliği nedeniyle geçici olarak devre dışı
-		// typedExceptionPtr := g.currentBB.NewBitCast(exceptionPtr, types.NewPointer(catch.ExceptionType))
-		// exceptionVal := g.currentBB.NewLoad(catch.ExceptionType, typedExceptionPtr)
-		// g.currentBB.NewStore(exceptionVal, exceptionVar)
-
-		// Değişkeni sembol tablosuna ekle
-		g.symbolTable[catch.Variable] = exceptionVar
+		restore := func() {}
+		if catch.Variable != "" && catch.Variable != "_" {
+			var caseTypes []types.Type
+			if catch.ExceptionType != nil {
+				caseTypes = []types.Type{catch.ExceptionType}
+			}
+			restore = g.bindTypeSwitchValue(catch.Variable, exception, anyInfo, caseTypes, []*NamedInfo{catch.NamedType})
+		}
 
 		// Catch bloğunu işle
 		g.generateBlockStatement(stmt.Catches[i].Body)
+		restore()
 
 		// Eğer catch bloğu bir dönüş ifadesi ile bitmiyorsa, finally bloğuna veya end bloğuna git
 		if g.currentBB.Term == nil {
-			if finallyBlock != nil {
-				g.currentBB.NewBr(finallyBlock)
-			} else {
-				g.currentBB.NewBr(endBlock)
-			}
+			g.currentBB.NewBr(after)
 		}
 	}
 
@@ -156,20 +171,16 @@ func (g *IRGenerator) generateTryCatchStatement(stmt *ast.TryCatchStatement) {
 		g.currentBB = finallyBlock
 		g.generateBlockStatement(stmt.Finally)
 
-		// Eğer finally bloğu bir dönüş ifadesi ile bitmiyorsa, end bloğuna git
+		// Eğer finally bloğu bir dönüş ifadesi ile bitmiyorsa, end bloğuna veya resume bloğuna git
 		if g.currentBB.Term == nil {
-			g.currentBB.NewBr(endBlock)
+			g.currentBB.NewCondBr(g.currentBB.NewLoad(types.I1, rethrow), resumeBlock, endBlock)
 		}
 	}
 
-	// Resume bloğunu işle
+	// Resume bloğunu işle: panic bir dıştaki çerçeveye iletilir
 	g.currentBB = resumeBlock
-	// TODO: LLVM API değişikliği nedeniyle geçici olarak devre dışı
-	// g.currentBB.NewResume(landingPadInst)
+	g.generateRepanic()
 
 	// End bloğuna geç
 	g.currentBB = endBlock
-
-	// İstisna bilgisini yığından çıkar
-	g.exceptionStack = g.exceptionStack[:len(g.exceptionStack)-1]
 }
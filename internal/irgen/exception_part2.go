@@ -11,7 +11,8 @@ import (
 	"github.com/llir/llvm/ir/value"
 )
 
-// generateThrowStatement, bir throw deyimi için IR üretir.
+// generateThrowStatement, bir throw deyimi için IR üretir. Fırlatılan değer
+// panic'e verilmiş gibi en içteki try bloğuna veya defer çerçevesine iletilir.
 func (g *IRGenerator) generateThrowStatement(stmt *ast.ThrowStatement) {
 	if g.currentBB == nil {
 		g.ReportError("Geçerli bir blok yok, throw deyimi değerlendirilemiyor")
@@ -24,19 +25,7 @@ func (g *IRGenerator) generateThrowStatement(stmt *ast.ThrowStatement) {
 		return
 	}
 
-	// İstisna fırlatma fonksiyonunu al
-	throwFunc := g.getThrowFunction()
-
-	// İstisna değerini void* tipine dönüştür
-	exceptionPtr := g.currentBB.NewAlloca(exceptionVal.Type())
-	g.currentBB.NewStore(exceptionVal, exceptionPtr)
-	voidPtr := g.currentBB.NewBitCast(exceptionPtr, types.I8Ptr)
-
-	// İstisna fırlatma fonksiyonunu çağır
-	g.currentBB.NewCall(throwFunc, voidPtr)
-
-	// Unreachable ekle
-	g.currentBB.NewUnreachable()
+	g.generatePanic(exceptionVal)
 }
 
 // getPersonalityFunction, istisna işleme için personality fonksiyonunu döndürür.
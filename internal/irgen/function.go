@@ -0,0 +1,426 @@
+package irgen
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// parameterName, bir parametrenin adını döndürür; adsız parametreler için
+// boş dize döner.
+func parameterName(param *ast.Parameter) string {
+	if param.Name == nil {
+		return ""
+	}
+	return param.Name.Value
+}
+
+// parameterType, bir parametrenin LLVM tipini belirler. Tipi belirtilmemiş
+// eski stil parametreler int32 kabul edilir.
+func (g *IRGenerator) parameterType(param *ast.Parameter) types.Type {
+	if param.Type == nil {
+		return types.I32
+	}
+	if t := g.resolveType(param.Type); t != nil {
+		return t
+	}
+	return types.I32
+}
+
+// resultType, bir fonksiyonun dönüş tipini belirler. Birden çok sonuç
+// alanları sonuç tiplerinden oluşan adsız bir struct olarak döndürülür.
+// Dönüş tipi belirtilmemiş fonksiyonlar int32 döndürür.
+func (g *IRGenerator) resultType(returnType ast.Expression) types.Type {
+	if returnType == nil {
+		return types.I32
+	}
+	if results, ok := returnType.(*ast.ResultList); ok {
+		fields := make([]types.Type, len(results.Results))
+		for i, result := range results.Results {
+			fields[i] = g.parameterType(result)
+		}
+		if len(fields) == 1 {
+			return fields[0]
+		}
+		return types.NewStruct(fields...)
+	}
+	if t := g.resolveType(returnType); t != nil {
+		return t
+	}
+	return types.I32
+}
+
+// newFunction, parametre ve dönüş tipleri bildirimden belirlenen bir
+// fonksiyon oluşturur. leading, bildirilen parametrelerden önce gelen gizli
+// parametrelerdir (ör. closure ortamı).
+func (g *IRGenerator) newFunction(name string, params []*ast.Parameter, returnType ast.Expression, leading ...*ir.Param) *ir.Func {
+	fnParams := leading
+	for _, param := range params {
+		fnParams = append(fnParams, ir.NewParam(parameterName(param), g.parameterType(param)))
+	}
+	fn := g.module.NewFunc(name, g.resultType(returnType), fnParams...)
+	g.variadicSigs[fn.Sig] = ast.IsVariadic(params)
+	return fn
+}
+
+// declareFunctions, global fonksiyonları gövdeleri üretilmeden önce
+// tanımlar; böylece fonksiyonlar bildirimlerinden önce çağrılabilir.
+func (g *IRGenerator) declareFunctions(stmts []ast.Statement) {
+	for _, stmt := range stmts {
+		fs, ok := stmt.(*ast.FunctionStatement)
+		if !ok {
+			continue
+		}
+		if _, exists := g.symbolTable[fs.Name.Value]; exists {
+			continue
+		}
+		g.symbolTable[fs.Name.Value] = g.newFunction(fs.Name.Value, fs.Parameters, fs.ReturnType)
+	}
+}
+
+// enterFunctionScope, bir fonksiyon gövdesi üretilmeden önce yerel sembolleri
+// kaydeder ve gövdedeki fonksiyon değişmezlerinin yakaladığı değişkenleri
+// belirler. Döndürülen fonksiyon gövdede tanımlanan yerel değişkenleri ve
+// parametreleri kaldırarak önceki durumu geri yükler; gövdede oluşturulan
+// fonksiyonlar ve global değişkenler korunur.
+func (g *IRGenerator) enterFunctionScope(body *ast.BlockStatement) func() {
+	symbols := make(map[string]value.Value, len(g.symbolTable))
+	for name, val := range g.symbolTable {
+		symbols[name] = val
+	}
+	unsigned := make(map[string]bool, len(g.unsignedVars))
+	for name, u := range g.unsignedVars {
+		unsigned[name] = u
+	}
+	named := make(map[string]*NamedInfo, len(g.namedVars))
+	for name, info := range g.namedVars {
+		named[name] = info
+	}
+	prevResults := g.resultVars
+	prevEscaping := g.escapingVars
+	prevLabels := g.functionLabels
+	prevFrame := g.deferFrame
+	prevExceptions := g.exceptionStack
+	g.resultVars = nil
+	g.escapingVars = escapingNames(body)
+	g.functionLabels = definedLabels(body)
+	g.deferFrame = nil
+	g.exceptionStack = nil
+
+	return func() {
+		for name, val := range g.symbolTable {
+			switch val.(type) {
+			case *ir.Func, *ir.Global:
+				if _, existed := symbols[name]; !existed {
+					symbols[name] = val
+				}
+			}
+		}
+		g.symbolTable = symbols
+		g.unsignedVars = unsigned
+		g.namedVars = named
+		g.resultVars = prevResults
+		g.escapingVars = prevEscaping
+		g.functionLabels = prevLabels
+		g.deferFrame = prevFrame
+		g.exceptionStack = prevExceptions
+	}
+}
+
+// bindParameters, fonksiyon parametreleri için giriş bloğunda yerel
+// değişkenler oluşturur. Adsız ve "_" adlı parametreler atlanır.
+func (g *IRGenerator) bindParameters(entry *ir.Block, params []*ast.Parameter, values []*ir.Param) {
+	for i, param := range params {
+		name := parameterName(param)
+		if name == "" || name == "_" || i >= len(values) {
+			continue
+		}
+
+		addr := g.newVariable(entry, name, name+".addr", values[i].Typ)
+		entry.NewStore(values[i], addr)
+		g.symbolTable[name] = addr
+		g.unsignedVars[name] = g.isUnsignedTypeExpr(param.Type)
+		g.namedVars[name] = g.namedTypeInfo(param.Type)
+	}
+}
+
+// defineNamedResults, adlandırılmış sonuçlar için giriş bloğunda sıfır
+// değerli yerel değişkenler oluşturur. Çıplak return deyimleri bu
+// değişkenlerin değerlerini döndürür.
+func (g *IRGenerator) defineNamedResults(entry *ir.Block, returnType ast.Expression) {
+	results, ok := returnType.(*ast.ResultList)
+	if !ok || !results.Named() {
+		return
+	}
+
+	g.resultVars = nil
+	for _, result := range results.Results {
+		t := g.parameterType(result)
+		name := parameterName(result)
+		addr := g.newVariable(entry, name, name, t)
+		entry.NewStore(zeroValue(t), addr)
+		g.resultVars = append(g.resultVars, addr)
+		if name != "_" {
+			g.symbolTable[name] = addr
+			g.unsignedVars[name] = g.isUnsignedTypeExpr(result.Type)
+			g.namedVars[name] = g.namedTypeInfo(result.Type)
+		}
+	}
+}
+
+// generateBareReturn, değer almayan bir return deyimi için IR üretir.
+// Adlandırılmış sonuçları olan fonksiyonlar sonuç değişkenlerinin güncel
+// değerlerini, diğerleri dönüş tipinin sıfır değerini döndürür.
+func (g *IRGenerator) generateBareReturn() {
+	g.leaveTryBlocks(0)
+	g.runDefers()
+	g.returnResults()
+}
+
+// generateReturnValue, fonksiyondan verilen değerle döner. defer içeren
+// fonksiyonlarda ertelenen çağrılar dönüşten önce çalışır; adlandırılmış
+// sonuçlar önce sonuç değişkenlerine yazılır, böylece ertelenen çağrıların
+// sonuçlarda yaptığı değişiklikler döndürülen değere yansır.
+func (g *IRGenerator) generateReturnValue(val value.Value) {
+	g.leaveTryBlocks(0)
+	if g.deferFrame == nil {
+		g.currentBB.NewRet(val)
+		return
+	}
+	if len(g.resultVars) == 0 {
+		g.runDefers()
+		g.currentBB.NewRet(val)
+		return
+	}
+
+	if len(g.resultVars) == 1 {
+		g.currentBB.NewStore(val, g.resultVars[0])
+	} else {
+		for i, result := range g.resultVars {
+			g.currentBB.NewStore(g.currentBB.NewExtractValue(val, uint64(i)), result)
+		}
+	}
+	g.runDefers()
+	g.returnResults()
+}
+
+// returnResults, sonuç değişkenlerinin güncel değerlerini, adlandırılmış
+// sonuç yoksa dönüş tipinin sıfır değerini döndürür.
+func (g *IRGenerator) returnResults() {
+	retType := g.currentFunc.Sig.RetType
+	if len(g.resultVars) == 0 {
+		g.currentBB.NewRet(zeroValue(retType))
+		return
+	}
+
+	values := make([]value.Value, len(g.resultVars))
+	for i, result := range g.resultVars {
+		values[i] = g.currentBB.NewLoad(result.Type().(*types.PointerType).ElemType, result)
+	}
+	if len(values) == 1 {
+		g.currentBB.NewRet(values[0])
+		return
+	}
+	g.currentBB.NewRet(g.buildResults(retType, values, nil))
+}
+
+// buildResults, birden çok dönüş değerini fonksiyonun sonuç struct'ında
+// birleştirir. Her değer ilgili alanın tipine dönüştürülür; exprs verilmişse
+// işaretsiz dönüşümler için kullanılır.
+func (g *IRGenerator) buildResults(retType types.Type, values []value.Value, exprs []ast.Expression) value.Value {
+	st, ok := retType.(*types.StructType)
+	if !ok || len(st.Fields) != len(values) {
+		g.ReportError("Yanlış sayıda dönüş değeri: %d verildi", len(values))
+		return zeroValue(retType)
+	}
+
+	var result value.Value = constant.NewZeroInitializer(st)
+	for i, val := range values {
+		unsigned := exprs != nil && g.isUnsignedExpr(exprs[i])
+		result = g.currentBB.NewInsertValue(result, g.convertAssignedValue(val, st.Fields[i], unsigned), uint64(i))
+	}
+	return result
+}
+
+// tupleValues, birden çok değer döndüren bir çağrının sonucunu değerlerine
+// ayırır. Sonuç bir struct değilse nil döner.
+func (g *IRGenerator) tupleValues(call value.Value, count int) []value.Value {
+	st, ok := call.Type().(*types.StructType)
+	if !ok || st.Name() != "" || len(st.Fields) != count {
+		return nil
+	}
+	values := make([]value.Value, count)
+	for i := range values {
+		values[i] = g.currentBB.NewExtractValue(call, uint64(i))
+	}
+	return values
+}
+
+// generateMultiAssignStatement, x, err := f() ve a, b = b, a gibi çoklu
+// atamalar için IR üretir. Sağ taraftaki tüm değerler atamalardan önce
+// değerlendirilir; "_" hedefleri değeri atar.
+func (g *IRGenerator) generateMultiAssignStatement(stmt *ast.MultiAssignStatement) {
+	if g.currentFunc == nil || g.currentBB == nil {
+		g.ReportError("Çoklu atama sadece fonksiyon içinde kullanılabilir")
+		return
+	}
+
+	var values []value.Value
+	var valueExprs []ast.Expression
+	if len(stmt.Values) == 1 && len(stmt.Left) == 2 && isReceiveExpression(stmt.Values[0]) {
+		// v, ok := <-ch
+		if values = g.receiveOkValues(stmt.Values[0]); values == nil {
+			return
+		}
+	} else if len(stmt.Values) == 1 && len(stmt.Left) == 2 && isIndexExpression(stmt.Values[0]) {
+		// v, ok := m[k]
+		if values = g.mapIndexOkValues(stmt.Values[0].(*ast.IndexExpression)); values == nil {
+			return
+		}
+	} else if len(stmt.Values) == 1 && len(stmt.Left) == 2 && isTypeAssertion(stmt.Values[0]) {
+		// v, ok := x.(T)
+		if values = g.typeAssertOkValues(stmt.Values[0].(*ast.TypeAssertExpression)); values == nil {
+			return
+		}
+	} else if len(stmt.Values) == 1 && len(stmt.Left) > 1 {
+		call := g.generateExpression(stmt.Values[0])
+		if call == nil {
+			return
+		}
+		if values = g.tupleValues(call, len(stmt.Left)); values == nil {
+			g.ReportError("Atama uyuşmazlığı: %d hedef ancak %s tek değer döndürür", len(stmt.Left), stmt.Values[0].String())
+			return
+		}
+	} else {
+		if len(stmt.Values) != len(stmt.Left) {
+			g.ReportError("Atama uyuşmazlığı: %d hedef ancak %d değer var", len(stmt.Left), len(stmt.Values))
+			return
+		}
+		for _, expr := range stmt.Values {
+			val := g.generateExpression(expr)
+			if val == nil {
+				return
+			}
+			values = append(values, val)
+		}
+		valueExprs = stmt.Values
+	}
+
+	for i, target := range stmt.Left {
+		var unsigned bool
+		var named *NamedInfo
+		if valueExprs != nil {
+			unsigned = g.isUnsignedExpr(valueExprs[i])
+			named = g.namedTypeOf(valueExprs[i])
+		}
+
+		ident, isIdent := target.(*ast.Identifier)
+		if isIdent && ident.Value == "_" {
+			continue
+		}
+
+		if stmt.Operator == ":=" {
+			if !isIdent {
+				g.ReportError("':=' operatörünün sol tarafı bir tanımlayıcı olmalıdır: %s", target.String())
+				continue
+			}
+			// Zaten tanımlı yerel değişkenlere yeniden atanır
+			if isLocalVariable(g.symbolTable[ident.Value]) {
+				addr, elemType := g.variableAddress(ident.Value)
+				g.currentBB.NewStore(g.convertAssignedValue(values[i], elemType, unsigned), addr)
+				continue
+			}
+			addr := g.newVariable(g.currentBB, ident.Value, ident.Value, values[i].Type())
+			g.currentBB.NewStore(values[i], addr)
+			g.symbolTable[ident.Value] = addr
+			g.unsignedVars[ident.Value] = unsigned
+			g.namedVars[ident.Value] = named
+			continue
+		}
+
+		addr, elemType := g.generateAddress(target)
+		if addr == nil {
+			return
+		}
+		g.currentBB.NewStore(g.convertAssignedValue(values[i], elemType, unsigned), addr)
+	}
+}
+
+// generateArguments, bir çağrının argümanlarını değerlendirip parametre
+// tiplerine uyarlar. Variadic bir fonksiyonun son parametresi fazladan
+// argümanları toplayan slice'tır; f(xs...) ile yayılan slice ise doğrudan
+// geçirilir. Argüman sayısı uyuşmazsa hata raporlanır ve nil döner.
+func (g *IRGenerator) generateArguments(params []types.Type, variadic bool, call *ast.CallExpression) []value.Value {
+	arguments := call.Arguments
+	pack := variadic && !call.Ellipsis.IsValid()
+	fixed := len(params)
+	if pack {
+		fixed--
+		if len(arguments) < fixed {
+			g.ReportError("Çağrı en az %d argüman alır, %d verildi", fixed, len(arguments))
+			return nil
+		}
+	} else if len(arguments) != len(params) {
+		g.ReportError("Çağrı %d argüman alır, %d verildi", len(params), len(arguments))
+		return nil
+	}
+
+	args := make([]value.Value, 0, len(params))
+	for i, arg := range arguments[:fixed] {
+		val := g.generateExpression(arg)
+		if val == nil {
+			return nil
+		}
+		args = append(args, g.convertAssignedValue(val, params[i], g.isUnsignedExpr(arg)))
+	}
+	if pack {
+		slice := g.packVariadicArguments(params[fixed], arguments[fixed:])
+		if slice == nil {
+			return nil
+		}
+		args = append(args, slice)
+	}
+	return args
+}
+
+// packVariadicArguments, variadic bir parametreye verilen argümanları yığında
+// ayrılan bir diziye yazar ve diziyi gösteren bir slice oluşturur. Argüman
+// yoksa slice'ın verisi null, uzunluğu ve kapasitesi sıfırdır.
+func (g *IRGenerator) packVariadicArguments(sliceType types.Type, arguments []ast.Expression) value.Value {
+	ptrType, ok := sliceType.(*types.PointerType)
+	if !ok {
+		g.ReportError("Variadic parametre bir slice olmalıdır")
+		return nil
+	}
+	st := ptrType.ElemType.(*types.StructType)
+	dataType := st.Fields[0].(*types.PointerType)
+
+	var data value.Value = constant.NewNull(dataType)
+	if len(arguments) > 0 {
+		backingType := types.NewArray(uint64(len(arguments)), dataType.ElemType)
+		backing := g.currentBB.NewAlloca(backingType)
+		for i, arg := range arguments {
+			val := g.generateExpression(arg)
+			if val == nil {
+				return nil
+			}
+			elemPtr := g.currentBB.NewGetElementPtr(backingType, backing,
+				constant.NewInt(types.I32, 0), constant.NewInt(types.I32, int64(i)))
+			g.currentBB.NewStore(g.convertAssignedValue(val, dataType.ElemType, g.isUnsignedExpr(arg)), elemPtr)
+		}
+		data = g.currentBB.NewGetElementPtr(backingType, backing,
+			constant.NewInt(types.I32, 0), constant.NewInt(types.I32, 0))
+	}
+
+	// Slice struct: {data *T, len int32, cap int32}
+	slice := g.currentBB.NewAlloca(st)
+	length := constant.NewInt(types.I32, int64(len(arguments)))
+	for i, field := range []value.Value{data, length, length} {
+		fieldPtr := g.currentBB.NewGetElementPtr(st, slice,
+			constant.NewInt(types.I32, 0), constant.NewInt(types.I32, int64(i)))
+		g.currentBB.NewStore(field, fieldPtr)
+	}
+	return slice
+}
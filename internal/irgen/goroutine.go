@@ -0,0 +1,212 @@
+package irgen
+
+import (
+	goruntime "runtime"
+	"strings"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// Goroutine'ler, C runtime'ındaki (goroutine.c) zamanlayıcının sabit
+// boyutlu işçi havuzunda çalışır. go deyimi, defer deyimi gibi çağrılan
+// fonksiyon değerini ve argümanları hemen değerlendirip bir ortama yazar ve
+// çağrıyı yapan thunk'ı ortamla birlikte gominus.go'ya verir. gominus.go
+// goroutine'i gominus_spawn ile çalışma kuyruğuna ekler; bir işçi onu
+// gominus.goroutine giriş fonksiyonunda çalıştırır. Panic durumu ve çerçeve
+// zinciri iş parçacığına özel olduğundan her goroutine kendi ertelenen
+// çağrılarını çalıştırır; durdurulmayan bir panic programı sonlandırır.
+// Çalışan goroutine sayısı atomik olarak güncellenen bir sayaçta tutulur.
+// Runtime POSIX iş parçacıklarını kullandığından Windows hedefinde go deyimi
+// desteklenmez.
+
+// goStartType, yeni bir goroutine'e verilen başlangıç kaydıdır: { thunk, ortam }.
+var goStartType = types.NewStruct(types.NewPointer(deferThunkType), bytePtr)
+
+// goEntryType, gominus_spawn'a verilen giriş fonksiyonunun tipidir.
+var goEntryType = types.NewFunc(bytePtr, bytePtr)
+
+// goroutinesGlobalName, çalışan goroutine sayısını tutan global değişkendir.
+// Ana goroutine'i de saydığından 1 ile başlar.
+const goroutinesGlobalName = "gominus.goroutines"
+
+// Çalışma zamanı fonksiyonlarının adları.
+const (
+	goFuncName         = "gominus.go"
+	goroutineEntryName = "gominus.goroutine"
+)
+
+func (g *IRGenerator) goroutinesGlobal() *ir.Global {
+	global := g.getGlobal(goroutinesGlobalName)
+	if global == nil {
+		global = g.module.NewGlobalDef(goroutinesGlobalName, constant.NewInt(types.I32, 1))
+		global.Linkage = enum.LinkageInternal
+	}
+	return global
+}
+
+// addGoroutines, goroutine sayacına delta ekler ve önceki değeri döndürür.
+func addGoroutines(block *ir.Block, counter value.Value, delta int64) value.Value {
+	return block.NewAtomicRMW(enum.AtomicOpAdd, counter, constant.NewInt(types.I32, delta), enum.AtomicOrderingSequentiallyConsistent)
+}
+
+// getGoroutineEntryFunc, goroutine'lerin giriş fonksiyonu
+// gominus.goroutine(start)'ı döndürür. Fonksiyon başlangıç kaydını okuyup
+// serbest bırakır, thunk'ı ortamla çağırır ve goroutine sayacını azaltır.
+func (g *IRGenerator) getGoroutineEntryFunc() *ir.Func {
+	if fn := g.getFunction(goroutineEntryName); fn != nil {
+		return fn
+	}
+
+	param := ir.NewParam("start", bytePtr)
+	fn := g.module.NewFunc(goroutineEntryName, bytePtr, param)
+	fn.Linkage = enum.LinkageInternal
+	g.symbolTable[goroutineEntryName] = fn
+
+	i32 := func(v int64) constant.Constant { return constant.NewInt(types.I32, v) }
+	entry := fn.NewBlock("entry")
+	start := entry.NewBitCast(param, types.NewPointer(goStartType))
+	thunk := entry.NewLoad(types.NewPointer(deferThunkType), entry.NewGetElementPtr(goStartType, start, i32(0), i32(0)))
+	env := entry.NewLoad(bytePtr, entry.NewGetElementPtr(goStartType, start, i32(0), i32(1)))
+	entry.NewCall(g.getExternalFunction("free", types.Void, ir.NewParam("ptr", bytePtr)), param)
+	entry.NewCall(thunk, env)
+	addGoroutines(entry, g.goroutinesGlobal(), -1)
+	entry.NewRet(constant.NewNull(bytePtr))
+
+	return fn
+}
+
+// getGoFunc, gominus.go(thunk, env) çalışma zamanı fonksiyonunu döndürür.
+// Fonksiyon başlangıç kaydını oluşturur ve thunk'ı çalışma kuyruğuna ekler.
+// Goroutine'i çalıştıracak bir işçi başlatılamazsa panic başlatılır.
+func (g *IRGenerator) getGoFunc() *ir.Func {
+	if fn := g.getFunction(goFuncName); fn != nil {
+		return fn
+	}
+
+	thunkParam := ir.NewParam("thunk", types.NewPointer(deferThunkType))
+	envParam := ir.NewParam("env", bytePtr)
+	fn := g.module.NewFunc(goFuncName, types.Void, thunkParam, envParam)
+	fn.Linkage = enum.LinkageInternal
+	g.symbolTable[goFuncName] = fn
+
+	spawn := g.getExternalFunction("gominus_spawn", types.I32,
+		ir.NewParam("entry", types.NewPointer(goEntryType)), ir.NewParam("arg", bytePtr))
+
+	i32 := func(v int64) constant.Constant { return constant.NewInt(types.I32, v) }
+	entry := fn.NewBlock("entry")
+	started := fn.NewBlock("started")
+	failed := fn.NewBlock("failed")
+
+	data := entry.NewCall(g.getMallocFunction(), sizeOf(goStartType))
+	start := entry.NewBitCast(data, types.NewPointer(goStartType))
+	entry.NewStore(thunkParam, entry.NewGetElementPtr(goStartType, start, i32(0), i32(0)))
+	entry.NewStore(envParam, entry.NewGetElementPtr(goStartType, start, i32(0), i32(1)))
+
+	// Sayaç goroutine kuyruğa eklenmeden artırılır; böylece NumGoroutine yeni
+	// goroutine'i go deyiminden hemen sonra görür
+	counter := g.goroutinesGlobal()
+	addGoroutines(entry, counter, 1)
+	result := entry.NewCall(spawn, g.getGoroutineEntryFunc(), data)
+	entry.NewCondBr(entry.NewICmp(enum.IPredNE, result, i32(0)), started, failed)
+
+	started.NewRet(nil)
+
+	addGoroutines(failed, counter, -1)
+	failed.NewCall(g.getExternalFunction("free", types.Void, ir.NewParam("ptr", bytePtr)), data)
+	failed.NewCall(g.getPanicFunction(), g.stringConstant("goroutine başlatılamadı"))
+	failed.NewUnreachable()
+
+	return fn
+}
+
+// generateGoStatement, bir go deyimi için IR üretir. Çağrı defer deyimindeki
+// gibi hemen değerlendirilip bir thunk'a bağlanır ve thunk yeni bir
+// goroutine'de başlatılır.
+func (g *IRGenerator) generateGoStatement(stmt *ast.GoStatement) {
+	if g.currentFunc == nil || g.currentBB == nil {
+		g.ReportError("go yalnızca fonksiyon içinde kullanılabilir")
+		return
+	}
+
+	if g.targetOS() == "windows" {
+		g.ReportError("go deyimi Windows hedefinde desteklenmiyor")
+		return
+	}
+
+	thunk, env := g.generateBoundCall(stmt.Token, stmt.Call, g.thunkName("go"))
+	if thunk == nil {
+		return
+	}
+	g.currentBB.NewCall(g.getGoFunc(), thunk, env)
+}
+
+// generateRuntimeFunctionCall, runtime package function call'ları için IR üretir.
+func (g *IRGenerator) generateRuntimeFunctionCall(funcName string, args []ast.Expression) value.Value {
+	if len(args) != 0 {
+		g.ReportError("runtime.%s fonksiyonu argüman almaz, %d verildi", funcName, len(args))
+		return nil
+	}
+
+	switch funcName {
+	case "Gosched":
+		// Windows'ta goroutine başlatılamadığından bırakılacak bir şey yoktur
+		if g.targetOS() != "windows" {
+			g.currentBB.NewCall(g.getExternalFunction("gominus_gosched", types.Void))
+		}
+		return nil
+	case "NumGoroutine":
+		return addGoroutines(g.currentBB, g.goroutinesGlobal(), 0)
+	case "NumCPU":
+		name, ok := g.nprocessorsOnln()
+		if !ok {
+			g.ReportError("runtime.NumCPU %s hedefinde desteklenmiyor", g.targetOS())
+			return nil
+		}
+		sysconf := g.getExternalFunction("sysconf", types.I64, ir.NewParam("name", types.I32))
+		count := g.currentBB.NewCall(sysconf, constant.NewInt(types.I32, name))
+		return g.currentBB.NewTrunc(count, types.I32)
+	default:
+		g.ReportError("Desteklenmeyen runtime fonksiyonu: %s", funcName)
+		return nil
+	}
+}
+
+// nprocessorsOnln, hedef işletim sisteminde sysconf'un çevrimiçi işlemci
+// sayısı için aldığı _SC_NPROCESSORS_ONLN değerini döndürür. Hedefte
+// sysconf yoksa ok false olur.
+func (g *IRGenerator) nprocessorsOnln() (name int64, ok bool) {
+	switch g.targetOS() {
+	case "windows":
+		return 0, false
+	case "darwin", "freebsd":
+		return 58, true
+	}
+	return 84, true
+}
+
+// targetOS, hedef üçlüsündeki işletim sistemini döndürür. Üçlü
+// belirtilmemişse derleyicinin çalıştığı platform hedeflenir.
+func (g *IRGenerator) targetOS() string {
+	if g.targetTriple == "" {
+		return goruntime.GOOS
+	}
+	for _, part := range strings.Split(strings.ToLower(g.targetTriple), "-") {
+		switch {
+		case strings.HasPrefix(part, "linux"):
+			return "linux"
+		case strings.HasPrefix(part, "darwin"), strings.HasPrefix(part, "macos"), strings.HasPrefix(part, "ios"):
+			return "darwin"
+		case strings.HasPrefix(part, "windows"), strings.HasPrefix(part, "win32"), strings.HasPrefix(part, "mingw"):
+			return "windows"
+		case strings.HasPrefix(part, "freebsd"):
+			return "freebsd"
+		}
+	}
+	return "linux"
+}
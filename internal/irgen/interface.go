@@ -0,0 +1,1059 @@
+package irgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// Arayüz değerleri { itab, data } çiftleridir. itab, dinamik tipin
+// tanımlayıcısını (ilk eleman) ve arayüz metotlarının fonksiyonlarını ad
+// sırasıyla tutan sabit bir tablodur; data, değerin yığında tutulan kopyasını
+// gösterir. nil arayüz değerinin itab'ı null'dır. Tip tanımlayıcıları her tip
+// için tek olan global string'lerdir ve adresleriyle karşılaştırılır.
+
+// InterfaceInfo, bir arayüz tipi hakkında bilgi tutar.
+type InterfaceInfo struct {
+	Name    string // Anonim arayüzler için boş
+	Type    *types.StructType
+	Methods map[string]*types.FuncType // Arayüzün kendi metotları (alıcı i8* olarak)
+	Embeds  []*InterfaceInfo
+}
+
+// MethodSet, gömülü arayüzlerinkiler dahil tüm metotları döndürür.
+func (info *InterfaceInfo) MethodSet() map[string]*types.FuncType {
+	methods := make(map[string]*types.FuncType)
+	info.collectMethods(methods, map[*InterfaceInfo]bool{})
+	return methods
+}
+
+func (info *InterfaceInfo) collectMethods(methods map[string]*types.FuncType, visited map[*InterfaceInfo]bool) {
+	if visited[info] {
+		return
+	}
+	visited[info] = true
+
+	for name, sig := range info.Methods {
+		methods[name] = sig
+	}
+	for _, embed := range info.Embeds {
+		embed.collectMethods(methods, visited)
+	}
+}
+
+// MethodNames, metot adlarını itab'daki sırayla döndürür.
+func (info *InterfaceInfo) MethodNames() []string {
+	methods := info.MethodSet()
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// bytePtr, i8* tipidir; itab ve data işaretçileri bu tiple tutulur.
+var bytePtr = types.NewPointer(types.I8)
+
+// newInterfaceType, bir arayüz değerinin LLVM tipini oluşturur.
+func newInterfaceType() *types.StructType {
+	return types.NewStruct(bytePtr, bytePtr)
+}
+
+// interfaceInfo, t bir arayüz tipiyse arayüzün bilgisini döndürür.
+func (g *IRGenerator) interfaceInfo(t types.Type) *InterfaceInfo {
+	if st, ok := t.(*types.StructType); ok {
+		return g.interfaceTable[st]
+	}
+	return nil
+}
+
+// defineInterfaceMethods, bir arayüz tipinin metotlarını ve gömülü
+// arayüzlerini çözümleyip arayüz bilgisine ekler.
+func (g *IRGenerator) defineInterfaceMethods(it *ast.InterfaceType, info *InterfaceInfo) {
+	for _, embed := range it.Embeds {
+		if embedded := g.interfaceInfo(g.resolveType(embed)); embedded != nil {
+			info.Embeds = append(info.Embeds, embedded)
+		}
+	}
+	for _, method := range it.Methods {
+		info.Methods[method.Name.Value] = g.methodSignature(bytePtr, method.Parameters, method.ReturnType)
+	}
+}
+
+// methodSignature, alıcısı recv tipinde olan bir metodun fonksiyon tipini
+// oluşturur. Parametre ve dönüş tipleri fonksiyonlardaki gibi belirlenir.
+func (g *IRGenerator) methodSignature(recv types.Type, params []*ast.Parameter, returnType ast.Expression) *types.FuncType {
+	paramTypes := []types.Type{recv}
+	for _, param := range params {
+		paramTypes = append(paramTypes, g.parameterType(param))
+	}
+	sig := types.NewFunc(g.resultType(returnType), paramTypes...)
+	g.variadicSigs[sig] = ast.IsVariadic(params)
+	return sig
+}
+
+// declareMethods, alıcılı metot bildirimlerinin fonksiyonlarını gövdeleri
+// üretilmeden önce tanımlar; böylece metotlar bildirimlerinden önce
+// çağrılabilir ve itab'lara eklenebilir. T tipindeki bir alıcının metodu,
+// alıcıyı %T* olarak alan @T.Metot fonksiyonudur. Dayanak tipiyle temsil
+// edilen adlandırılmış tiplerde alıcı dayanak tipe işaretçidir (ör.
+// @Celsius.String(double*)).
+func (g *IRGenerator) declareMethods(stmts []ast.Statement) {
+	for _, stmt := range stmts {
+		ms, ok := stmt.(*ast.MethodStatement)
+		if !ok {
+			continue
+		}
+
+		recvType, typeName, methods := g.receiverMethods(ms)
+		if methods == nil {
+			g.ReportError("Metot alıcısı bir struct veya adlandırılmış tip olmalıdır: %s", ms.Receiver.Value)
+			continue
+		}
+
+		sig := g.methodSignature(types.NewPointer(recvType), ms.Parameters, ms.ReturnType)
+		recvName := "recv"
+		if ms.ReceiverName != nil {
+			recvName = ms.ReceiverName.Value
+		}
+		params := []*ir.Param{ir.NewParam(recvName, sig.Params[0])}
+		for i, param := range ms.Parameters {
+			params = append(params, ir.NewParam(parameterName(param), sig.Params[i+1]))
+		}
+		fn := g.module.NewFunc(typeName+"."+ms.Name.Value, sig.RetType, params...)
+		g.variadicSigs[fn.Sig] = g.variadicSigs[sig]
+		methods[ms.Name.Value] = fn
+	}
+}
+
+// receiverInfo, bir metodun alıcı tipinin struct bilgisini döndürür.
+func (g *IRGenerator) receiverInfo(stmt *ast.MethodStatement) *StructInfo {
+	if st, ok := g.typeTable[stmt.Receiver.Value].(*types.StructType); ok {
+		return g.structTable[st]
+	}
+	return nil
+}
+
+// receiverMethods, bir metodun alıcı tipini, metot adlarında kullanılan tip
+// adını ve tipin metot tablosunu döndürür. Alıcı bir struct veya adlandırılmış
+// tip değilse metot tablosu nil'dir.
+func (g *IRGenerator) receiverMethods(stmt *ast.MethodStatement) (types.Type, string, map[string]*ir.Func) {
+	if info := g.receiverInfo(stmt); info != nil {
+		if info.Methods == nil {
+			info.Methods = make(map[string]*ir.Func)
+		}
+		return info.Type, info.Type.Name(), info.Methods
+	}
+	if info := g.namedTable[stmt.Receiver.Value]; info != nil {
+		return info.Type, info.Name, info.Methods
+	}
+	return nil, "", nil
+}
+
+// generateMethodStatement, declareMethods ile tanımlanan bir metodun
+// gövdesini üretir. Değer alıcıların metot başında yerel bir kopyası
+// oluşturulur; işaretçi alıcılar (func (p *T)) aldıkları adresi kullanır.
+func (g *IRGenerator) generateMethodStatement(stmt *ast.MethodStatement) {
+	recvType, _, methods := g.receiverMethods(stmt)
+	if methods == nil {
+		return
+	}
+	fn := methods[stmt.Name.Value]
+	if fn == nil || len(fn.Blocks) > 0 {
+		return
+	}
+
+	prevFunc := g.currentFunc
+	prevBB := g.currentBB
+	restore := g.enterFunctionScope(stmt.Body)
+	g.currentFunc = fn
+	entryBlock := fn.NewBlock("entry")
+	g.currentBB = entryBlock
+
+	// Alıcı, parametreler ve adlandırılmış sonuçlar metoddan sonra önceki
+	// tanımlarına döner
+	if name := stmt.ReceiverName; name != nil && name.Value != "_" {
+		if stmt.Pointer {
+			addr := g.newVariable(entryBlock, name.Value, name.Value+".addr", fn.Params[0].Type())
+			entryBlock.NewStore(fn.Params[0], addr)
+			g.symbolTable[name.Value] = addr
+			g.namedVars[name.Value] = nil
+		} else {
+			addr := g.newVariable(entryBlock, name.Value, name.Value+".addr", recvType)
+			entryBlock.NewStore(entryBlock.NewLoad(recvType, fn.Params[0]), addr)
+			g.symbolTable[name.Value] = addr
+			g.namedVars[name.Value] = g.namedTable[stmt.Receiver.Value]
+		}
+		g.unsignedVars[name.Value] = false
+	}
+	g.bindParameters(entryBlock, stmt.Parameters, fn.Params[1:])
+	g.defineNamedResults(entryBlock, stmt.ReturnType)
+	g.enterDeferFrame(stmt.Body)
+
+	if stmt.Body != nil {
+		g.generateBlockStatement(stmt.Body)
+	}
+	if g.currentBB.Term == nil {
+		g.generateBareReturn()
+	}
+
+	restore()
+	g.currentFunc = prevFunc
+	g.currentBB = prevBB
+}
+
+// zeroValue, bir tipin sıfır değerini döndürür.
+func zeroValue(t types.Type) constant.Constant {
+	if intType, ok := t.(*types.IntType); ok {
+		return constant.NewInt(intType, 0)
+	}
+	return constant.NewZeroInitializer(t)
+}
+
+// findMethod, bir metodu struct'ta ve gömülü struct'larında en sığ
+// derinlikten başlayarak arar. Metodun fonksiyonunu ve metodu tanımlayan
+// gömülü struct'ın alan indeksi yolunu döndürür.
+func (g *IRGenerator) findMethod(info *StructInfo, name string) ([]int, *ir.Func) {
+	type candidate struct {
+		info *StructInfo
+		path []int
+	}
+
+	current := []candidate{{info: info}}
+	visited := map[*StructInfo]bool{}
+	for len(current) > 0 {
+		var next []candidate
+		for _, c := range current {
+			if visited[c.info] {
+				continue
+			}
+			visited[c.info] = true
+
+			if fn, exists := c.info.Methods[name]; exists {
+				return c.path, fn
+			}
+			for i, field := range c.info.Fields {
+				if innerInfo := g.embeddedInfo(field); innerInfo != nil {
+					next = append(next, candidate{info: innerInfo, path: append(append([]int{}, c.path...), i)})
+				}
+			}
+		}
+		current = next
+	}
+
+	return nil, nil
+}
+
+// methodFunc, bir struct'ın metodunun fonksiyonunu döndürür. Gömülü
+// struct'lardan yükseltilen metotlar için alıcıyı gömülü alana ileten bir
+// sarmalayıcı fonksiyon bir kez üretilir.
+func (g *IRGenerator) methodFunc(info *StructInfo, name string) *ir.Func {
+	path, target := g.findMethod(info, name)
+	if target == nil || len(path) == 0 {
+		return target
+	}
+
+	recv := ir.NewParam("recv", types.NewPointer(info.Type))
+	params := []*ir.Param{recv}
+	for _, param := range target.Params[1:] {
+		params = append(params, ir.NewParam(param.Name(), param.Typ))
+	}
+	wrapper := g.module.NewFunc(g.typeName(info.Type)+"."+name, target.Sig.RetType, params...)
+	entry := wrapper.NewBlock("entry")
+
+	// Gömülü bir işaretçinin metodunda alıcı, işaretçinin kendisidir
+	embedded := g.fieldAddress(entry, info, recv, path)
+	if elem := embedded.Type().(*types.PointerType).ElemType; types.IsPointer(elem) {
+		embedded = entry.NewLoad(elem, embedded)
+	}
+	args := []value.Value{embedded}
+	for _, param := range params[1:] {
+		args = append(args, param)
+	}
+	entry.NewRet(entry.NewCall(target, args...))
+
+	if info.Methods == nil {
+		info.Methods = make(map[string]*ir.Func)
+	}
+	info.Methods[name] = wrapper
+	return wrapper
+}
+
+// implements, t tipinin arayüzün tüm metotlarına sahip olup olmadığını kontrol eder.
+func (g *IRGenerator) implements(t types.Type, iface *InterfaceInfo) bool {
+	names := iface.MethodNames()
+	if len(names) == 0 {
+		return true
+	}
+	if info := g.structPointerInfo(t); info != nil {
+		t = info.Type
+	}
+	st, ok := t.(*types.StructType)
+	info, exists := g.structTable[st]
+	if !ok || !exists {
+		return false
+	}
+	for _, name := range names {
+		if _, fn := g.findMethod(info, name); fn == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// typeName, bir tipin tanımlayıcılarda ve hata mesajlarında kullanılan adını döndürür.
+func (g *IRGenerator) typeName(t types.Type) string {
+	switch tt := t.(type) {
+	case *types.StructType:
+		if tt.Name() != "" {
+			return tt.Name()
+		}
+	case *types.PointerType:
+		if g.structPointerInfo(tt) != nil {
+			return "*" + g.typeName(tt.ElemType)
+		}
+	case *types.IntType:
+		switch tt.BitSize {
+		case 1:
+			return "bool"
+		case 32:
+			return "int"
+		default:
+			return fmt.Sprintf("int%d", tt.BitSize)
+		}
+	case *types.FloatType:
+		if tt.Kind == types.FloatKindFloat {
+			return "float32"
+		}
+		return "float64"
+	}
+	return t.String()
+}
+
+// getGlobal, belirtilen isimde bir global değişkeni döndürür.
+func (g *IRGenerator) getGlobal(name string) *ir.Global {
+	for _, global := range g.module.Globals {
+		if global.Name() == name {
+			return global
+		}
+	}
+	return nil
+}
+
+// typeDescriptor, bir tipin tanımlayıcısını döndürür.
+func (g *IRGenerator) typeDescriptor(t types.Type) constant.Constant {
+	return g.descriptorByName(g.typeName(t))
+}
+
+// descriptorByName, verilen adlı tipin tanımlayıcısını döndürür. Tanımlayıcı,
+// tipin adını tutan ve ilk kullanımda oluşturulan bir global string'dir.
+func (g *IRGenerator) descriptorByName(name string) constant.Constant {
+	global := g.getGlobal("gominus.type." + name)
+	if global == nil {
+		global = g.module.NewGlobalDef("gominus.type."+name, constant.NewCharArrayFromString(name+"\x00"))
+		global.Immutable = true
+	}
+	zero := constant.NewInt(types.I32, 0)
+	return constant.NewGetElementPtr(global.ContentType, global, zero, zero)
+}
+
+// itab, t tipinin arayüz için itab'ını döndürür; t arayüzü uygulamıyorsa nil döner.
+func (g *IRGenerator) itab(t types.Type, iface *InterfaceInfo) constant.Constant {
+	if !g.implements(t, iface) {
+		return nil
+	}
+	return g.buildItab(g.typeName(t), iface, func(method string) *ir.Func {
+		if info := g.structPointerInfo(t); info != nil {
+			return g.methodFunc(info, method)
+		}
+		return g.methodFunc(g.structTable[t.(*types.StructType)], method)
+	})
+}
+
+// namedImplements, adlandırılmış bir tipin arayüzün tüm metotlarına sahip
+// olup olmadığını kontrol eder.
+func namedImplements(info *NamedInfo, iface *InterfaceInfo) bool {
+	for _, name := range iface.MethodNames() {
+		if info.Methods[name] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// namedItab, dayanak tipiyle temsil edilen adlandırılmış bir tipin arayüz
+// için itab'ını döndürür; tip arayüzü uygulamıyorsa nil döner.
+func (g *IRGenerator) namedItab(info *NamedInfo, iface *InterfaceInfo) constant.Constant {
+	if !namedImplements(info, iface) {
+		return nil
+	}
+	return g.buildItab(info.Name, iface, func(method string) *ir.Func {
+		return info.Methods[method]
+	})
+}
+
+// buildItab, verilen adlı tipin arayüz için itab'ını ilk kullanımda oluşturur.
+// İlk eleman tipin tanımlayıcısı, diğerleri sıralı metot adlarına karşılık
+// gelen fonksiyonlardır.
+func (g *IRGenerator) buildItab(typeName string, iface *InterfaceInfo, method func(string) *ir.Func) constant.Constant {
+	names := iface.MethodNames()
+	name := "gominus.itab." + typeName
+	if len(names) > 0 {
+		name += "." + strings.Join(names, ".")
+	}
+
+	global := g.getGlobal(name)
+	if global == nil {
+		slots := []constant.Constant{g.descriptorByName(typeName)}
+		for _, m := range names {
+			slots = append(slots, constant.NewBitCast(method(m), bytePtr))
+		}
+		table := constant.NewArray(types.NewArray(uint64(len(slots)), bytePtr), slots...)
+		global = g.module.NewGlobalDef(name, table)
+		global.Immutable = true
+	}
+	return constant.NewBitCast(global, bytePtr)
+}
+
+// nilItab, nil arayüzlerin tip tanımlayıcısını güvenle okumak için
+// kullanılan, tanımlayıcısı null olan itab'ı döndürür.
+func (g *IRGenerator) nilItab() constant.Constant {
+	global := g.getGlobal("gominus.itab.nil")
+	if global == nil {
+		table := constant.NewArray(types.NewArray(1, bytePtr), constant.NewNull(bytePtr))
+		global = g.module.NewGlobalDef("gominus.itab.nil", table)
+		global.Immutable = true
+	}
+	return constant.NewBitCast(global, bytePtr)
+}
+
+// dynamicType, bir arayüz değerinin dinamik tipinin tanımlayıcısını yükler.
+// nil arayüzler için null döner.
+func (g *IRGenerator) dynamicType(iface value.Value) value.Value {
+	itab := g.currentBB.NewExtractValue(iface, 0)
+	isNil := g.currentBB.NewICmp(enum.IPredEQ, itab, constant.NewNull(bytePtr))
+	safe := g.currentBB.NewSelect(isNil, g.nilItab(), itab)
+	slots := g.currentBB.NewBitCast(safe, types.NewPointer(bytePtr))
+	return g.currentBB.NewLoad(bytePtr, slots)
+}
+
+// convertToInterface, bir değeri arayüz değerine dönüştürür. Somut değerler
+// yığına kopyalanır; arayüz değerleri hedef arayüze uyarlanır.
+func (g *IRGenerator) convertToInterface(val value.Value, iface *InterfaceInfo) value.Value {
+	if from := g.interfaceInfo(val.Type()); from != nil {
+		return g.convertInterface(val, from, iface)
+	}
+	if _, isNull := val.(*constant.Null); isNull {
+		return constant.NewZeroInitializer(iface.Type)
+	}
+
+	itab, typeName := g.valueItab(val, iface)
+	if itab == nil {
+		g.ReportError("%s tipi %s arayüzünü uygulamıyor", typeName, g.interfaceName(iface))
+		return val
+	}
+
+	// Değeri yığına kopyala; struct işaretçileri metotların beklediği alıcı
+	// adresi olduğundan doğrudan saklanır
+	var data value.Value
+	if g.structPointerInfo(val.Type()) != nil {
+		data = g.currentBB.NewBitCast(val, bytePtr)
+	} else {
+		data = g.currentBB.NewCall(g.getMallocFunction(), sizeOf(val.Type()))
+		g.currentBB.NewStore(val, g.currentBB.NewBitCast(data, types.NewPointer(val.Type())))
+	}
+
+	var result value.Value = constant.NewZeroInitializer(iface.Type)
+	result = g.currentBB.NewInsertValue(result, itab, 0)
+	return g.currentBB.NewInsertValue(result, data, 1)
+}
+
+// valueItab, arayüze dönüştürülen bir değerin dinamik tipinin itab'ını ve
+// tipin adını döndürür. Adlandırılmış tipteki değerlerin dinamik tipi,
+// dayanak tipleri değil adlandırılmış tiptir.
+func (g *IRGenerator) valueItab(val value.Value, iface *InterfaceInfo) (constant.Constant, string) {
+	if info := g.namedValues[val]; info != nil {
+		return g.namedItab(info, iface), info.Name
+	}
+	return g.itab(val.Type(), iface), g.typeName(val.Type())
+}
+
+// convertConstantToInterface, global değişkenlerin ilk değeri olarak
+// kullanılan sabit bir değeri arayüz sabitine dönüştürür.
+func (g *IRGenerator) convertConstantToInterface(val constant.Constant, iface *InterfaceInfo) constant.Constant {
+	if _, isNull := val.(*constant.Null); isNull {
+		return constant.NewZeroInitializer(iface.Type)
+	}
+
+	itab, typeName := g.valueItab(val, iface)
+	if itab == nil {
+		g.ReportError("%s tipi %s arayüzünü uygulamıyor", typeName, g.interfaceName(iface))
+		return nil
+	}
+	data := g.module.NewGlobalDef("", val)
+	return constant.NewStruct(iface.Type, itab, constant.NewBitCast(data, bytePtr))
+}
+
+// convertInterface, bir arayüz değerini başka bir arayüz tipine dönüştürür.
+// Metotları aynı olan veya boş arayüzlere dönüşümde itab aynen kullanılır.
+// Diğer durumlarda dinamik tipe göre hedefin itab'ı çalışma zamanında seçilir;
+// dinamik tip hedefi uygulamıyorsa sonuç nil arayüzdür.
+func (g *IRGenerator) convertInterface(val value.Value, from, to *InterfaceInfo) value.Value {
+	if from == to {
+		return val
+	}
+
+	var itab value.Value = g.currentBB.NewExtractValue(val, 0)
+	data := g.currentBB.NewExtractValue(val, 1)
+
+	targetNames := to.MethodNames()
+	if len(targetNames) > 0 && strings.Join(targetNames, ",") != strings.Join(from.MethodNames(), ",") {
+		desc := g.dynamicType(val)
+		var selected value.Value = constant.NewNull(bytePtr)
+		for _, candidate := range g.implementers(to) {
+			matches := g.currentBB.NewICmp(enum.IPredEQ, desc, candidate.desc)
+			selected = g.currentBB.NewSelect(matches, candidate.itab, selected)
+		}
+		itab = selected
+	}
+
+	var result value.Value = constant.NewZeroInitializer(to.Type)
+	result = g.currentBB.NewInsertValue(result, itab, 0)
+	return g.currentBB.NewInsertValue(result, data, 1)
+}
+
+// implementer, bir arayüzü uygulayan adlandırılmış bir tipin tanımlayıcısı ve
+// arayüz için itab'ıdır.
+type implementer struct {
+	name string
+	desc constant.Constant
+	itab constant.Constant
+}
+
+// implementers, arayüzü uygulayan adlandırılmış struct'ları, bu struct'ların
+// işaretçilerini ve adlandırılmış tipleri adlarına göre sıralı olarak
+// döndürür.
+func (g *IRGenerator) implementers(iface *InterfaceInfo) []implementer {
+	var structs []*StructInfo
+	var named []*NamedInfo
+	for _, info := range g.structTable {
+		if info.Name != "" && g.implements(info.Type, iface) {
+			structs = append(structs, info)
+		}
+	}
+	for name, info := range g.namedTable {
+		// Takma adlar, adlandırılmış tipin bilgisini paylaşır
+		if name == info.Name && namedImplements(info, iface) {
+			named = append(named, info)
+		}
+	}
+
+	// Global'ler sıralı oluşturulsun diye itab'lar sıralamadan sonra üretilir
+	sort.Slice(structs, func(i, j int) bool {
+		return g.typeName(structs[i].Type) < g.typeName(structs[j].Type)
+	})
+	sort.Slice(named, func(i, j int) bool {
+		return named[i].Name < named[j].Name
+	})
+
+	var result []implementer
+	for _, info := range structs {
+		result = append(result, implementer{name: g.typeName(info.Type), desc: g.typeDescriptor(info.Type), itab: g.itab(info.Type, iface)})
+		ptr := types.NewPointer(info.Type)
+		result = append(result, implementer{name: g.typeName(ptr), desc: g.typeDescriptor(ptr), itab: g.itab(ptr, iface)})
+	}
+	for _, info := range named {
+		result = append(result, implementer{name: info.Name, desc: g.descriptorByName(info.Name), itab: g.namedItab(info, iface)})
+	}
+	return result
+}
+
+// interfaceName, bir arayüzün hata mesajlarında kullanılan adını döndürür.
+func (g *IRGenerator) interfaceName(iface *InterfaceInfo) string {
+	if iface.Name != "" {
+		return iface.Name
+	}
+	names := iface.MethodNames()
+	if len(names) == 0 {
+		return "interface{}"
+	}
+	return "interface { " + strings.Join(names, "(); ") + "() }"
+}
+
+// generateNullLiteral, nil değeri için IR üretir. nil, atandığı arayüz
+// tipinde sıfır değerine dönüştürülür.
+func (g *IRGenerator) generateNullLiteral() value.Value {
+	return constant.NewNull(bytePtr)
+}
+
+// generateNilComparison, bir arayüz, fonksiyon veya işaretçi değerinin nil
+// ile karşılaştırması (err != nil) için IR üretir. Arayüz değeri itab'ı,
+// fonksiyon değeri fonksiyonu nil ise nil'dir. İşlenenler böyle bir karşılaştırma oluşturmuyorsa nil döner.
+func (g *IRGenerator) generateNilComparison(pred enum.IPred, left, right value.Value) value.Value {
+	if _, isNull := left.(*constant.Null); isNull {
+		left, right = right, left
+	}
+	if _, isNull := right.(*constant.Null); !isNull {
+		return nil
+	}
+
+	if g.interfaceInfo(left.Type()) != nil {
+		return g.currentBB.NewICmp(pred, g.currentBB.NewExtractValue(left, 0), constant.NewNull(bytePtr))
+	}
+	if sig := closureSignature(left.Type()); sig != nil {
+		return g.currentBB.NewICmp(pred, g.currentBB.NewExtractValue(left, 0), constant.NewNull(types.NewPointer(sig)))
+	}
+	if ptr, ok := left.Type().(*types.PointerType); ok {
+		return g.currentBB.NewICmp(pred, left, constant.NewNull(ptr))
+	}
+	return nil
+}
+
+// generateTypeAssertion, bir x.(T) tip iddiası için IR üretir. İddia
+// başarısız olursa program panic ile sonlanır.
+func (g *IRGenerator) generateTypeAssertion(expr *ast.TypeAssertExpression) value.Value {
+	result, _ := g.assertType(expr, false)
+	return result
+}
+
+// isTypeAssertion, bir ifadenin tip iddiası (x.(T)) olup olmadığını belirler.
+func isTypeAssertion(expr ast.Expression) bool {
+	_, ok := expr.(*ast.TypeAssertExpression)
+	return ok
+}
+
+// typeAssertOkValues, v, ok := x.(T) biçimindeki atamalar için iddia edilen
+// değeri ve iddianın başarısını döndürür. Başarısız iddia panic başlatmaz;
+// değer T'nin sıfır değeridir.
+func (g *IRGenerator) typeAssertOkValues(expr *ast.TypeAssertExpression) []value.Value {
+	result, ok := g.assertType(expr, true)
+	if result == nil {
+		return nil
+	}
+	return []value.Value{result, ok}
+}
+
+// assertType, x.(T) için iddia edilen değeri ve dinamik tipin eşleşip
+// eşleşmediğini üretir. commaOk false ise eşleşmeyen tip panic başlatır;
+// true ise değer sıfır değeri olur.
+func (g *IRGenerator) assertType(expr *ast.TypeAssertExpression, commaOk bool) (value.Value, value.Value) {
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, tip iddiası değerlendirilemiyor")
+		return nil, nil
+	}
+
+	val := g.generateExpression(expr.Expression)
+	if val == nil {
+		return nil, nil
+	}
+	from := g.interfaceInfo(val.Type())
+	if from == nil {
+		g.ReportError("Tip iddiası yalnızca arayüz değerlerinde kullanılabilir: %s", expr.String())
+		return nil, nil
+	}
+	target := g.resolveType(expr.Type)
+	if target == nil {
+		return nil, nil
+	}
+
+	if to := g.interfaceInfo(target); to != nil {
+		converted := g.convertInterface(val, from, to)
+		matched := g.currentBB.NewICmp(enum.IPredNE, g.currentBB.NewExtractValue(converted, 0), constant.NewNull(bytePtr))
+		if !commaOk {
+			g.generatePanicIf(g.currentBB.NewXor(matched, constant.True), "assert", "interface conversion: interface is not "+g.interfaceName(to))
+			return converted, matched
+		}
+		return g.currentBB.NewSelect(matched, converted, constant.NewZeroInitializer(target)), matched
+	}
+
+	desc, name := g.typeDescriptor(target), g.typeName(target)
+	named := g.namedTypeInfo(expr.Type)
+	if named != nil {
+		desc, name = g.descriptorByName(named.Name), named.Name
+	}
+	matched := g.currentBB.NewICmp(enum.IPredEQ, g.dynamicType(val), desc)
+
+	var result value.Value
+	if !commaOk {
+		g.generatePanicIf(g.currentBB.NewXor(matched, constant.True), "assert", "interface conversion: interface is not "+name)
+		result = g.unboxInterface(val, target)
+	} else {
+		// Değer yalnızca tip eşleştiğinde yüklenir
+		g.labelCounter++
+		labelSuffix := fmt.Sprintf("%d", g.labelCounter)
+		unboxBlock := g.currentFunc.NewBlock("assert.unbox." + labelSuffix)
+		endBlock := g.currentFunc.NewBlock("assert.end." + labelSuffix)
+		missBlock := g.currentBB
+		g.currentBB.NewCondBr(matched, unboxBlock, endBlock)
+
+		g.currentBB = unboxBlock
+		unboxed := g.unboxInterface(val, target)
+		g.currentBB.NewBr(endBlock)
+
+		g.currentBB = endBlock
+		result = g.currentBB.NewPhi(ir.NewIncoming(unboxed, unboxBlock), ir.NewIncoming(constant.NewZeroInitializer(target), missBlock))
+	}
+	if named != nil {
+		g.namedValues[result] = named
+	}
+	return result, matched
+}
+
+// unboxInterface, dinamik tipi t olduğu bilinen bir arayüz değerinin değerini yükler.
+func (g *IRGenerator) unboxInterface(val value.Value, t types.Type) value.Value {
+	data := g.currentBB.NewExtractValue(val, 1)
+	if g.structPointerInfo(t) != nil {
+		return g.currentBB.NewBitCast(data, t)
+	}
+	return g.currentBB.NewLoad(t, g.currentBB.NewBitCast(data, types.NewPointer(t)))
+}
+
+// generateTypeSwitchStatement, bir tip switch'i için IR üretir. Case'ler
+// sırayla dinamik tip tanımlayıcısıyla karşılaştırılır; arayüz case'leri
+// dönüşümün başarısıyla, nil case'i itab'ın null olmasıyla eşleşir.
+func (g *IRGenerator) generateTypeSwitchStatement(stmt *ast.TypeSwitchStatement) {
+	if g.currentFunc == nil || g.currentBB == nil {
+		g.ReportError("Geçerli bir fonksiyon yok, tip switch'i değerlendirilemiyor")
+		return
+	}
+
+	subject := g.generateExpression(stmt.Subject)
+	if subject == nil {
+		return
+	}
+	from := g.interfaceInfo(subject.Type())
+	if from == nil {
+		g.ReportError("Tip switch'i yalnızca arayüz değerlerinde kullanılabilir: %s", stmt.Subject.String())
+		return
+	}
+
+	g.labelCounter++
+	labelSuffix := fmt.Sprintf("%d", g.labelCounter)
+
+	endBlock := g.currentFunc.NewBlock("typeswitch.end." + labelSuffix)
+	defaultBlock := endBlock
+	caseBlocks := make([]*ir.Block, len(stmt.Cases))
+	caseTypes := make([][]types.Type, len(stmt.Cases)) // nil case için nil
+	caseNamed := make([][]*NamedInfo, len(stmt.Cases))
+	for i, clause := range stmt.Cases {
+		if len(clause.Values) == 0 {
+			caseBlocks[i] = g.currentFunc.NewBlock("typeswitch.default." + labelSuffix)
+			defaultBlock = caseBlocks[i]
+			continue
+		}
+		caseBlocks[i] = g.currentFunc.NewBlock(fmt.Sprintf("typeswitch.case.%d.%s", i, labelSuffix))
+		for _, caseValue := range clause.Values {
+			var caseType types.Type
+			if _, isNil := caseValue.(*ast.NullLiteral); !isNil {
+				if caseType = g.resolveType(caseValue); caseType == nil {
+					return
+				}
+			}
+			caseTypes[i] = append(caseTypes[i], caseType)
+			caseNamed[i] = append(caseNamed[i], g.namedTypeInfo(caseValue))
+		}
+	}
+
+	// Case'leri sırayla dene
+	desc := g.dynamicType(subject)
+	for i := range stmt.Cases {
+		for j, caseType := range caseTypes[i] {
+			var matches value.Value
+			if caseType == nil {
+				matches = g.currentBB.NewICmp(enum.IPredEQ, desc, constant.NewNull(bytePtr))
+			} else {
+				matches = g.typeMatches(subject, from, desc, caseType, caseNamed[i][j])
+			}
+
+			nextBlock := g.currentFunc.NewBlock(fmt.Sprintf("typeswitch.next.%d.%d.%s", i, j, labelSuffix))
+			g.currentBB.NewCondBr(matches, caseBlocks[i], nextBlock)
+			g.currentBB = nextBlock
+		}
+	}
+	g.currentBB.NewBr(defaultBlock)
+
+	// Case gövdeleri; break end bloğuna gider
+	g.pushBranchTarget(endBlock, nil)
+	for i, clause := range stmt.Cases {
+		g.currentBB = caseBlocks[i]
+
+		restore := func() {}
+		if stmt.Binding != nil && stmt.Binding.Value != "_" {
+			restore = g.bindTypeSwitchValue(stmt.Binding.Value, subject, from, caseTypes[i], caseNamed[i])
+		}
+
+		for _, bodyStmt := range clause.Body {
+			g.generateStatement(bodyStmt)
+			if g.currentBB.Term != nil {
+				break
+			}
+		}
+		if g.currentBB.Term == nil {
+			g.currentBB.NewBr(endBlock)
+		}
+		restore()
+	}
+	g.popBranchTarget()
+
+	g.currentBB = endBlock
+}
+
+// typeMatches, dinamik tip tanımlayıcısı desc olan bir arayüz değerinin t
+// tipiyle eşleşip eşleşmediğini sınar. Arayüz tipleri dönüşümün başarısıyla,
+// diğer tipler tanımlayıcılarıyla eşleşir.
+func (g *IRGenerator) typeMatches(subject value.Value, from *InterfaceInfo, desc value.Value, t types.Type, named *NamedInfo) value.Value {
+	if to := g.interfaceInfo(t); to != nil {
+		converted := g.convertInterface(subject, from, to)
+		return g.currentBB.NewICmp(enum.IPredNE, g.currentBB.NewExtractValue(converted, 0), constant.NewNull(bytePtr))
+	}
+	if named != nil {
+		return g.currentBB.NewICmp(enum.IPredEQ, desc, g.descriptorByName(named.Name))
+	}
+	return g.currentBB.NewICmp(enum.IPredEQ, desc, g.typeDescriptor(t))
+}
+
+// bindTypeSwitchValue, tip switch'inin değişkenini bir case için tanımlar.
+// Tek tipli case'lerde değişken o tipte, diğerlerinde incelenen arayüz
+// değerinin tipindedir. Önceki tanımı geri yükleyen fonksiyonu döndürür.
+func (g *IRGenerator) bindTypeSwitchValue(name string, subject value.Value, from *InterfaceInfo, caseTypes []types.Type, caseNamed []*NamedInfo) func() {
+	bound := subject
+	var named *NamedInfo
+	if len(caseTypes) == 1 && caseTypes[0] != nil {
+		named = caseNamed[0]
+		if to := g.interfaceInfo(caseTypes[0]); to != nil {
+			bound = g.convertInterface(subject, from, to)
+		} else {
+			bound = g.unboxInterface(subject, caseTypes[0])
+		}
+	}
+
+	prevVal, hadVal := g.symbolTable[name]
+	prevNamed := g.namedVars[name]
+	addr := g.newVariable(g.currentBB, name, name+"."+g.currentBB.Name(), bound.Type())
+	g.currentBB.NewStore(bound, addr)
+	g.symbolTable[name] = addr
+	g.namedVars[name] = named
+
+	return func() {
+		if hadVal {
+			g.symbolTable[name] = prevVal
+		} else {
+			delete(g.symbolTable, name)
+		}
+		g.namedVars[name] = prevNamed
+	}
+}
+
+// receiverType, bir metot çağrısındaki nesnenin tipini döndürür. Sembol
+// tablosunda olmayan tanımlayıcılar paket adlarıdır; bunlar için nil döner.
+func (g *IRGenerator) receiverType(expr ast.Expression) types.Type {
+	if ident, ok := expr.(*ast.Identifier); ok {
+		if _, exists := g.symbolTable[ident.Value]; !exists {
+			return nil
+		}
+	}
+	return g.getExpressionType(expr)
+}
+
+// generateMethodCall, bir struct veya arayüz değeri üzerindeki metot
+// çağrısı için IR üretir. Nesne struct veya arayüz değilse false döner.
+func (g *IRGenerator) generateMethodCall(callExpr *ast.CallExpression, memberExpr *ast.MemberExpression, name string) (value.Value, bool) {
+	objType := g.receiverType(memberExpr.Object)
+
+	if iface := g.interfaceInfo(objType); iface != nil {
+		obj := g.generateExpression(memberExpr.Object)
+		if obj == nil {
+			return nil, true
+		}
+		return g.generateInterfaceMethodCall(obj, iface, name, callExpr), true
+	}
+
+	if named := g.namedTypeOf(memberExpr.Object); named != nil {
+		return g.generateNamedMethodCall(callExpr, memberExpr, named, name), true
+	}
+
+	// Struct işaretçilerinin metotları da struct'ın metot tablosundadır
+	if info := g.structPointerInfo(objType); info != nil {
+		objType = info.Type
+	}
+	st, ok := objType.(*types.StructType)
+	info, exists := g.structTable[st]
+	if !ok || !exists {
+		return nil, false
+	}
+	fn := g.methodFunc(info, name)
+	if fn == nil {
+		// Fonksiyon tipindeki alanlar closure olarak çağrılır
+		if _, fieldType := g.fieldPath(info, name); closureSignature(fieldType) != nil {
+			closure := g.generateMemberExpression(memberExpr)
+			if closure == nil {
+				return nil, true
+			}
+			return g.generateClosureCall(closure, callExpr), true
+		}
+		g.ReportError("%s tipinde '%s' adında bir metot yok", g.typeName(st), name)
+		return nil, true
+	}
+
+	// Alıcı adresiyle geçirilir; değer alıcılı metotlar kendi kopyasını
+	// oluşturur, işaretçi alıcılı metotlar nesneyi doğrudan değiştirir
+	recv := g.generateObjectAddress(memberExpr.Object)
+	if recv == nil {
+		return nil, true
+	}
+	args := g.generateMethodArguments(fn.Sig, callExpr)
+	if args == nil {
+		return nil, true
+	}
+	return g.currentBB.NewCall(fn, append([]value.Value{recv}, args...)...), true
+}
+
+// generateNamedMethodCall, dayanak tipiyle temsil edilen adlandırılmış
+// tipteki bir değerin metodunu çağırır. Değişkenler adresleriyle geçirilir,
+// böylece işaretçi alıcılı metotların değişiklikleri değişkene yansır; diğer
+// değerler geçici bir kopyalarının adresiyle geçirilir.
+func (g *IRGenerator) generateNamedMethodCall(callExpr *ast.CallExpression, memberExpr *ast.MemberExpression, named *NamedInfo, name string) value.Value {
+	fn := named.Methods[name]
+	if fn == nil {
+		g.ReportError("%s tipinde '%s' adında bir metot yok", named.Name, name)
+		return nil
+	}
+
+	var recv value.Value
+	if ident, ok := memberExpr.Object.(*ast.Identifier); ok {
+		if addr, elemType := g.variableAddress(ident.Value); addr != nil && elemType.Equal(named.Type) {
+			recv = addr
+		}
+	}
+	if recv == nil {
+		obj := g.generateExpression(memberExpr.Object)
+		if obj == nil {
+			return nil
+		}
+		recv = g.currentBB.NewAlloca(named.Type)
+		g.currentBB.NewStore(obj, recv)
+	}
+
+	args := g.generateMethodArguments(fn.Sig, callExpr)
+	if args == nil {
+		return nil
+	}
+	return g.currentBB.NewCall(fn, append([]value.Value{recv}, args...)...)
+}
+
+// generateInterfaceMethodCall, bir arayüz metodunu itab üzerinden çağırır.
+// nil arayüz üzerindeki çağrılar panic ile sonlanır.
+func (g *IRGenerator) generateInterfaceMethodCall(obj value.Value, iface *InterfaceInfo, name string, call *ast.CallExpression) value.Value {
+	sig, exists := iface.MethodSet()[name]
+	if !exists {
+		g.ReportError("%s arayüzünde '%s' adında bir metot yok", g.interfaceName(iface), name)
+		return nil
+	}
+	slot := int64(sort.SearchStrings(iface.MethodNames(), name)) + 1
+
+	itab := g.currentBB.NewExtractValue(obj, 0)
+	isNil := g.currentBB.NewICmp(enum.IPredEQ, itab, constant.NewNull(bytePtr))
+	g.generatePanicIf(isNil, "nilcall", "runtime error: invalid memory address or nil pointer dereference")
+
+	slots := g.currentBB.NewBitCast(itab, types.NewPointer(bytePtr))
+	fnPtr := g.currentBB.NewLoad(bytePtr, g.currentBB.NewGetElementPtr(bytePtr, slots, constant.NewInt(types.I32, slot)))
+	fn := g.currentBB.NewBitCast(fnPtr, types.NewPointer(sig))
+
+	args := g.generateMethodArguments(sig, call)
+	if args == nil {
+		return nil
+	}
+	data := g.currentBB.NewExtractValue(obj, 1)
+	return g.currentBB.NewCall(fn, append([]value.Value{data}, args...)...)
+}
+
+// generateMethodArguments, metot argümanlarını değerlendirip parametre
+// tiplerine uyarlar. Alıcı parametresi sig.Params[0]'dır.
+func (g *IRGenerator) generateMethodArguments(sig *types.FuncType, call *ast.CallExpression) []value.Value {
+	return g.generateArguments(sig.Params[1:], g.variadicSigs[sig], call)
+}
+
+// callResultType, bir fonksiyon veya metot çağrısının sonuç tipini kod
+// üretmeden belirler. Belirlenemezse nil döner.
+func (g *IRGenerator) callResultType(expr *ast.CallExpression) types.Type {
+	switch f := expr.Function.(type) {
+	case *ast.Identifier:
+		switch f.Value {
+		case "len", "cap", "copy":
+			return types.I32
+		case "append":
+			if len(expr.Arguments) > 0 {
+				return g.getExpressionType(expr.Arguments[0])
+			}
+		case "recover":
+			return g.anyInterface().Type
+		case "alloc":
+			if len(expr.Arguments) > 0 {
+				if elemType := g.resolveType(expr.Arguments[0]); elemType != nil {
+					return types.NewPointer(elemType)
+				}
+			}
+			return nil
+		case "free":
+			return types.Void
+		}
+		if t := g.conversionType(f); t != nil {
+			return t
+		}
+		if fn, ok := g.symbolTable[f.Value].(*ir.Func); ok {
+			return fn.Sig.RetType
+		}
+		if _, varType := g.variableAddress(f.Value); varType != nil {
+			if sig := closureSignature(varType); sig != nil {
+				return sig.RetType
+			}
+		}
+	case *ast.MemberExpression:
+		member, ok := f.Member.(*ast.Identifier)
+		if !ok {
+			return nil
+		}
+		objType := g.receiverType(f.Object)
+		if iface := g.interfaceInfo(objType); iface != nil {
+			if sig, exists := iface.MethodSet()[member.Value]; exists {
+				return sig.RetType
+			}
+			return nil
+		}
+		if named := g.namedTypeOf(f.Object); named != nil {
+			if fn := named.Methods[member.Value]; fn != nil {
+				return fn.Sig.RetType
+			}
+			return nil
+		}
+		if info := g.structPointerInfo(objType); info != nil {
+			objType = info.Type
+		}
+		if st, ok := objType.(*types.StructType); ok {
+			if info, exists := g.structTable[st]; exists {
+				if _, fn := g.findMethod(info, member.Value); fn != nil {
+					return fn.Sig.RetType
+				}
+				if _, fieldType := g.fieldPath(info, member.Value); fieldType != nil {
+					if sig := closureSignature(fieldType); sig != nil {
+						return sig.RetType
+					}
+				}
+			}
+		}
+	default:
+		if sig := closureSignature(g.getExpressionType(f)); sig != nil {
+			return sig.RetType
+		}
+	}
+	return nil
+}
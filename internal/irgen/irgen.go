@@ -22,17 +22,36 @@ type IRGenerator struct {
 	module         *ir.Module
 	currentFunc    *ir.Func
 	currentBB      *ir.Block
-	symbolTable    map[string]value.Value   // Symbol table
-	typeTable      map[string]types.Type    // Type table
-	classTable     map[string]*ClassInfo    // Class table
-	templateTable  map[string]*TemplateInfo // Template table
-	exceptionStack []*ExceptionInfo         // Exception stack
-	analyzer       *semantic.Analyzer       // Semantic analyzer
-	debugInfo      *DebugInfo               // Debug information
-	generateDebug  bool                     // Generate debug information?
-	sourceFile     string                   // Source file name
-	sourceDir      string                   // Source file directory
-	labelCounter   int                      // Counter for unique labels
+	symbolTable    map[string]value.Value               // Symbol table
+	typeTable      map[string]types.Type                // Type table
+	unsignedVars   map[string]bool                      // Variables of unsigned integer type
+	classTable     map[string]*ClassInfo                // Class table
+	templateTable  map[string]*TemplateInfo             // Template table
+	exceptionStack []*ExceptionInfo                     // Exception stack
+	analyzer       *semantic.Analyzer                   // Semantic analyzer
+	debugInfo      *DebugInfo                           // Debug information
+	generateDebug  bool                                 // Generate debug information?
+	sourceFile     string                               // Source file name
+	sourceDir      string                               // Source file directory
+	labelCounter   int                                  // Counter for unique labels
+	branchTargets  []*branchTarget                      // Enclosing loops and switches for break/continue
+	pendingLabel   string                               // Label of the loop or switch being generated
+	labelBlocks    map[*ir.Func]map[string]*ir.Block    // Label blocks per function
+	functionLabels map[string]bool                      // Labels defined in the current function body
+	structTable    map[*types.StructType]*StructInfo    // Struct types and their fields
+	interfaceTable map[*types.StructType]*InterfaceInfo // Interface types and their methods
+	namedTable     map[string]*NamedInfo                // Named types represented by their underlying types
+	namedVars      map[string]*NamedInfo                // Variables of named non-struct types
+	namedValues    map[value.Value]*NamedInfo           // Values known to be of a named non-struct type
+	tempStrings    map[value.Value]value.Value          // Strings produced by concatenation or conversion and whether they own their buffer
+	resultVars     []value.Value                        // Named results of the current function
+	variadicSigs   map[*types.FuncType]bool             // Signatures whose last parameter collects extra arguments
+	escapingVars   map[string]bool                      // Local variables of the current function captured by function literals or whose address is taken
+	deferFrame     value.Value                          // Frame of the current function if it contains defer statements
+	emptyInterface *InterfaceInfo                       // Shared interface{} type of panic values
+	chanTypes      []*chanInfo                          // Channel types and their element types
+	mapTypes       []*mapInfo                           // Map types with their key and value types
+	targetTriple   string                               // Target triple; the host platform if empty
 }
 
 // New creates a new IRGenerator.
@@ -44,9 +63,18 @@ func New() *IRGenerator {
 		moduleName:     "gominus_module",
 		symbolTable:    make(map[string]value.Value),
 		typeTable:      make(map[string]types.Type),
+		unsignedVars:   make(map[string]bool),
 		classTable:     make(map[string]*ClassInfo),
 		templateTable:  make(map[string]*TemplateInfo),
 		exceptionStack: make([]*ExceptionInfo, 0),
+		labelBlocks:    make(map[*ir.Func]map[string]*ir.Block),
+		structTable:    make(map[*types.StructType]*StructInfo),
+		interfaceTable: make(map[*types.StructType]*InterfaceInfo),
+		namedTable:     make(map[string]*NamedInfo),
+		namedVars:      make(map[string]*NamedInfo),
+		namedValues:    make(map[value.Value]*NamedInfo),
+		tempStrings:    make(map[value.Value]value.Value),
+		variadicSigs:   make(map[*types.FuncType]bool),
 		generateDebug:  false,
 		sourceFile:     "",
 		sourceDir:      "",
@@ -62,9 +90,18 @@ func NewWithAnalyzer(analyzer *semantic.Analyzer) *IRGenerator {
 		moduleName:     "gominus_module",
 		symbolTable:    make(map[string]value.Value),
 		typeTable:      make(map[string]types.Type),
+		unsignedVars:   make(map[string]bool),
 		classTable:     make(map[string]*ClassInfo),
 		templateTable:  make(map[string]*TemplateInfo),
 		exceptionStack: make([]*ExceptionInfo, 0),
+		labelBlocks:    make(map[*ir.Func]map[string]*ir.Block),
+		structTable:    make(map[*types.StructType]*StructInfo),
+		interfaceTable: make(map[*types.StructType]*InterfaceInfo),
+		namedTable:     make(map[string]*NamedInfo),
+		namedVars:      make(map[string]*NamedInfo),
+		namedValues:    make(map[value.Value]*NamedInfo),
+		tempStrings:    make(map[value.Value]value.Value),
+		variadicSigs:   make(map[*types.FuncType]bool),
 		analyzer:       analyzer,
 		generateDebug:  false,
 		sourceFile:     "",
@@ -78,6 +115,12 @@ func (g *IRGenerator) SetSourceFile(filename, directory string) {
 	g.sourceDir = directory
 }
 
+// SetTargetTriple sets the target triple used to lower target-dependent
+// runtime calls such as runtime.NumCPU and go statements.
+func (g *IRGenerator) SetTargetTriple(triple string) {
+	g.targetTriple = triple
+}
+
 // EnableDebugInfo enables or disables debug information generation.
 func (g *IRGenerator) EnableDebugInfo(enable bool) {
 	g.generateDebug = enable
@@ -122,6 +165,15 @@ func (g *IRGenerator) GenerateProgram(program *ast.Program) (string, error) {
 		return "", fmt.Errorf("IR üretimi sırasında hatalar oluştu: %v", g.Errors())
 	}
 
+	// Tip bildirimleri, kullanıldıkları yerden önce gelmeyebileceği için önceden tanımlanır
+	g.declareTypes(program.Statements, "")
+
+	// Metotlar itab'larda ve bildirimlerinden önce kullanılabildiği için önceden tanımlanır
+	g.declareMethods(program.Statements)
+
+	// Fonksiyonlar bildirimlerinden önce çağrılabildiği için önceden tanımlanır
+	g.declareFunctions(program.Statements)
+
 	// AST düğümlerini gezerek IR üretme
 	for _, stmt := range program.Statements {
 		// Hata ayıklama bilgisi için konum bilgisini ayarla
@@ -182,7 +234,22 @@ func (g *IRGenerator) defineBasicTypes() {
 	g.typeTable["bool"] = types.I1
 	g.typeTable["byte"] = types.I8
 	g.typeTable["rune"] = types.I32
-	g.typeTable["string"] = types.NewPointer(types.I8) // Basitleştirilmiş string temsili
+	g.typeTable["char"] = types.I32 // Karakterler Unicode kod noktası (rune) olarak tutulur
+
+	// String'ler { veri, uzunluk } değerleridir
+	stringType := newStringType()
+	g.module.NewTypeDef("string", stringType)
+	g.typeTable["string"] = stringType
+
+	// Önceden tanımlanmış error arayüzü: interface { Error() string }
+	errorType := newInterfaceType()
+	g.module.NewTypeDef("error", errorType)
+	g.typeTable["error"] = errorType
+	g.interfaceTable[errorType] = &InterfaceInfo{
+		Name:    "error",
+		Type:    errorType,
+		Methods: map[string]*types.FuncType{"Error": types.NewFunc(stringType, bytePtr)},
+	}
 }
 
 // getTypeTableKeys, debug için typeTable'daki anahtarları döndürür.
@@ -231,16 +298,33 @@ func (g *IRGenerator) generateStatement(stmt ast.Statement) {
 		g.generateVarStatement(s)
 	case *ast.ReturnStatement:
 		g.generateReturnStatement(s)
+	case *ast.MultiAssignStatement:
+		g.generateMultiAssignStatement(s)
+	case *ast.SendStatement:
+		g.generateSendStatement(s)
+	case *ast.SelectStatement:
+		g.generateSelectStatement(s)
 	case *ast.BlockStatement:
 		g.generateBlockStatement(s)
+	case *ast.UnsafeStatement:
+		// unsafe kuralları semantik analizde denetlenir
+		if s.Body != nil {
+			g.generateBlockStatement(s.Body)
+		}
 	case *ast.WhileStatement:
 		g.generateWhileStatement(s)
 	case *ast.ForStatement:
 		g.generateForStatement(s)
+	case *ast.RangeStatement:
+		g.generateRangeStatement(s)
 	case *ast.SwitchStatement:
 		g.generateSwitchStatement(s)
 	case *ast.FunctionStatement:
 		g.generateFunctionStatement(s)
+	case *ast.MethodStatement:
+		g.generateMethodStatement(s)
+	case *ast.TypeSwitchStatement:
+		g.generateTypeSwitchStatement(s)
 	case *ast.ClassStatement:
 		g.generateClassStatement(s)
 	case *ast.TemplateStatement:
@@ -249,6 +333,16 @@ func (g *IRGenerator) generateStatement(stmt ast.Statement) {
 		g.generateTryCatchStatement(s)
 	case *ast.ThrowStatement:
 		g.generateThrowStatement(s)
+	case *ast.DeferStatement:
+		g.generateDeferStatement(s)
+	case *ast.GoStatement:
+		g.generateGoStatement(s)
+	case *ast.BranchStatement:
+		g.generateBranchStatement(s)
+	case *ast.LabeledStatement:
+		g.generateLabeledStatement(s)
+	case *ast.TypeStatement:
+		g.generateTypeStatement(s)
 	default:
 		g.ReportError("Desteklenmeyen deyim türü: %T", s)
 	}
@@ -258,19 +352,27 @@ func (g *IRGenerator) generateStatement(stmt ast.Statement) {
 func (g *IRGenerator) generateExpression(expr ast.Expression) value.Value {
 	switch e := expr.(type) {
 	case *ast.Identifier:
-		return g.generateIdentifier(e)
+		val := g.generateIdentifier(e)
+		if info := g.namedVars[e.Value]; info != nil && val != nil {
+			g.namedValues[val] = info
+		}
+		return val
 	case *ast.IntegerLiteral:
 		return g.generateIntegerLiteral(e)
 	case *ast.FloatLiteral:
 		return g.generateFloatLiteral(e)
 	case *ast.StringLiteral:
 		return g.generateStringLiteral(e)
+	case *ast.CharLiteral:
+		return g.generateCharLiteral(e)
 	case *ast.BooleanLiteral:
 		return g.generateBooleanLiteral(e)
 	case *ast.PrefixExpression:
 		return g.generatePrefixExpression(e)
 	case *ast.InfixExpression:
 		return g.generateInfixExpression(e)
+	case *ast.AssignExpression:
+		return g.generateAssignExpression(e)
 	case *ast.PostfixExpression:
 		return g.generatePostfixExpression(e)
 	case *ast.CallExpression:
@@ -291,6 +393,14 @@ func (g *IRGenerator) generateExpression(expr ast.Expression) value.Value {
 		return g.generateArrayLiteral(e)
 	case *ast.IndexExpression:
 		return g.generateIndexExpression(e)
+	case *ast.SliceExpression:
+		return g.generateSliceExpression(e)
+	case *ast.CompositeLiteral:
+		return g.generateCompositeLiteral(e)
+	case *ast.TypeAssertExpression:
+		return g.generateTypeAssertion(e)
+	case *ast.NullLiteral:
+		return g.generateNullLiteral()
 	default:
 		g.ReportError("Desteklenmeyen ifade türü: %T", e)
 		return nil
@@ -302,8 +412,12 @@ func (g *IRGenerator) getExpressionType(expr ast.Expression) types.Type {
 	switch e := expr.(type) {
 	case *ast.Identifier:
 		// Tanımlayıcının tipini bul
-		if val, exists := g.symbolTable[e.Value]; exists {
-			return g.getValueType(val)
+		// Değişkenler bellekte tutulur; değişkenin tipi gösterilen tiptir
+		if _, varType := g.variableAddress(e.Value); varType != nil {
+			return varType
+		}
+		if fn, ok := g.symbolTable[e.Value].(*ir.Func); ok {
+			return g.funcValueType(fn.Sig.RetType, fn.Sig.Params, g.variadicSigs[fn.Sig])
 		}
 		return nil
 	case *ast.IntegerLiteral:
@@ -311,22 +425,58 @@ func (g *IRGenerator) getExpressionType(expr ast.Expression) types.Type {
 	case *ast.FloatLiteral:
 		return types.Double // Varsayılan olarak float64
 	case *ast.StringLiteral:
-		return types.NewPointer(types.I8) // Basitleştirilmiş string temsili
+		return g.stringType()
+	case *ast.CharLiteral:
+		return types.I32 // Unicode kod noktası
 	case *ast.BooleanLiteral:
 		return types.I1
 	case *ast.PrefixExpression:
+		switch e.Operator {
+		case "&":
+			if elemType := g.getExpressionType(e.Right); elemType != nil {
+				return types.NewPointer(elemType)
+			}
+			return nil
+		case "*":
+			if ptr, ok := g.getExpressionType(e.Right).(*types.PointerType); ok {
+				return ptr.ElemType
+			}
+			return nil
+		}
 		return g.getExpressionType(e.Right)
 	case *ast.InfixExpression:
 		// Aritmetik operatörler için
 		if e.Operator == "+" || e.Operator == "-" || e.Operator == "*" || e.Operator == "/" {
 			leftType := g.getExpressionType(e.Left)
 			rightType := g.getExpressionType(e.Right)
+			// İşaretçi aritmetiği: p + n işaretçi, p - q eleman sayısıdır
+			if _, leftPtr := leftType.(*types.PointerType); leftPtr {
+				if _, rightPtr := rightType.(*types.PointerType); rightPtr {
+					return types.I32
+				}
+				return leftType
+			}
+			if _, rightPtr := rightType.(*types.PointerType); rightPtr {
+				return rightType
+			}
 			// Tip yükseltme (type promotion)
 			if leftType == types.Double || rightType == types.Double {
 				return types.Double
 			}
 			return types.I32
 		}
+		// Bit düzeyinde operatörlerin sonucu, sabit olmayan işlenenin tipindedir
+		switch e.Operator {
+		case "&", "|", "^", "&^", "<<", ">>":
+			operand := e.Left
+			if _, isConst := e.Left.(*ast.IntegerLiteral); isConst && e.Operator != "<<" && e.Operator != ">>" {
+				operand = e.Right
+			}
+			if operandType := g.getExpressionType(operand); operandType != nil && types.IsInt(operandType) {
+				return operandType
+			}
+			return types.I32
+		}
 		// Karşılaştırma operatörleri için
 		if e.Operator == "==" || e.Operator == "!=" || e.Operator == "<" || e.Operator == ">" || e.Operator == "<=" || e.Operator == ">=" {
 			return types.I1
@@ -346,6 +496,9 @@ func (g *IRGenerator) getExpressionType(expr ast.Expression) types.Type {
 	case *ast.IndexExpression:
 		// Index expression için element tipini döndür
 		arrayType := g.getExpressionType(e.Left)
+		if g.isStringType(arrayType) {
+			return types.I8
+		}
 		if ptrType, ok := arrayType.(*types.PointerType); ok {
 			if arrType, ok := ptrType.ElemType.(*types.ArrayType); ok {
 				return arrType.ElemType
@@ -353,6 +506,31 @@ func (g *IRGenerator) getExpressionType(expr ast.Expression) types.Type {
 			return ptrType.ElemType
 		}
 		return types.I32
+	case *ast.SliceExpression:
+		// Dizilerin dilimlenmesi eleman tipinde bir slice üretir
+		leftType := g.getExpressionType(e.Left)
+		if arrType := pointedArrayType(leftType); arrType != nil {
+			return sliceTypeOf(arrType.ElemType)
+		}
+		return leftType
+	case *ast.CompositeLiteral:
+		return g.resolveType(e.Type)
+	case *ast.MemberExpression:
+		if memberType := g.memberType(e); memberType != nil {
+			return memberType
+		}
+		g.ReportError("Üye erişiminin tipi belirlenemiyor: %s", e.String())
+		return nil
+	case *ast.TypeAssertExpression:
+		return g.resolveType(e.Type)
+	case *ast.FunctionLiteral:
+		return g.literalType(e)
+	case *ast.CallExpression:
+		if resultType := g.callResultType(e); resultType != nil {
+			return resultType
+		}
+		g.ReportError("Desteklenmeyen ifade türü (tip belirlenemiyor): %T", e)
+		return nil
 	default:
 		g.ReportError("Desteklenmeyen ifade türü (tip belirlenemiyor): %T", e)
 		return nil
@@ -374,15 +552,37 @@ func (g *IRGenerator) generateConstantExpression(expr ast.Expression) constant.C
 		return constant.NewInt(types.I32, e.Value)
 	case *ast.FloatLiteral:
 		return constant.NewFloat(types.Double, e.Value)
+	case *ast.CharLiteral:
+		return constant.NewInt(types.I32, int64(e.Value))
 	case *ast.BooleanLiteral:
 		if e.Value {
 			return constant.NewInt(types.I1, 1)
 		}
 		return constant.NewInt(types.I1, 0)
 	case *ast.StringLiteral:
-		// String sabitleri için global değişken oluştur
-		strConst := g.module.NewGlobalDef("", constant.NewCharArrayFromString(e.Value+"\x00"))
-		return constant.NewGetElementPtr(strConst.ContentType, strConst, constant.NewInt(types.I32, 0), constant.NewInt(types.I32, 0))
+		return g.stringLiteral(e.Value)
+	case *ast.CompositeLiteral:
+		return g.generateConstantCompositeLiteral(e)
+	case *ast.NullLiteral:
+		return constant.NewNull(bytePtr)
+	case *ast.Identifier:
+		if fn, ok := g.symbolTable[e.Value].(*ir.Func); ok {
+			return g.funcValue(fn)
+		}
+		g.ReportError("Desteklenmeyen sabit ifade türü: %T", e)
+		return nil
+	case *ast.FunctionLiteral:
+		// Global değişmezler değişken yakalamaz; closure'ları sabittir
+		if closure, ok := g.generateFunctionLiteral(e).(constant.Constant); ok {
+			return closure
+		}
+		return nil
+	case *ast.CallExpression:
+		if target := g.conversionType(e.Function); target != nil && len(e.Arguments) == 1 {
+			return g.generateConstantConversion(e, target)
+		}
+		g.ReportError("Desteklenmeyen sabit ifade türü: %T", e)
+		return nil
 	default:
 		g.ReportError("Desteklenmeyen sabit ifade türü: %T", e)
 		return nil
@@ -394,6 +594,10 @@ func (g *IRGenerator) generateConstantExpression(expr ast.Expression) constant.C
 func (g *IRGenerator) generateIdentifier(ident *ast.Identifier) value.Value {
 	// Tanımlayıcının değerini sembol tablosundan bul
 	if val, exists := g.symbolTable[ident.Value]; exists {
+		// Değer olarak kullanılan fonksiyonlar closure'a dönüştürülür
+		if fn, ok := val.(*ir.Func); ok {
+			return g.funcValue(fn)
+		}
 		// Eğer değer bir pointer ise (örn. alloca), yükle
 		if ptr, ok := val.(value.Value); ok && types.IsPointer(ptr.Type()) {
 			if g.currentBB != nil {
@@ -416,9 +620,11 @@ func (g *IRGenerator) generateFloatLiteral(lit *ast.FloatLiteral) value.Value {
 }
 
 func (g *IRGenerator) generateStringLiteral(lit *ast.StringLiteral) value.Value {
-	// String sabitleri için global değişken oluştur
-	strConst := g.module.NewGlobalDef("", constant.NewCharArrayFromString(lit.Value+"\x00"))
-	return constant.NewGetElementPtr(strConst.ContentType, strConst, constant.NewInt(types.I32, 0), constant.NewInt(types.I32, 0))
+	return g.stringLiteral(lit.Value)
+}
+
+func (g *IRGenerator) generateCharLiteral(lit *ast.CharLiteral) value.Value {
+	return constant.NewInt(types.I32, int64(lit.Value))
 }
 
 func (g *IRGenerator) generateBooleanLiteral(lit *ast.BooleanLiteral) value.Value {
@@ -431,6 +637,14 @@ func (g *IRGenerator) generateBooleanLiteral(lit *ast.BooleanLiteral) value.Valu
 // Karmaşık ifade türleri için IR üretme fonksiyonları
 
 func (g *IRGenerator) generatePrefixExpression(expr *ast.PrefixExpression) value.Value {
+	// Adres alma ve işaretçi çözme işleneni değer olarak değerlendirmez
+	switch expr.Operator {
+	case "&":
+		return g.generateAddressOf(expr)
+	case "*":
+		return g.generateDereference(expr)
+	}
+
 	right := g.generateExpression(expr.Right)
 	if right == nil {
 		return nil
@@ -452,6 +666,15 @@ func (g *IRGenerator) generatePrefixExpression(expr *ast.PrefixExpression) value
 		} else if types.IsFloat(right.Type()) {
 			return g.currentBB.NewFSub(constant.NewFloat(types.Double, 0), right)
 		}
+	case "^", "~":
+		// Bit düzeyinde tümleyen: x ^ -1
+		if intType, ok := right.Type().(*types.IntType); ok && intType.BitSize > 1 {
+			return g.currentBB.NewXor(right, constant.NewInt(intType, -1))
+		}
+	case "<-":
+		// Kanaldan alma
+		val, _ := g.generateReceive(right)
+		return val
 	}
 
 	g.ReportError("Desteklenmeyen önek operatörü: %s", expr.Operator)
@@ -546,12 +769,13 @@ func (g *IRGenerator) generateInfixExpression(expr *ast.InfixExpression) value.V
 			}
 
 			// Değişken için bellek ayır
-			alloca := g.currentBB.NewAlloca(rightType)
-			alloca.SetName(varName)
-			g.symbolTable[varName] = alloca
+			addr := g.newVariable(g.currentBB, varName, varName, rightType)
+			g.symbolTable[varName] = addr
+			g.unsignedVars[varName] = g.isUnsignedExpr(expr.Right)
+			g.namedVars[varName] = g.namedTypeOf(expr.Right)
 
 			// Değeri ata
-			g.currentBB.NewStore(right, alloca)
+			g.currentBB.NewStore(right, addr)
 			return right
 		} else {
 			g.ReportError("Kısa değişken tanımlama operatörünün sol tarafı bir tanımlayıcı olmalıdır")
@@ -559,6 +783,13 @@ func (g *IRGenerator) generateInfixExpression(expr *ast.InfixExpression) value.V
 		}
 	}
 
+	// Zincirleme string birleştirmeleri (a + b + c) tek çağrıyla üretilir
+	if expr.Operator == "+" {
+		if operands := additionOperands(expr); len(operands) > 2 && g.isStringType(g.getExpressionType(operands[0])) {
+			return g.generateStringConcatChain(operands)
+		}
+	}
+
 	// Diğer operatörler için normal işlem
 	left := g.generateExpression(expr.Left)
 	right := g.generateExpression(expr.Right)
@@ -572,20 +803,46 @@ func (g *IRGenerator) generateInfixExpression(expr *ast.InfixExpression) value.V
 		return nil
 	}
 
+	result := g.generateBinaryOperation(expr, left, right)
+	if result != nil && g.isStringType(left.Type()) && expr.Operator != "+" {
+		// Karşılaştırılan geçici string'ler artık kullanılmaz
+		g.releaseTemporary(left, right)
+	}
+	return result
+}
+
+// generateBinaryOperation, değerlendirilmiş işlenenler üzerinde bir ikili işlem için IR üretir.
+// Bileşik atamalar (x op= y) da işlemlerini bu fonksiyonla üretir.
+func (g *IRGenerator) generateBinaryOperation(expr *ast.InfixExpression, left, right value.Value) value.Value {
 	// Tip uyumluluğunu kontrol et ve gerekirse dönüşüm yap
 	leftType := left.Type()
 	rightType := right.Type()
 
-	// Aritmetik ve atama operatörleri
+	if result := g.generatePointerOperation(expr, left, right); result != nil {
+		return result
+	}
+
+	if g.isStringType(leftType) && g.isStringType(rightType) {
+		if expr.Operator == "+" {
+			return g.generateStringConcat([]value.Value{left, right})
+		}
+		if cmp := g.generateStringComparison(expr.Operator, left, right); cmp != nil {
+			return cmp
+		}
+	}
+	if cmp := g.generateStructComparison(expr.Operator, left, right); cmp != nil {
+		return cmp
+	}
+
+	// Aritmetik operatörler
 	switch expr.Operator {
+	case "&", "|", "^", "&^", "<<", ">>":
+		return g.generateBitwiseExpression(expr, left, right)
 	case "+":
 		if types.IsInt(leftType) && types.IsInt(rightType) {
 			return g.currentBB.NewAdd(left, right)
 		} else if types.IsFloat(leftType) && types.IsFloat(rightType) {
 			return g.currentBB.NewFAdd(left, right)
-		} else if g.isStringType(leftType) && g.isStringType(rightType) {
-			// String concatenation
-			return g.generateStringConcatenation(left, right)
 		}
 	case "-":
 		if types.IsInt(leftType) && types.IsInt(rightType) {
@@ -601,40 +858,38 @@ func (g *IRGenerator) generateInfixExpression(expr *ast.InfixExpression) value.V
 		}
 	case "/":
 		if types.IsInt(leftType) && types.IsInt(rightType) {
+			if g.isUnsignedExpr(expr) {
+				return g.currentBB.NewUDiv(left, right) // Unsigned division
+			}
 			return g.currentBB.NewSDiv(left, right) // Signed division
 		} else if types.IsFloat(leftType) && types.IsFloat(rightType) {
 			return g.currentBB.NewFDiv(left, right)
 		}
-	case "=":
-		// Atama operatörü
-		// Sol taraf bir tanımlayıcı olmalı
-		if ident, ok := expr.Left.(*ast.Identifier); ok {
-			// Tanımlayıcının değerini sembol tablosundan bul
-			if val, exists := g.symbolTable[ident.Value]; exists {
-				// Değeri ata
-				g.currentBB.NewStore(right, val)
-				return right
-			} else {
-				g.ReportError("Tanımlanmamış tanımlayıcı: %s", ident.Value)
-				return nil
+	case "%":
+		if types.IsInt(leftType) && types.IsInt(rightType) {
+			if g.isUnsignedExpr(expr) {
+				return g.currentBB.NewURem(left, right) // Unsigned remainder
 			}
-		} else {
-			g.ReportError("Atama operatörünün sol tarafı bir tanımlayıcı olmalıdır")
-			return nil
+			return g.currentBB.NewSRem(left, right) // Signed remainder
+		} else if types.IsFloat(leftType) && types.IsFloat(rightType) {
+			return g.currentBB.NewFRem(left, right)
 		}
-
 	// Karşılaştırma operatörleri
 	case "==":
 		if types.IsInt(leftType) && types.IsInt(rightType) {
 			return g.currentBB.NewICmp(enum.IPredEQ, left, right)
 		} else if types.IsFloat(leftType) && types.IsFloat(rightType) {
 			return g.currentBB.NewFCmp(enum.FPredOEQ, left, right)
+		} else if cmp := g.generateNilComparison(enum.IPredEQ, left, right); cmp != nil {
+			return cmp
 		}
 	case "!=":
 		if types.IsInt(leftType) && types.IsInt(rightType) {
 			return g.currentBB.NewICmp(enum.IPredNE, left, right)
 		} else if types.IsFloat(leftType) && types.IsFloat(rightType) {
 			return g.currentBB.NewFCmp(enum.FPredONE, left, right)
+		} else if cmp := g.generateNilComparison(enum.IPredNE, left, right); cmp != nil {
+			return cmp
 		}
 	case "<":
 		if types.IsInt(leftType) && types.IsInt(rightType) {
@@ -732,13 +987,45 @@ func (g *IRGenerator) generateCallExpression(expr *ast.CallExpression) value.Val
 		case "cap":
 			return g.generateCapCall(expr.Arguments)
 		case "append":
-			return g.generateAppendCall(expr.Arguments)
+			return g.generateAppendCall(expr.Arguments, expr.Ellipsis.IsValid())
+		case "copy":
+			return g.generateCopyCall(expr.Arguments)
 		case "make":
 			return g.generateMakeCall(expr.Arguments)
+		case "close":
+			return g.generateCloseCall(expr.Arguments)
+		case "delete":
+			// delete(p) alloc ile ayrılan belleği serbest bırakır
+			if len(expr.Arguments) == 1 {
+				return g.generateFreeCall(funcName, expr.Arguments)
+			}
+			return g.generateDeleteCall(expr.Arguments)
+		case "alloc":
+			return g.generateAllocCall(expr.Arguments)
+		case "free":
+			return g.generateFreeCall(funcName, expr.Arguments)
+		case "panic":
+			return g.generatePanicCall(expr.Arguments)
+		case "recover":
+			return g.generateRecoverCall(expr.Arguments)
+		}
+
+		// T(x) biçimindeki çağrılar tip dönüşümüdür
+		if target := g.conversionType(f); target != nil {
+			return g.generateConversion(expr, target)
 		}
 
 		if val, exists := g.symbolTable[funcName]; exists {
 			fn = val
+			// Fonksiyon tipindeki değişkenler closure olarak çağrılır
+			if _, isFunc := val.(*ir.Func); !isFunc {
+				closure := g.generateIdentifier(f)
+				if closure == nil || closureSignature(closure.Type()) == nil {
+					g.ReportError("%s bir fonksiyon değil", funcName)
+					return nil
+				}
+				return g.generateClosureCall(closure, expr)
+			}
 		} else {
 			// Fonksiyon bulunamadıysa, dış fonksiyon olarak tanımla
 			fn = g.module.NewFunc(funcName, types.I32)
@@ -747,9 +1034,28 @@ func (g *IRGenerator) generateCallExpression(expr *ast.CallExpression) value.Val
 	case *ast.MemberExpression:
 		// Member function call: package.func() veya object.method()
 		return g.generateMemberFunctionCall(expr, f)
-	default:
-		g.ReportError("Desteklenmeyen fonksiyon çağrısı türü: %T", expr.Function)
+	case *ast.ArrayType:
+		// []T(x) biçimindeki dönüşümler
+		if target := g.conversionType(f); target != nil {
+			return g.generateConversion(expr, target)
+		}
+		g.ReportError("Desteklenmeyen dönüşüm: %s", expr.String())
 		return nil
+	default:
+		// Fonksiyon değeri üreten ifadeler: func(...) {...}(), fs[i](), f()()
+		if g.currentBB == nil {
+			g.ReportError("Geçerli bir blok yok, fonksiyon çağrısı yapılamıyor")
+			return nil
+		}
+		closure := g.generateExpression(expr.Function)
+		if closure == nil {
+			return nil
+		}
+		if closureSignature(closure.Type()) == nil {
+			g.ReportError("Desteklenmeyen fonksiyon çağrısı türü: %T", expr.Function)
+			return nil
+		}
+		return g.generateClosureCall(closure, expr)
 	}
 
 	if g.currentBB == nil {
@@ -757,11 +1063,25 @@ func (g *IRGenerator) generateCallExpression(expr *ast.CallExpression) value.Val
 		return nil
 	}
 
-	// Argümanları değerlendir
+	// Argümanları değerlendir; tanımlı fonksiyonlarda argümanlar parametre tiplerine uyarlanır
+	if callee, ok := fn.(*ir.Func); ok && g.variadicSigs[callee.Sig] {
+		args := g.generateArguments(callee.Sig.Params, true, expr)
+		if args == nil {
+			return nil
+		}
+		return g.currentBB.NewCall(fn, args...)
+	}
+	var params []*ir.Param
+	if callee, ok := fn.(*ir.Func); ok && len(callee.Params) == len(expr.Arguments) {
+		params = callee.Params
+	}
 	args := make([]value.Value, 0, len(expr.Arguments))
-	for _, arg := range expr.Arguments {
+	for i, arg := range expr.Arguments {
 		argVal := g.generateExpression(arg)
 		if argVal != nil {
+			if params != nil {
+				argVal = g.convertAssignedValue(argVal, params[i].Typ, g.isUnsignedExpr(arg))
+			}
 			args = append(args, argVal)
 		}
 	}
@@ -786,6 +1106,11 @@ func (g *IRGenerator) generateMemberFunctionCall(callExpr *ast.CallExpression, m
 		return nil
 	}
 
+	// Struct ve arayüz değerleri üzerindeki metot çağrıları
+	if result, isMethod := g.generateMethodCall(callExpr, memberExpr, memberName); isMethod {
+		return result
+	}
+
 	// Object adını al (package name için)
 	var objectName string
 	if objectIdent, ok := memberExpr.Object.(*ast.Identifier); ok {
@@ -802,6 +1127,8 @@ func (g *IRGenerator) generateMemberFunctionCall(callExpr *ast.CallExpression, m
 		return g.generateFmtFunctionCall(memberName, callExpr.Arguments)
 	case "os":
 		return g.generateOsFunctionCall(memberName, callExpr.Arguments)
+	case "runtime":
+		return g.generateRuntimeFunctionCall(memberName, callExpr.Arguments)
 	default:
 		// Diğer package'lar veya object method calls için
 		// Şimdilik basit bir external function call olarak handle edelim
@@ -856,123 +1183,82 @@ func (g *IRGenerator) generateOsFunctionCall(funcName string, args []ast.Express
 }
 
 // generatePrintfCall, printf-style function call'ları için IR üretir.
+// Argümanlar boşlukla ayrılarak yazdırılır; Println sona satır sonu ekler.
 func (g *IRGenerator) generatePrintfCall(funcName string, args []ast.Expression) value.Value {
 	if g.currentBB == nil {
 		g.ReportError("Geçerli bir blok yok, printf çağrısı yapılamıyor")
 		return nil
 	}
 
-	// Platform-specific print function kullan
-	// Windows için puts, Linux/macOS için printf
-	var printFunc *ir.Func
-	var irArgs []value.Value
-
-	if len(args) > 0 {
-		// Multiple arguments için printf kullan
-		if funcName == "Println" {
-			// printf fonksiyonunu bul veya tanımla
-			printFunc = g.getFunction("printf")
-			if printFunc == nil {
-				printFunc = g.module.NewFunc("printf", types.I32, ir.NewParam("format", types.NewPointer(types.I8)))
-				printFunc.Sig.Variadic = true
-				g.symbolTable["printf"] = printFunc
-			}
-
-			// Format string oluştur
-			formatParts := make([]string, 0, len(args))
-			for _, arg := range args {
-				argVal := g.generateExpression(arg)
-				if argVal != nil {
-					// Argument tipine göre format belirle
-					switch argVal.Type() {
-					case types.I32, types.I64:
-						formatParts = append(formatParts, "%d")
-					case types.Float, types.Double:
-						formatParts = append(formatParts, "%f")
-					case types.I1:
-						// Boolean değeri i32'ye extend et
-						extendedVal := g.currentBB.NewZExt(argVal, types.I32)
-						formatParts = append(formatParts, "%d")
-						argVal = extendedVal
-					default:
-						formatParts = append(formatParts, "%s")
-					}
-					irArgs = append(irArgs, argVal)
-				}
-			}
-
-			// Format string'i oluştur ve newline ekle
-			formatString := strings.Join(formatParts, " ") + "\n"
-			formatStr := g.generateStringLiteral(&ast.StringLiteral{Value: formatString})
-
-			// Format string'i ilk argüman olarak ekle
-			finalArgs := make([]value.Value, 0, len(irArgs)+1)
-			finalArgs = append(finalArgs, formatStr)
-			finalArgs = append(finalArgs, irArgs...)
-			irArgs = finalArgs
-		} else {
-			// fmt.Print için - newline yok
-			printFunc = g.getFunction("printf")
-			if printFunc == nil {
-				printFunc = g.module.NewFunc("printf", types.I32, ir.NewParam("format", types.NewPointer(types.I8)))
-				printFunc.Sig.Variadic = true
-				g.symbolTable["printf"] = printFunc
-			}
-
-			// Format string oluştur (newline olmadan)
-			formatParts := make([]string, 0, len(args))
-			for _, arg := range args {
-				argVal := g.generateExpression(arg)
-				if argVal != nil {
-					// Argument tipine göre format belirle
-					switch argVal.Type() {
-					case types.I32, types.I64:
-						formatParts = append(formatParts, "%d")
-					case types.Float, types.Double:
-						formatParts = append(formatParts, "%f")
-					case types.I1:
-						// Boolean değeri i32'ye extend et
-						extendedVal := g.currentBB.NewZExt(argVal, types.I32)
-						formatParts = append(formatParts, "%d")
-						argVal = extendedVal
-					default:
-						formatParts = append(formatParts, "%s")
-					}
-					irArgs = append(irArgs, argVal)
-				}
-			}
-
-			// Format string'i oluştur
-			formatString := strings.Join(formatParts, " ")
-			formatStr := g.generateStringLiteral(&ast.StringLiteral{Value: formatString})
+	// printf fonksiyonunu bul veya tanımla
+	printFunc := g.getFunction("printf")
+	if printFunc == nil {
+		printFunc = g.module.NewFunc("printf", types.I32, ir.NewParam("format", types.NewPointer(types.I8)))
+		printFunc.Sig.Variadic = true
+		g.symbolTable["printf"] = printFunc
+	}
 
-			// Format string'i ilk argüman olarak ekle
-			finalArgs := make([]value.Value, 0, len(irArgs)+1)
-			finalArgs = append(finalArgs, formatStr)
-			finalArgs = append(finalArgs, irArgs...)
-			irArgs = finalArgs
-		}
-	} else {
-		// Argüman yoksa sadece newline yazdır (Println için)
-		if funcName == "Println" {
-			printFunc = g.getFunction("puts")
-			if printFunc == nil {
-				printFunc = g.module.NewFunc("puts", types.I32, ir.NewParam("str", types.NewPointer(types.I8)))
-				g.symbolTable["puts"] = printFunc
-			}
-			// Boş string için newline
-			emptyStr := g.generateStringLiteral(&ast.StringLiteral{Value: ""})
-			irArgs = append(irArgs, emptyStr)
+	// Format string oluştur
+	formatParts := make([]string, 0, len(args))
+	irArgs := []value.Value{nil}
+	argVals := make([]value.Value, 0, len(args))
+	for _, arg := range args {
+		argVal := g.generateExpression(arg)
+		if argVal == nil {
+			continue
 		}
+		argVals = append(argVals, argVal)
+		format, vals := g.printfArgument(argVal, g.isUnsignedExpr(arg))
+		formatParts = append(formatParts, format)
+		irArgs = append(irArgs, vals...)
 	}
 
-	if printFunc == nil {
-		g.ReportError("Print fonksiyonu oluşturulamadı")
-		return nil
+	formatString := strings.Join(formatParts, " ")
+	if funcName == "Println" {
+		formatString += "\n"
 	}
+	irArgs[0] = g.stringConstant(formatString)
+
+	// Print fonksiyonu çağrısı yap; yazdırılan geçici string'ler artık kullanılmaz
+	call := g.currentBB.NewCall(printFunc, irArgs...)
+	g.releaseTemporary(argVals...)
+	return call
+}
 
-	// Print fonksiyonu çağrısı yap
-	return g.currentBB.NewCall(printFunc, irArgs...)
+// printfArgument, bir değerin printf biçimini ve printf'e geçirilecek
+// argümanlarını döndürür. String'ler uzunluklarıyla (%.*s) yazdırılır;
+// küçük tamsayılar ve float32 değerleri variadic çağrı için genişletilir.
+func (g *IRGenerator) printfArgument(val value.Value, unsigned bool) (string, []value.Value) {
+	if g.isStringType(val.Type()) {
+		length := g.currentBB.NewTrunc(g.stringLen(val), types.I32)
+		return "%.*s", []value.Value{length, g.stringData(val)}
+	}
+
+	switch t := val.Type().(type) {
+	case *types.IntType:
+		switch {
+		case t.BitSize == 64:
+			if unsigned {
+				return "%llu", []value.Value{val}
+			}
+			return "%lld", []value.Value{val}
+		case t.BitSize == 1:
+			// Boolean değeri i32'ye extend et
+			return "%d", []value.Value{g.currentBB.NewZExt(val, types.I32)}
+		case unsigned:
+			return "%u", []value.Value{g.convertIntWidth(val, types.I32, true)}
+		default:
+			return "%d", []value.Value{g.convertIntWidth(val, types.I32, false)}
+		}
+	case *types.FloatType:
+		if t.Kind == types.FloatKindFloat {
+			return "%f", []value.Value{g.currentBB.NewFPExt(val, types.Double)}
+		}
+		return "%f", []value.Value{val}
+	case *types.PointerType:
+		return "%p", []value.Value{val}
+	}
+	return "%s", []value.Value{val}
 }
 
 // generateExitCall, exit function call'ı için IR üretir.
@@ -983,7 +1269,7 @@ func (g *IRGenerator) generateExitCall(args []ast.Expression) value.Value {
 	}
 
 	// exit fonksiyonunu tanımla (eğer yoksa)
-	exitFunc := g.module.NewFunc("exit", types.Void, ir.NewParam("status", types.I32))
+	exitFunc := g.getExitFunc()
 
 	// Argümanı değerlendir
 	var exitCode value.Value
@@ -997,72 +1283,6 @@ func (g *IRGenerator) generateExitCall(args []ast.Expression) value.Value {
 	return g.currentBB.NewCall(exitFunc, exitCode)
 }
 
-func (g *IRGenerator) generateFunctionLiteral(expr *ast.FunctionLiteral) value.Value {
-	// Fonksiyon adını belirle
-	funcName := "anonymous_func"
-
-	// Parametre tiplerini belirle
-	paramTypes := make([]types.Type, len(expr.Parameters))
-	for i := range expr.Parameters {
-		paramTypes[i] = types.I32 // Varsayılan olarak int32
-	}
-
-	// Dönüş tipini belirle
-	var returnType types.Type = types.I32 // Varsayılan olarak int32
-
-	// Fonksiyonu oluştur
-	fn := g.module.NewFunc(funcName, returnType)
-
-	// Parametreleri ekle
-	for _, paramType := range paramTypes {
-		param := ir.NewParam("", paramType)
-		fn.Params = append(fn.Params, param)
-	}
-
-	// Önceki durumu kaydet
-	prevFunc := g.currentFunc
-	prevBB := g.currentBB
-
-	// Yeni durumu ayarla
-	g.currentFunc = fn
-	entryBlock := fn.NewBlock("entry")
-	g.currentBB = entryBlock
-
-	// Parametreleri sembol tablosuna ekle
-	if len(expr.Parameters) > 0 && len(fn.Params) > 0 {
-		for i, param := range expr.Parameters {
-			if i < len(fn.Params) {
-				paramName := param.Value
-				paramVal := fn.Params[i]
-				paramVal.SetName(paramName)
-
-				// Parametre için yerel değişken oluştur
-				alloca := entryBlock.NewAlloca(paramTypes[i])
-				alloca.SetName(paramName + ".addr")
-				entryBlock.NewStore(paramVal, alloca)
-
-				g.symbolTable[paramName] = alloca
-			}
-		}
-	}
-
-	// Fonksiyon gövdesini işle
-	if expr.Body != nil {
-		g.generateBlockStatement(expr.Body)
-	}
-
-	// Eğer son blok bir dönüş ifadesi ile bitmiyorsa, varsayılan dönüş ekle
-	if g.currentBB.Term == nil {
-		g.currentBB.NewRet(constant.NewInt(types.I32, 0))
-	}
-
-	// Önceki durumu geri yükle
-	g.currentFunc = prevFunc
-	g.currentBB = prevBB
-
-	return fn
-}
-
 // Deyim türleri için IR üretme fonksiyonları
 
 func (g *IRGenerator) generateVarStatement(stmt *ast.VarStatement) {
@@ -1071,24 +1291,23 @@ func (g *IRGenerator) generateVarStatement(stmt *ast.VarStatement) {
 
 	// Değişken tipini belirle
 	var varType types.Type
+	var named *NamedInfo
+	unsigned := false
 	if stmt.Type != nil {
 		// Tip belirtilmişse, bu tipi kullan
-		if typeIdent, ok := stmt.Type.(*ast.Identifier); ok {
-			if t, exists := g.typeTable[typeIdent.Value]; exists {
-				varType = t
-			} else {
-				g.ReportError("Bilinmeyen tip: %s", typeIdent.Value)
-				return
-			}
-		} else {
-			g.ReportError("Desteklenmeyen tip ifadesi: %T", stmt.Type)
+		varType = g.resolveType(stmt.Type)
+		if varType == nil {
 			return
 		}
+		unsigned = g.isUnsignedTypeExpr(stmt.Type)
+		named = g.namedTypeInfo(stmt.Type)
 	} else if stmt.Value != nil {
 		// Tip belirtilmemişse ve değer varsa, değerin tipini kullan
 		exprType := g.getExpressionType(stmt.Value)
 		if exprType != nil {
 			varType = exprType
+			unsigned = g.isUnsignedExpr(stmt.Value)
+			named = g.namedTypeOf(stmt.Value)
 		} else {
 			g.ReportError("Değişken tipi belirlenemedi: %s", varName)
 			return
@@ -1097,16 +1316,27 @@ func (g *IRGenerator) generateVarStatement(stmt *ast.VarStatement) {
 		g.ReportError("Değişken tipi belirtilmemiş ve değer atanmamış: %s", varName)
 		return
 	}
+	g.unsignedVars[varName] = unsigned
+	g.namedVars[varName] = named
 
 	// Değişken global mi yoksa lokal mi?
 	if g.currentFunc == nil {
 		// Global değişken
 		globalVar := g.module.NewGlobalDef(varName, constant.NewZeroInitializer(varType))
 		g.symbolTable[varName] = globalVar
+		if arrType := pointedArrayType(varType); arrType != nil {
+			// Global diziler ayrı bir global'de tutulan diziyi gösterir
+			storage := g.module.NewGlobalDef(varName+".array", constant.NewZeroInitializer(arrType))
+			globalVar.Init = storage
+		}
 
 		// Değer atanmışsa, değeri ata
 		if stmt.Value != nil {
-			if constVal := g.generateConstantExpression(stmt.Value); constVal != nil {
+			constVal := g.generateConstantExpression(stmt.Value)
+			if iface := g.interfaceInfo(varType); iface != nil && constVal != nil {
+				constVal = g.convertConstantToInterface(constVal, iface)
+			}
+			if constVal != nil {
 				globalVar.Init = constVal
 			}
 		}
@@ -1125,9 +1355,8 @@ func (g *IRGenerator) generateVarStatement(stmt *ast.VarStatement) {
 		}
 
 		// Değişken için bellek ayır
-		alloca := g.currentBB.NewAlloca(varType)
-		alloca.SetName(varName)
-		g.symbolTable[varName] = alloca
+		addr := g.newVariable(g.currentBB, varName, varName, varType)
+		g.symbolTable[varName] = addr
 
 		// Hata ayıklama bilgisi ekle
 		if g.generateDebug {
@@ -1137,6 +1366,16 @@ func (g *IRGenerator) generateVarStatement(stmt *ast.VarStatement) {
 			// g.debugInfo.InsertDeclare(...)
 		}
 
+		// Değer atanmamışsa değişken sıfır değerini alır; diziler sıfırlanmış
+		// bir diziyi gösterir
+		if stmt.Value == nil {
+			if arrType := pointedArrayType(varType); arrType != nil {
+				g.currentBB.NewStore(g.newArray(arrType), addr)
+			} else {
+				g.currentBB.NewStore(constant.NewZeroInitializer(varType), addr)
+			}
+		}
+
 		// Değer atanmışsa, değeri ata
 		if stmt.Value != nil {
 			// Hata ayıklama bilgisi için konum bilgisini ayarla
@@ -1147,7 +1386,8 @@ func (g *IRGenerator) generateVarStatement(stmt *ast.VarStatement) {
 
 			val := g.generateExpression(stmt.Value)
 			if val != nil {
-				g.currentBB.NewStore(val, alloca)
+				val = g.convertAssignedValue(val, varType, unsigned)
+				g.currentBB.NewStore(val, addr)
 			}
 		}
 	}
@@ -1170,17 +1410,30 @@ func (g *IRGenerator) generateReturnStatement(stmt *ast.ReturnStatement) {
 		g.debugInfo.SetLocation(pos.Line, pos.Column, g.sourceFile)
 	}
 
-	// Dönüş değeri varsa değerlendir
-	if stmt.ReturnValue != nil {
-		retVal := g.generateExpression(stmt.ReturnValue)
-		if retVal != nil {
-			g.currentBB.NewRet(retVal)
-		} else {
-			g.currentBB.NewRet(constant.NewInt(types.I32, 0)) // Varsayılan dönüş değeri
+	retType := g.currentFunc.Sig.RetType
+	switch len(stmt.ReturnValues) {
+	case 0:
+		// Çıplak return adlandırılmış sonuçları döndürür
+		g.generateBareReturn()
+	case 1:
+		retVal := g.generateExpression(stmt.ReturnValues[0])
+		if retVal == nil {
+			g.generateReturnValue(zeroValue(retType)) // Varsayılan dönüş değeri
+			return
 		}
-	} else {
-		// Dönüş değeri yoksa void dönüş
-		g.currentBB.NewRet(constant.NewInt(types.I32, 0)) // Varsayılan dönüş değeri
+		// return f() biçiminde çok değerli bir çağrının sonuçları aynen döndürülür
+		g.generateReturnValue(g.convertAssignedValue(retVal, retType, g.isUnsignedExpr(stmt.ReturnValues[0])))
+	default:
+		values := make([]value.Value, 0, len(stmt.ReturnValues))
+		for _, expr := range stmt.ReturnValues {
+			val := g.generateExpression(expr)
+			if val == nil {
+				g.generateReturnValue(zeroValue(retType))
+				return
+			}
+			values = append(values, val)
+		}
+		g.generateReturnValue(g.buildResults(retType, values, stmt.ReturnValues))
 	}
 }
 
@@ -1299,11 +1552,13 @@ func (g *IRGenerator) generateWhileStatement(stmt *ast.WhileStatement) {
 	// Koşula göre dallanma
 	g.currentBB.NewCondBr(condition, bodyBlock, endBlock)
 
-	// Döngü gövdesini işle
+	// Döngü gövdesini işle; continue koşul bloğuna döner
 	g.currentBB = bodyBlock
+	g.pushBranchTarget(endBlock, condBlock)
 	if stmt.Body != nil {
 		g.generateBlockStatement(stmt.Body)
 	}
+	g.popBranchTarget()
 
 	// Koşul bloğuna geri dön
 	if g.currentBB.Term == nil {
@@ -1356,11 +1611,13 @@ func (g *IRGenerator) generateForStatement(stmt *ast.ForStatement) {
 		g.currentBB.NewBr(bodyBlock)
 	}
 
-	// Döngü gövdesini işle
+	// Döngü gövdesini işle; continue post bloğuna gider
 	g.currentBB = bodyBlock
+	g.pushBranchTarget(endBlock, postBlock)
 	if stmt.Body != nil {
 		g.generateBlockStatement(stmt.Body)
 	}
+	g.popBranchTarget()
 	// Body'den post bloğuna git
 	if g.currentBB.Term == nil {
 		g.currentBB.NewBr(postBlock)
@@ -1420,7 +1677,8 @@ func (g *IRGenerator) generateSwitchStatement(stmt *ast.SwitchStatement) {
 		defaultBlock = endBlock
 	}
 
-	// Switch logic'i implement et
+	// Switch logic'i implement et; break end bloğuna gider
+	g.pushBranchTarget(endBlock, nil)
 	if switchValue != nil {
 		// Tag'li switch: her case değerini kontrol et
 		g.generateTaggedSwitch(stmt, switchValue, caseBlocks, defaultBlock, endBlock)
@@ -1428,6 +1686,7 @@ func (g *IRGenerator) generateSwitchStatement(stmt *ast.SwitchStatement) {
 		// Tag'siz switch: boolean case'ler
 		g.generateBooleanSwitch(stmt, caseBlocks, defaultBlock, endBlock)
 	}
+	g.popBranchTarget()
 
 	// End bloğuna geç
 	g.currentBB = endBlock
@@ -1453,7 +1712,7 @@ func (g *IRGenerator) generateTaggedSwitch(stmt *ast.SwitchStatement, switchValu
 			}
 
 			// Karşılaştırma yap
-			cmp := currentBlock.NewICmp(enum.IPredEQ, switchValue, val)
+			cmp := g.equalValues(currentBlock, switchValue, val, switchValue.Type())
 
 			// Sonraki karşılaştırma için yeni blok oluştur
 			nextBlock := g.currentFunc.NewBlock(fmt.Sprintf("switch.next.%d", i))
@@ -1609,36 +1868,80 @@ func (g *IRGenerator) generateArrayLiteral(expr *ast.ArrayLiteral) value.Value {
 	return arrayAlloca
 }
 
-// generateIndexExpression, bir array/slice indexing için IR üretir.
+// generateIndexExpression, bir array/slice indexing veya map okuması için IR
+// üretir. Map okumaları map'e girdi eklemez.
 func (g *IRGenerator) generateIndexExpression(expr *ast.IndexExpression) value.Value {
+	container := g.generateIndexContainer(expr)
+	if container == nil {
+		return nil
+	}
+	if info := g.mapInfoOf(container.Type()); info != nil {
+		val, _ := g.generateMapIndex(info, container, expr.Index)
+		return val
+	}
+	if g.isStringType(container.Type()) {
+		return g.generateStringIndex(container, expr.Index)
+	}
+
+	elementPtr, elementType := g.indexAddress(container, expr)
+	if elementPtr == nil {
+		return nil
+	}
+
+	// Element değerini load et
+	return g.currentBB.NewLoad(elementType, elementPtr)
+}
+
+// generateIndexContainer, bir indeks ifadesinde indekslenen değeri üretir.
+func (g *IRGenerator) generateIndexContainer(expr *ast.IndexExpression) value.Value {
 	if g.currentBB == nil {
 		g.ReportError("Geçerli bir blok yok, index expression değerlendirilemiyor")
 		return nil
 	}
+	return g.generateExpression(expr.Left)
+}
 
-	// Array/slice değerini al
-	arrayValue := g.generateExpression(expr.Left)
-	if arrayValue == nil {
-		return nil
+// generateIndexAddress, bir array/slice elemanının adresini ve tipini hesaplar.
+// Map'lerde atanacak değerin adresi döndürülür; anahtar yoksa map'e eklenir.
+func (g *IRGenerator) generateIndexAddress(expr *ast.IndexExpression) (value.Value, types.Type) {
+	container := g.generateIndexContainer(expr)
+	if container == nil {
+		return nil, nil
+	}
+	return g.indexAddress(container, expr)
+}
+
+// indexAddress, değerlendirilmiş container üzerinde expr'in indeksindeki
+// elemanın adresini ve tipini hesaplar.
+func (g *IRGenerator) indexAddress(arrayValue value.Value, expr *ast.IndexExpression) (value.Value, types.Type) {
+	if info := g.mapInfoOf(arrayValue.Type()); info != nil {
+		return g.generateMapElementAddress(info, arrayValue, expr.Index)
 	}
 
 	// Index değerini al
 	indexValue := g.generateExpression(expr.Index)
 	if indexValue == nil {
-		return nil
+		return nil, nil
 	}
 
 	// Index'in integer olduğunu kontrol et
 	if !types.IsInt(indexValue.Type()) {
 		g.ReportError("Array index integer olmalıdır, alınan: %s", indexValue.Type().String())
-		return nil
+		return nil, nil
 	}
 
+	return g.generateElementAddress(arrayValue, indexValue, true)
+}
+
+// generateElementAddress, bir array/slice değerinin verilen indeksteki
+// elemanının adresini ve tipini hesaplar. checkBounds ise indeks çalışma
+// zamanında uzunluğa karşı denetlenir.
+func (g *IRGenerator) generateElementAddress(arrayValue, indexValue value.Value, checkBounds bool) (value.Value, types.Type) {
 	// Array tipini kontrol et
 	arrayType, ok := arrayValue.Type().(*types.PointerType)
 	if !ok {
 		g.ReportError("Index expression sadece array/slice'larda kullanılabilir")
-		return nil
+		return nil, nil
 	}
 
 	// Element tipini belirle
@@ -1656,12 +1959,14 @@ func (g *IRGenerator) generateIndexExpression(expr *ast.IndexExpression) value.V
 				elementType = dataPtr.ElemType
 			}
 			// len field'ını al (index 1)
-			lenIndices := []value.Value{
-				constant.NewInt(types.I32, 0),
-				constant.NewInt(types.I32, 1),
+			if checkBounds {
+				lenIndices := []value.Value{
+					constant.NewInt(types.I32, 0),
+					constant.NewInt(types.I32, 1),
+				}
+				lenPtr := g.currentBB.NewGetElementPtr(structType, arrayValue, lenIndices...)
+				arrayLength = g.currentBB.NewLoad(types.I32, lenPtr)
 			}
-			lenPtr := g.currentBB.NewGetElementPtr(structType, arrayValue, lenIndices...)
-			arrayLength = g.currentBB.NewLoad(types.I32, lenPtr)
 		}
 	} else {
 		elementType = arrayType.ElemType
@@ -1670,7 +1975,7 @@ func (g *IRGenerator) generateIndexExpression(expr *ast.IndexExpression) value.V
 	}
 
 	// Runtime bounds checking
-	if arrayLength != nil {
+	if checkBounds && arrayLength != nil {
 		g.generateBoundsCheck(indexValue, arrayLength)
 	}
 
@@ -1694,8 +1999,7 @@ func (g *IRGenerator) generateIndexExpression(expr *ast.IndexExpression) value.V
 		elementPtr = g.currentBB.NewGetElementPtr(arrayType.ElemType, arrayValue, indices...)
 	}
 
-	// Element değerini load et
-	return g.currentBB.NewLoad(elementType, elementPtr)
+	return elementPtr, elementType
 }
 
 // generateFunctionStatement, bir fonksiyon tanımlaması için IR üretir.
@@ -1703,34 +2007,10 @@ func (g *IRGenerator) generateFunctionStatement(stmt *ast.FunctionStatement) {
 	// Fonksiyon adını al
 	funcName := stmt.Name.Value
 
-	// Parametre tiplerini belirle
-	paramTypes := make([]types.Type, len(stmt.Parameters))
-	for i := range stmt.Parameters {
-		// TODO: Parametre tip sistemi implement edilecek
-		paramTypes[i] = types.I32 // Varsayılan olarak int32
-	}
-
-	// Dönüş tipini belirle
-	var returnType types.Type = types.I32 // Varsayılan olarak int32
-	if stmt.ReturnType != nil {
-		if typeIdent, ok := stmt.ReturnType.(*ast.Identifier); ok {
-			if t, exists := g.typeTable[typeIdent.Value]; exists {
-				returnType = t
-			} else {
-				g.ReportError("Bilinmeyen tip: %s", typeIdent.Value)
-			}
-		} else {
-			g.ReportError("Desteklenmeyen tip ifadesi: %T", stmt.ReturnType)
-		}
-	}
-
-	// Fonksiyonu oluştur
-	fn := g.module.NewFunc(funcName, returnType)
-
-	// Parametreleri ekle
-	for i, param := range stmt.Parameters {
-		paramName := param.Value
-		fn.Params = append(fn.Params, ir.NewParam(paramName, paramTypes[i]))
+	// Global fonksiyonlar declareFunctions ile önceden tanımlanmıştır
+	fn, declared := g.symbolTable[funcName].(*ir.Func)
+	if !declared || len(fn.Blocks) > 0 {
+		fn = g.newFunction(funcName, stmt.Parameters, stmt.ReturnType)
 	}
 
 	// Hata ayıklama bilgisi ekle
@@ -1761,26 +2041,11 @@ func (g *IRGenerator) generateFunctionStatement(stmt *ast.FunctionStatement) {
 	entryBlock := fn.NewBlock("entry")
 	g.currentBB = entryBlock
 
-	// Parametreleri sembol tablosuna ekle
-	for i, param := range stmt.Parameters {
-		paramName := param.Value
-		paramVal := fn.Params[i]
-
-		// Parametre için yerel değişken oluştur
-		alloca := entryBlock.NewAlloca(paramTypes[i])
-		alloca.SetName(paramName + ".addr")
-		entryBlock.NewStore(paramVal, alloca)
-
-		// Hata ayıklama bilgisi ekle
-		if g.generateDebug {
-			// TODO: Debug API değişikliği nedeniyle geçici olarak devre dışı
-			// pos := param.Pos()
-			// localVar := g.debugInfo.CreateLocalVariable(...)
-			// g.debugInfo.InsertDeclare(...)
-		}
-
-		g.symbolTable[paramName] = alloca
-	}
+	// Parametreleri ve adlandırılmış sonuçları sembol tablosuna ekle
+	restore := g.enterFunctionScope(stmt.Body)
+	g.bindParameters(entryBlock, stmt.Parameters, fn.Params)
+	g.defineNamedResults(entryBlock, stmt.ReturnType)
+	g.enterDeferFrame(stmt.Body)
 
 	// Fonksiyon gövdesini işle
 	if stmt.Body != nil {
@@ -1807,7 +2072,7 @@ func (g *IRGenerator) generateFunctionStatement(stmt *ast.FunctionStatement) {
 			g.debugInfo.SetLocation(stmt.Body.End().Line, stmt.Body.End().Column, g.sourceFile)
 		}
 
-		g.currentBB.NewRet(constant.NewInt(types.I32, 0))
+		g.generateBareReturn()
 	}
 
 	// Fonksiyon hata ayıklama bilgisini tamamla
@@ -1817,6 +2082,7 @@ func (g *IRGenerator) generateFunctionStatement(stmt *ast.FunctionStatement) {
 	}
 
 	// Önceki durumu geri yükle
+	restore()
 	g.currentFunc = prevFunc
 	g.currentBB = prevBB
 
@@ -1844,29 +2110,70 @@ func (g *IRGenerator) generateLenCall(args []ast.Expression) value.Value {
 		return nil
 	}
 
-	// Argüman tipini kontrol et
-	argType := arg.Type()
-	if ptrType, ok := argType.(*types.PointerType); ok {
-		if arrType, ok := ptrType.ElemType.(*types.ArrayType); ok {
-			// Array için: sabit uzunluk döndür
-			return constant.NewInt(types.I32, int64(arrType.Len))
-		} else {
-			// Slice için: runtime length hesapla
-			// Slice struct: {data *T, len int32, cap int32}
-			// len field'ını al (index 1)
-			indices := []value.Value{
-				constant.NewInt(types.I32, 0), // Struct pointer
-				constant.NewInt(types.I32, 1), // len field
-			}
-			lenPtr := g.currentBB.NewGetElementPtr(ptrType.ElemType, arg, indices...)
-			return g.currentBB.NewLoad(types.I32, lenPtr)
+	length := g.generateLength(arg)
+	if length == nil {
+		g.ReportError("len() fonksiyonu sadece array, slice ve string'lerde kullanılabilir")
+	}
+	g.releaseTemporary(arg)
+	return length
+}
+
+// generateLength, bir array, slice veya string değerinin uzunluğunu üretir.
+// Uzunluk hesaplanamıyorsa nil döner.
+func (g *IRGenerator) generateLength(val value.Value) value.Value {
+	if g.isStringType(val.Type()) {
+		// String için: uzunluk alanı
+		return g.currentBB.NewTrunc(g.stringLen(val), types.I32)
+	}
+
+	ptrType, ok := val.Type().(*types.PointerType)
+	if !ok {
+		return nil
+	}
+
+	if g.chanElemType(ptrType) != nil {
+		// Kanal için: tampondaki eleman sayısı
+		return g.generateChanLength(val, "gominus_chanlen")
+	}
+
+	if g.mapInfoOf(ptrType) != nil {
+		// Map için: girdi sayısı
+		return g.generateMapLength(val)
+	}
+
+	if arrType, ok := ptrType.ElemType.(*types.ArrayType); ok {
+		// Array için: sabit uzunluk döndür
+		return constant.NewInt(types.I32, int64(arrType.Len))
+	}
+
+	if structType, ok := ptrType.ElemType.(*types.StructType); ok && len(structType.Fields) >= 2 {
+		// Slice için: runtime length hesapla
+		// Slice struct: {data *T, len int32, cap int32}
+		// len field'ını al (index 1)
+		indices := []value.Value{
+			constant.NewInt(types.I32, 0), // Struct pointer
+			constant.NewInt(types.I32, 1), // len field
 		}
+		lenPtr := g.currentBB.NewGetElementPtr(structType, val, indices...)
+		return g.currentBB.NewLoad(types.I32, lenPtr)
 	}
 
-	g.ReportError("len() fonksiyonu sadece array ve slice'larda kullanılabilir")
 	return nil
 }
 
+// getStrlenFunc, strlen fonksiyonunu bulur veya tanımlar.
+func (g *IRGenerator) getStrlenFunc() *ir.Func {
+	strlenFunc := g.getFunction("strlen")
+	if strlenFunc == nil {
+		// strlen(const char *str) -> size_t
+		strlenFunc = g.module.NewFunc("strlen",
+			types.I64,
+			ir.NewParam("str", types.NewPointer(types.I8)))
+		g.symbolTable["strlen"] = strlenFunc
+	}
+	return strlenFunc
+}
+
 // generateCapCall, cap() built-in function için IR üretir.
 func (g *IRGenerator) generateCapCall(args []ast.Expression) value.Value {
 	if len(args) != 1 {
@@ -1887,6 +2194,10 @@ func (g *IRGenerator) generateCapCall(args []ast.Expression) value.Value {
 
 	// Argüman tipini kontrol et
 	argType := arg.Type()
+	if g.chanElemType(argType) != nil {
+		// Kanal için: tampon kapasitesi
+		return g.generateChanLength(arg, "gominus_chancap")
+	}
 	if ptrType, ok := argType.(*types.PointerType); ok {
 		if arrType, ok := ptrType.ElemType.(*types.ArrayType); ok {
 			// Array için: sabit uzunluk döndür (len == cap)
@@ -1908,8 +2219,11 @@ func (g *IRGenerator) generateCapCall(args []ast.Expression) value.Value {
 	return nil
 }
 
-// generateAppendCall, append() built-in function için IR üretir.
-func (g *IRGenerator) generateAppendCall(args []ast.Expression) value.Value {
+// generateAppendCall, append() built-in function için IR üretir. spread
+// ise son argüman append(s, t...) biçiminde yayılan bir slice veya string'dir.
+// Kapasite yetmezse elemanlar yeni bir diziye taşınır; güncellenen slice
+// döndürülür.
+func (g *IRGenerator) generateAppendCall(args []ast.Expression, spread bool) value.Value {
 	if len(args) < 2 {
 		g.ReportError("append() fonksiyonu en az 2 argüman alır, %d verildi", len(args))
 		return nil
@@ -1926,110 +2240,54 @@ func (g *IRGenerator) generateAppendCall(args []ast.Expression) value.Value {
 		return nil
 	}
 
-	// Slice tipini kontrol et
-	sliceType := sliceArg.Type()
-	ptrType, ok := sliceType.(*types.PointerType)
-	if !ok {
-		g.ReportError("append() fonksiyonunun ilk argümanı slice olmalıdır")
-		return nil
-	}
-
 	// Element tipini belirle
-	var elementType types.Type
-	if structType, ok := ptrType.ElemType.(*types.StructType); ok {
-		// Slice struct: {data *T, len int32, cap int32}
-		if len(structType.Fields) >= 1 {
-			if dataPtr, ok := structType.Fields[0].(*types.PointerType); ok {
-				elementType = dataPtr.ElemType
-			}
-		}
-	}
-
+	elementType := sliceElemType(sliceArg.Type())
 	if elementType == nil {
-		g.ReportError("Slice element tipi belirlenemedi")
+		g.ReportError("append() fonksiyonunun ilk argümanı slice olmalıdır")
 		return nil
 	}
 
-	// Mevcut slice bilgilerini al
-	// len field (index 1)
-	lenIndices := []value.Value{
-		constant.NewInt(types.I32, 0),
-		constant.NewInt(types.I32, 1),
-	}
-	lenPtr := g.currentBB.NewGetElementPtr(ptrType.ElemType, sliceArg, lenIndices...)
-	currentLen := g.currentBB.NewLoad(types.I32, lenPtr)
+	if spread {
+		if len(args) != 2 {
+			g.ReportError("append(s, t...) biçiminde 2 argüman gerekir, %d verildi", len(args))
+			return nil
+		}
+		src := g.generateExpression(args[1])
+		if src == nil {
+			return nil
+		}
+		srcData, srcLen := g.copySource(src, elementType, "append")
+		if srcData == nil {
+			return nil
+		}
 
-	// cap field (index 2)
-	capIndices := []value.Value{
-		constant.NewInt(types.I32, 0),
-		constant.NewInt(types.I32, 2),
+		// Kaynak, kapasite artırılmadan önce okunur: append(s, s...) eski
+		// diziden kopyalar
+		result, dst := g.growSlice(sliceArg, elementType, srcLen)
+		g.moveElements(elementType, dst, srcData, srcLen)
+		return result
 	}
-	capPtr := g.currentBB.NewGetElementPtr(ptrType.ElemType, sliceArg, capIndices...)
-	currentCap := g.currentBB.NewLoad(types.I32, capPtr)
-
-	// Eklenecek element sayısını hesapla
-	appendCount := constant.NewInt(types.I32, int64(len(args)-1))
-	newLen := g.currentBB.NewAdd(currentLen, appendCount)
-
-	// Capacity kontrolü - basit implementasyon
-	needsRealloc := g.currentBB.NewICmp(enum.IPredSGT, newLen, currentCap)
-
-	// Conditional blocks oluştur
-	reallocBlock := g.currentFunc.NewBlock("realloc")
-	appendBlock := g.currentFunc.NewBlock("append")
-	endBlock := g.currentFunc.NewBlock("append_end")
-
-	g.currentBB.NewCondBr(needsRealloc, reallocBlock, appendBlock)
-
-	// Reallocation block
-	g.currentBB = reallocBlock
-	// Basit capacity doubling strategy
-	doubleCap := g.currentBB.NewMul(currentCap, constant.NewInt(types.I32, 2))
-	// Minimum capacity check
-	minCap := g.currentBB.NewICmp(enum.IPredSLT, doubleCap, newLen)
-	newCap := g.currentBB.NewSelect(minCap, newLen, doubleCap)
 
-	// TODO: Gerçek memory reallocation implementasyonu
-	// Şimdilik sadece capacity'yi güncelle
-	g.currentBB.NewStore(newCap, capPtr)
-	g.currentBB.NewBr(appendBlock)
-
-	// Append block
-	g.currentBB = appendBlock
-	// Yeni elementleri ekle
-	for i, argExpr := range args[1:] {
+	// Eklenecek değerler kapasite kontrolünden önce değerlendirilir
+	values := make([]value.Value, 0, len(args)-1)
+	for _, argExpr := range args[1:] {
 		elementVal := g.generateExpression(argExpr)
 		if elementVal == nil {
-			continue
-		}
-
-		// Element index'ini hesapla
-		elementIndex := g.currentBB.NewAdd(currentLen, constant.NewInt(types.I32, int64(i)))
-
-		// Data pointer'ını al (index 0)
-		dataIndices := []value.Value{
-			constant.NewInt(types.I32, 0),
-			constant.NewInt(types.I32, 0),
+			return nil
 		}
-		dataPtr := g.currentBB.NewGetElementPtr(ptrType.ElemType, sliceArg, dataIndices...)
-		dataArray := g.currentBB.NewLoad(types.NewPointer(elementType), dataPtr)
+		values = append(values, g.convertAssignedValue(elementVal, elementType, g.isUnsignedExpr(argExpr)))
+	}
 
-		// Element adresini hesapla
-		elementPtr := g.currentBB.NewGetElementPtr(elementType, dataArray, elementIndex)
+	result, dst := g.growSlice(sliceArg, elementType, constant.NewInt(types.I32, int64(len(values))))
 
-		// Element'i store et
+	// Yeni elementleri ekle
+	for i, elementVal := range values {
+		elementPtr := g.currentBB.NewGetElementPtr(elementType, dst, constant.NewInt(types.I32, int64(i)))
 		g.currentBB.NewStore(elementVal, elementPtr)
 	}
 
-	// Length'i güncelle
-	g.currentBB.NewStore(newLen, lenPtr)
-	g.currentBB.NewBr(endBlock)
-
-	// End block
-	g.currentBB = endBlock
-
-	// Güncellenmiş slice'ı döndür
-	return sliceArg
+	// Yeni başlıklı slice'ı döndür
+	return result
 }
 
 // generateMakeCall, make() built-in function için IR üretir.
@@ -2049,6 +2307,12 @@ func (g *IRGenerator) generateMakeCall(args []ast.Expression) value.Value {
 
 	// Tip ifadesini analiz et
 	switch t := typeExpr.(type) {
+	case *ast.ChanType:
+		// Kanal oluştur: make(chan T, cap)
+		return g.generateMakeChan(t, args)
+	case *ast.MapType:
+		// Map oluştur: make(map[K]V, boyut)
+		return g.generateMakeMap(t, args)
 	case *ast.ArrayType:
 		// Slice oluştur: make([]T, len, cap)
 		if t.Size != nil {
@@ -2056,65 +2320,39 @@ func (g *IRGenerator) generateMakeCall(args []ast.Expression) value.Value {
 			return nil
 		}
 
-		// Element tipini belirle
-		var elementType types.Type = types.I32 // Varsayılan
-		if elemIdent, ok := t.ElementType.(*ast.Identifier); ok {
-			if et, exists := g.typeTable[elemIdent.Value]; exists {
-				elementType = et
-			}
+		// Element tipini belirle; eleman tipi bir slice olabilir ([][]T)
+		sliceType, ok := g.resolveType(t).(*types.PointerType)
+		if !ok {
+			return nil
 		}
+		elementType := sliceElemType(sliceType)
 
 		// Length argümanı (zorunlu)
 		var length value.Value
 		if len(args) >= 2 {
-			length = g.generateExpression(args[1])
+			if length = g.sliceIndexValue(args[1]); length == nil {
+				return nil
+			}
 		} else {
 			g.ReportError("make() slice için length argümanı gerekli")
 			return nil
 		}
 
 		// Capacity argümanı (opsiyonel, varsayılan length ile aynı)
-		var capacity value.Value
+		capacity := length
 		if len(args) >= 3 {
-			capacity = g.generateExpression(args[2])
-		} else {
-			capacity = length
+			if capacity = g.sliceIndexValue(args[2]); capacity == nil {
+				return nil
+			}
 		}
 
-		// Slice struct oluştur: {data *T, len int32, cap int32}
-		sliceStructType := types.NewStruct(
-			types.NewPointer(elementType), // data
-			types.I32,                     // len
-			types.I32,                     // cap
-		)
-
-		// Slice için bellek ayır
-		sliceAlloca := g.currentBB.NewAlloca(sliceStructType)
-
-		// Data array için bellek ayır (basit implementasyon)
-		// TODO: Gerçek heap allocation implementasyonu
-		dataArrayType := types.NewArray(1024, elementType) // Sabit boyut
-		dataAlloca := g.currentBB.NewAlloca(dataArrayType)
-		dataPtr := g.currentBB.NewGetElementPtr(dataArrayType, dataAlloca,
-			constant.NewInt(types.I32, 0), constant.NewInt(types.I32, 0))
+		// 0 <= len <= cap olmalı
+		negative := g.currentBB.NewICmp(enum.IPredSLT, length, constant.NewInt(types.I32, 0))
+		tooLong := g.currentBB.NewICmp(enum.IPredSGT, length, capacity)
+		g.generatePanicIf(g.currentBB.NewOr(negative, tooLong), "makeslice", "runtime error: makeslice: len out of range")
 
-		// Slice struct'ını doldur
-		// data field (index 0)
-		dataFieldPtr := g.currentBB.NewGetElementPtr(sliceStructType, sliceAlloca,
-			constant.NewInt(types.I32, 0), constant.NewInt(types.I32, 0))
-		g.currentBB.NewStore(dataPtr, dataFieldPtr)
-
-		// len field (index 1)
-		lenFieldPtr := g.currentBB.NewGetElementPtr(sliceStructType, sliceAlloca,
-			constant.NewInt(types.I32, 0), constant.NewInt(types.I32, 1))
-		g.currentBB.NewStore(length, lenFieldPtr)
-
-		// cap field (index 2)
-		capFieldPtr := g.currentBB.NewGetElementPtr(sliceStructType, sliceAlloca,
-			constant.NewInt(types.I32, 0), constant.NewInt(types.I32, 2))
-		g.currentBB.NewStore(capacity, capFieldPtr)
-
-		return sliceAlloca
+		// Elemanlar heap'te sıfırlanmış olarak ayrılır
+		return g.newSlice(sliceType, g.allocElements(elementType, capacity), length, capacity)
 
 	default:
 		g.ReportError("make() fonksiyonu desteklenmeyen tip ile kullanıldı: %T", typeExpr)
@@ -2122,90 +2360,6 @@ func (g *IRGenerator) generateMakeCall(args []ast.Expression) value.Value {
 	}
 }
 
-// String operations helper functions
-
-// isStringType, bir tipin string tipi olup olmadığını kontrol eder.
-func (g *IRGenerator) isStringType(t types.Type) bool {
-	// String'i pointer to i8 array olarak handle ediyoruz
-	if ptrType, ok := t.(*types.PointerType); ok {
-		if arrType, ok := ptrType.ElemType.(*types.ArrayType); ok {
-			return arrType.ElemType == types.I8
-		}
-		// Ayrıca direkt i8 pointer'ı da string olarak kabul et
-		return ptrType.ElemType == types.I8
-	}
-	return false
-}
-
-// generateStringConcatenation, iki string'i birleştirmek için IR üretir.
-func (g *IRGenerator) generateStringConcatenation(left, right value.Value) value.Value {
-	if g.currentBB == nil {
-		g.ReportError("Geçerli bir blok yok, string concatenation yapılamıyor")
-		return nil
-	}
-
-	// strcat fonksiyonunu bul veya tanımla
-	strcatFunc := g.getFunction("strcat")
-	if strcatFunc == nil {
-		// strcat(char *dest, const char *src) -> char*
-		strcatFunc = g.module.NewFunc("strcat",
-			types.NewPointer(types.I8),
-			ir.NewParam("dest", types.NewPointer(types.I8)),
-			ir.NewParam("src", types.NewPointer(types.I8)))
-		g.symbolTable["strcat"] = strcatFunc
-	}
-
-	// strlen fonksiyonunu bul veya tanımla (buffer size hesaplamak için)
-	strlenFunc := g.getFunction("strlen")
-	if strlenFunc == nil {
-		// strlen(const char *str) -> size_t (i32 olarak handle edelim)
-		strlenFunc = g.module.NewFunc("strlen",
-			types.I32,
-			ir.NewParam("str", types.NewPointer(types.I8)))
-		g.symbolTable["strlen"] = strlenFunc
-	}
-
-	// malloc fonksiyonunu bul veya tanımla
-	mallocFunc := g.getFunction("malloc")
-	if mallocFunc == nil {
-		// malloc(size_t size) -> void*
-		mallocFunc = g.module.NewFunc("malloc",
-			types.NewPointer(types.I8),
-			ir.NewParam("size", types.I32))
-		g.symbolTable["malloc"] = mallocFunc
-	}
-
-	// strcpy fonksiyonunu bul veya tanımla
-	strcpyFunc := g.getFunction("strcpy")
-	if strcpyFunc == nil {
-		// strcpy(char *dest, const char *src) -> char*
-		strcpyFunc = g.module.NewFunc("strcpy",
-			types.NewPointer(types.I8),
-			ir.NewParam("dest", types.NewPointer(types.I8)),
-			ir.NewParam("src", types.NewPointer(types.I8)))
-		g.symbolTable["strcpy"] = strcpyFunc
-	}
-
-	// String uzunluklarını hesapla
-	leftLen := g.currentBB.NewCall(strlenFunc, left)
-	rightLen := g.currentBB.NewCall(strlenFunc, right)
-
-	// Toplam uzunluk + null terminator için 1
-	totalLen := g.currentBB.NewAdd(leftLen, rightLen)
-	totalLen = g.currentBB.NewAdd(totalLen, constant.NewInt(types.I32, 1))
-
-	// Yeni buffer ayır
-	newBuffer := g.currentBB.NewCall(mallocFunc, totalLen)
-
-	// İlk string'i kopyala
-	g.currentBB.NewCall(strcpyFunc, newBuffer, left)
-
-	// İkinci string'i ekle
-	result := g.currentBB.NewCall(strcatFunc, newBuffer, right)
-
-	return result
-}
-
 // generateBoundsCheck, array/slice indexing için bounds checking IR'ı üretir.
 func (g *IRGenerator) generateBoundsCheck(index, length value.Value) {
 	if g.currentBB == nil {
@@ -2221,29 +2375,31 @@ func (g *IRGenerator) generateBoundsCheck(index, length value.Value) {
 
 	// Herhangi bir koşul true ise panic
 	outOfBounds := g.currentBB.NewOr(negativeCheck, boundsCheck)
+	g.generatePanicIf(outOfBounds, "bounds", "runtime error: index out of range")
+}
+
+// generatePanicIf, koşul doğruysa verilen mesajla panic çağıran IR üretir.
+// Bloklar prefix_panic.N ve prefix_ok.N olarak adlandırılır; üretim
+// prefix_ok.N bloğunda devam eder.
+func (g *IRGenerator) generatePanicIf(cond value.Value, prefix, message string) {
+	g.labelCounter++
+	labelSuffix := fmt.Sprintf(".%d", g.labelCounter)
 
 	// Panic ve normal execution blokları oluştur
-	panicBlock := g.currentFunc.NewBlock("bounds_panic")
-	normalBlock := g.currentFunc.NewBlock("bounds_ok")
+	panicBlock := g.currentFunc.NewBlock(prefix + "_panic" + labelSuffix)
+	normalBlock := g.currentFunc.NewBlock(prefix + "_ok" + labelSuffix)
 
 	// Koşullu dallanma
-	g.currentBB.NewCondBr(outOfBounds, panicBlock, normalBlock)
+	g.currentBB.NewCondBr(cond, panicBlock, normalBlock)
 
 	// Panic block - runtime error
 	g.currentBB = panicBlock
 
-	// panic fonksiyonunu bul veya tanımla
-	panicFunc := g.getFunction("panic")
-	if panicFunc == nil {
-		// panic(const char* message) -> void
-		panicFunc = g.module.NewFunc("panic",
-			types.Void,
-			ir.NewParam("message", types.NewPointer(types.I8)))
-		g.symbolTable["panic"] = panicFunc
-	}
+	// panic(const char* message) -> void
+	panicFunc := g.getPanicFunction()
 
 	// Error message oluştur
-	errorMsg := g.generateStringLiteral(&ast.StringLiteral{Value: "runtime error: index out of range"})
+	errorMsg := g.stringConstant(message)
 
 	// panic çağrısı
 	g.currentBB.NewCall(panicFunc, errorMsg)
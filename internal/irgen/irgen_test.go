@@ -112,6 +112,743 @@ func main() {
 			wantErr:  false,
 			contains: []string{"define", "main", "define", "add", "call", "ret"},
 		},
+		{
+			name: "Bitwise operators",
+			input: `
+package main
+
+func main() {
+    var flags int = 6
+    var x int = (flags & 3) | (flags ^ 1) &^ 4
+    var y int = ^x << 2
+}
+`,
+			wantErr:  false,
+			contains: []string{"and i32", "or i32", "xor i32", "xor i32 %", "-1", "shl i32"},
+		},
+		{
+			name: "Signed right shift",
+			input: `
+package main
+
+func main() {
+    var s int = -64
+    var n uint = 3
+    var r int = s >> n
+}
+`,
+			wantErr:  false,
+			contains: []string{"ashr i32", "select"},
+		},
+		{
+			name: "Unsigned right shift",
+			input: `
+package main
+
+func main() {
+    var u uint = 64
+    var r uint = u >> 2
+}
+`,
+			wantErr:  false,
+			contains: []string{"lshr i32"},
+		},
+		{
+			name: "Wide shift count",
+			input: `
+package main
+
+func main() {
+    var x int32 = 3
+    var n int64 = 4294967297
+    var r int32 = x << n
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"icmp ult i64 %", // sayı daraltılmadan önce karşılaştırılır
+				"trunc i64 %",
+				"shl i32",
+			},
+		},
+		{
+			name: "Negative shift count",
+			input: `
+package main
+
+func main() {
+    var x int = 1
+    var n int = -1
+    var r int = x << n
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"icmp slt i64 %",
+				"runtime error: negative shift amount",
+			},
+		},
+		{
+			name: "Compound assignment",
+			input: `
+package main
+
+func main() {
+    var sum int = 0
+    var i int = 0
+    while i < 10 {
+        sum += i
+        i += 1
+    }
+    sum %= 7
+    sum |= 8
+}
+`,
+			wantErr:  false,
+			contains: []string{"load i32, i32* %sum", "add i32", "srem i32", "or i32", "store i32"},
+		},
+		{
+			name: "Break and continue",
+			input: `
+package main
+
+func main() {
+    var sum int = 0
+    var i int = 0
+dis:
+    for i < 10 {
+        i += 1
+        if i == 2 {
+            continue
+        }
+        switch i {
+        case 5:
+            break
+        case 8:
+            break dis
+        }
+        sum += i
+    }
+    goto son
+    sum = 0
+son:
+    println(sum)
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"br label %for.post.1",   // continue
+				"br label %switch.end.3", // switch içindeki break
+				"br label %for.end.1",    // break dis
+				"br label %label.son",    // goto son
+			},
+		},
+		{
+			name: "Delete with mismatched key type",
+			input: `
+package main
+
+func main() {
+    m := map[string]int{"a": 1}
+    delete(m, 1)
+}
+`,
+			wantErr: true,
+		},
+		{
+			name: "Goto undefined label",
+			input: `
+package main
+
+func main() {
+    goto yok
+}
+`,
+			wantErr: true,
+		},
+		{
+			name: "Range loops",
+			input: `
+package main
+
+func main() {
+    var xs = [10, 20, 30]
+    var sum int = 0
+    for i, v := range xs {
+        sum += i * v
+    }
+    for i := range 10 {
+        if i == 5 {
+            continue
+        }
+        sum += i
+    }
+    for _, r := range "çay" {
+        sum += r
+    }
+    println(sum)
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"range.cond.1",                          // dizi üzerinde döngü
+				"br label %range.post.",                 // continue sayacı ilerletir
+				"call { i32, i32 } @gominus.decoderune", // string'ler rune olarak çözülür
+				"icmp slt i32",
+			},
+		},
+		{
+			name: "Struct types",
+			input: `
+package main
+
+type Base struct {
+    id int
+}
+
+type Point struct {
+    x, y int ` + "`json:\"xy\"`" + `
+}
+
+struct Shape {
+    Base
+    origin Point
+}
+
+func main() {
+    var p = Point{x: 1, y: 2}
+    var q = p
+    q.x = 10
+    var s Shape
+    s.id = 5
+    s.origin = q
+    return p.x + s.origin.x + s.id
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"%Point = type { i32, i32 }",
+				"%Shape = type { %Base, %Point }",
+				"insertvalue %Point zeroinitializer, i32 1, 0",
+				"store %Point %2, %Point* %q",                           // değer kopyası
+				"getelementptr %Shape, %Shape* %s, i32 0, i32 0, i32 0", // yükseltilmiş alan
+			},
+		},
+		{
+			name: "Struct array fields, equality and embedded pointers",
+			input: `
+package main
+
+type Base struct {
+    id int
+}
+
+type Box struct {
+    *Base
+    arr [3]int
+}
+
+type Pair struct {
+    n int
+    s string
+}
+
+func main() {
+    var b Box
+    b.arr[0] = 4
+    c := b
+    b.Base = &Base{1}
+    b.id = 2
+    p := Pair{1, "a"}
+    q := Pair{n: 1}
+    if p != q {
+        return 1
+    }
+    return c.arr[0]
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"%Box = type { %Base*, [3 x i32] }",          // diziler struct'ın içinde
+				"getelementptr %Box, %Box* %b, i32 0, i32 1", // b.arr, alanın adresidir
+				"load %Base*, %Base** %",                     // gömülü işaretçi yüklenir
+				"getelementptr %Base, %Base* %",              // yükseltilmiş alan
+				"call i32 @gominus_strequal(",                // alan alan karşılaştırma
+				"xor i1 %",                                   // !=
+			},
+		},
+		{
+			name: "Interfaces",
+			input: `
+package main
+
+type Shape interface {
+    Area() int
+}
+
+type Square struct {
+    side int
+}
+
+func (s Square) Area() int {
+    return s.side * s.side
+}
+
+type Cube struct {
+    Square
+}
+
+func main() {
+    var sh Shape = Cube{Square{2}}
+    var sum int = sh.Area()
+    var x interface{} = sh
+    switch v := x.(type) {
+    case Square:
+        sum += v.side
+    case nil:
+        sum += 1
+    }
+    var sq = x.(Cube)
+    return sum + sq.Area()
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"%Shape = type { i8*, i8* }",
+				"define i32 @Square.Area(%Square* %s)",
+				"define i32 @Cube.Area(%Cube* %recv)", // yükseltilmiş metot sarmalayıcısı
+				"@gominus.itab.Cube.Area = constant [2 x i8*]",
+				"call i8* @malloc(", // değer yığına kopyalanır
+				"icmp eq i8* %",     // tip tanımlayıcısı karşılaştırması
+				"typeswitch.case.0.",
+				"call void @panic",
+			},
+		},
+		{
+			name: "Comma-ok type assertion",
+			input: `
+package main
+
+type Shape interface {
+    Area() int
+}
+
+type Square struct {
+    side int
+}
+
+func (s Square) Area() int {
+    return s.side * s.side
+}
+
+func main() {
+    var x interface{} = 3
+    sq, ok := x.(Square)
+    var sh Shape
+    sh, ok = x.(Shape)
+    return sq.side
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"assert.unbox.",
+				"phi %Square [ %",
+				"[ zeroinitializer, %entry ]",
+				"select i1 %",
+			},
+		},
+		{
+			name: "Named types",
+			input: `
+package main
+
+type Celsius float64
+type Count int
+type Total = Count
+
+type Getter interface {
+    Get() int
+}
+
+func (c Count) Get() int {
+    return int(c)
+}
+
+func (c Celsius) Double() Celsius {
+    return c * 2.0
+}
+
+func main() {
+    var c Celsius = Celsius(21)
+    var d = c.Double()
+    var n Total = 4
+    var g Getter = n
+    var back = g.(Count)
+    return g.Get() + int(back) + int(d)
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"define i32 @Count.Get(i32* %c)",
+				"define double @Celsius.Double(double* %c)",
+				"@gominus.type.Count = constant [6 x i8] c\"Count\\00\"",
+				"@gominus.itab.Count.Get = constant [2 x i8*]",
+				"sitofp i32 21 to double",
+				"call double @Celsius.Double(double* %",
+			},
+		},
+		{
+			name: "Multiple returns",
+			input: `
+package main
+
+func divmod(a, b int) (int, int) {
+    return a / b, a % b
+}
+
+func split(sum int) (x, y int) {
+    x = sum * 4 / 9
+    y = sum - x
+    return
+}
+
+func main() {
+    q, r := divmod(17, 5)
+    a, b := split(q + r)
+    a, b = b, a
+    return a - b
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"define { i32, i32 } @divmod(i32 %a, i32 %b)",
+				"insertvalue { i32, i32 } %6, i32 %5, 1", // çoklu dönüş
+				"%x = alloca i32",                        // adlandırılmış sonuç
+				"ret { i32, i32 } %9",                    // çıplak return
+				"extractvalue { i32, i32 } %0, 1",
+			},
+		},
+		{
+			name: "Variadic functions",
+			input: `
+package main
+
+func sum(base int, xs ...int) int {
+    total := base
+    for _, x := range xs {
+        total += x
+    }
+    return total
+}
+
+func forward(xs ...int) int {
+    return sum(0, xs...)
+}
+
+func main() {
+    return sum(1, 2, 3) + sum(0) + forward(4, 5)
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"define i32 @sum(i32 %base, { i32*, i32, i32 }* %xs)",
+				"alloca [2 x i32]",                             // fazladan argümanlar
+				"store i32* null, i32** %",                     // argümansız çağrı
+				"call i32 @sum(i32 0, { i32*, i32, i32 }* %0)", // yayılan slice
+			},
+		},
+		{
+			name: "Closures",
+			input: `
+package main
+
+func counter() func() int {
+    n := 0
+    return func() int {
+        n++
+        return n
+    }
+}
+
+func twice(f func(int) int, x int) int {
+    return f(f(x))
+}
+
+func inc(x int) int {
+    return x + 1
+}
+
+func main() {
+    next := counter()
+    next()
+    return next() + twice(inc, 1)
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"%n = bitcast i8* %0 to i32*", // yakalanan değişken yığında
+				"insertvalue { i32 (i8*)*, i8* } { i32 (i8*)* @counter.func1, i8* null }, i8* %1, 1",
+				"define i32 @counter.func1(i8* %env)",
+				"%n = load i32*, i32** %1", // referansla yakalama
+				"define i32 @twice({ i32 (i8*, i32)*, i8* } %f, i32 %x)",
+				"{ i32 (i8*, i32)* @inc.closure, i8* null }",
+			},
+		},
+		{
+			name: "Defer",
+			input: `
+package main
+
+func double() (a int, b int) {
+    defer func() {
+        a = a * 2
+    }()
+    return 3, 4
+}
+
+func safe(f func() int) (v int) {
+    defer func() {
+        r := recover()
+        if r != nil {
+            v = -1
+        }
+    }()
+    return f()
+}
+
+func main() {
+    a, b := double()
+    return a + b
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"@gominus.frames = internal thread_local global i8* zeroinitializer",
+				"call i32 @_setjmp(i8* %",                          // panic iniş noktası
+				"store void (i8*)* @double.defer1, void (i8*)** %", // LIFO listesine kayıt
+				"call void @gominus.rundefers(i8* %",
+				"define internal void @double.defer1(i8* %env)",
+				"store i1 false, i1* @gominus.panicking", // recover paniği durdurur
+				"call void @longjmp(i8* %",
+			},
+		},
+		{
+			name: "Goroutines",
+			input: `
+package main
+
+import "runtime"
+
+func worker(id int, done func(int)) {
+    done(id)
+}
+
+func main() {
+    total := 0
+    go worker(1, func(n int) {
+        total = total + n
+    })
+    runtime.Gosched()
+    return runtime.NumGoroutine()
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"define internal void @main.go1(i8* %env)",
+				"call void @gominus.go(void (i8*)* @main.go1, i8* %",
+				"call i32 @gominus_spawn(i8* (i8*)* @gominus.goroutine, i8* %",
+				"atomicrmw add i32* @gominus.goroutines, i32 1 seq_cst",
+				"call void @gominus_gosched()",
+			},
+		},
+		{
+			name: "Channels",
+			input: `
+package main
+
+func produce(out chan<- int) {
+    out <- 1
+    close(out)
+}
+
+func main() {
+    ch := make(chan int, 2)
+    go produce(ch)
+    v, ok := <-ch
+    n := len(ch) + cap(ch)
+    select {
+    case w := <-ch:
+        n = n + w
+    case ch <- v:
+    default:
+    }
+    for x := range ch {
+        n = n + x
+    }
+    return n
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"%gominus.chan.0 = type opaque",
+				"call i8* @gominus_makechan(i64 ptrtoint (i32* getelementptr (i32, i32* null, i32 1) to i64), i64 2)",
+				"call i32 @gominus_chansend(i8* %",
+				"call i32 @gominus_chanrecv(i8* %",
+				"call i32 @gominus_chanclose(i8* %",
+				"call i64 @gominus_chanlen(i8* %",
+				"call i64 @gominus_chancap(i8* %",
+				"call i32 @gominus_select({ i8*, i8*, i32, i32 }* %",
+				"send on closed channel",
+			},
+		},
+		{
+			name: "Maps",
+			input: `
+package main
+
+func main() {
+    m := map[string]int{"a": 1}
+    m["b"] = 2
+    v, ok := m["a"]
+    delete(m, "a")
+    n := len(m) + v
+    for k, x := range m {
+        n = n + x
+    }
+    return n
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"%gominus.map.0 = type opaque",
+				"define internal i64 @gominus.hash.",
+				"define internal i32 @gominus.eq.",
+				"call i64 @gominus_strhash(i8* %",
+				"call i8* @gominus_makemap(",
+				"call i8* @gominus_mapaccess(i8* %",
+				"call i8* @gominus_mapassign(i8* %",
+				"call void @gominus_mapdelete(i8* %",
+				"call i64 @gominus_maplen(i8* %",
+				"call i32 @gominus_mapiternext(",
+				"assignment to entry in nil map",
+			},
+		},
+		{
+			name: "Strings",
+			input: `
+package main
+
+func main() {
+    s := "merhaba"
+    t := s[1:3] + s[:2] + s[4:]
+    b := s[0]
+    if s < t {
+        return len(t)
+    }
+    for i, r := range s {
+        b = b + byte(i)
+    }
+    return len(s)
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"%string = type { i8*, i64 }",
+				"call i32 @gominus_concatstrings(%string* %",
+				"call i32 @gominus_cmpstring(i8* %",
+				"extractvalue %string %",
+				"runtime error: slice bounds out of range",
+				"runtime error: index out of range",
+			},
+		},
+		{
+			name: "Temporary strings",
+			input: `
+package main
+
+import "fmt"
+
+func main() {
+    s := "a"
+    t := s + "b"
+    fmt.Println(s + t)
+    if s+"b" == t {
+        return len(string(s[0]) + t)
+    }
+    return 0
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"declare void @gominus_releasestring(i8* %s, i32 %owned)",
+				"call void @gominus_releasestring(i8* %",
+				"i32 1)",
+			},
+		},
+		{
+			name: "Slices",
+			input: `
+package main
+
+func main() {
+    xs := make([]int, 5, 10)
+    a := xs[1:3]
+    b := append(xs[:2:2], 9)
+    b = append(b, a...)
+    var arr [4]int
+    s := arr[1:]
+    n := copy(s, xs)
+    grid := [][]int{{1, 2}, {3}}
+    return n + grid[1][0] + len(b)
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"call i8* @calloc(i64 %",
+				"call i8* @memmove(i8* %",
+				"store [4 x i32] zeroinitializer, [4 x i32]* %",
+				"runtime error: slice bounds out of range",
+				"runtime error: makeslice: len out of range",
+				"realloc.",
+			},
+		},
+		{
+			name: "Pointers",
+			input: `
+package main
+
+type Point struct {
+    X int
+}
+
+func (p *Point) Move(dx int) {
+    p.X += dx
+}
+
+func main() {
+    x := 1
+    p := &x
+    *p = 2
+    q := &Point{X: 3}
+    q.Move(*p)
+    unsafe {
+        buf := alloc(int, 4)
+        *(buf + 1) = q.X
+        free(buf)
+    }
+    return x
+}
+`,
+			wantErr: false,
+			contains: []string{
+				"define i32 @Point.Move(%Point* %p, i32 %dx)",
+				"call i8* @malloc(i64 ptrtoint (i32* getelementptr (i32, i32* null, i32 1) to i64))",
+				"call i8* @calloc(i64 4, ",
+				"getelementptr i32, i32* %",
+				"call void @free(i8* %",
+				"runtime error: invalid memory address or nil pointer dereference",
+			},
+		},
 		{
 			name: "Invalid syntax",
 			input: `
@@ -167,6 +904,94 @@ func main() {
 	}
 }
 
+// TestTargetDependentRuntimeCalls tests that target-dependent runtime calls
+// are lowered for the target triple rather than the host platform.
+func TestTargetDependentRuntimeCalls(t *testing.T) {
+	input := `
+package main
+
+import "runtime"
+
+func work() {
+}
+
+func main() {
+    go work()
+    return runtime.NumCPU()
+}
+`
+	tests := []struct {
+		triple   string
+		wantErr  bool
+		contains string
+	}{
+		{triple: "x86_64-unknown-linux-gnu", contains: "call i64 @sysconf(i32 84)"},
+		{triple: "aarch64-apple-darwin", contains: "call i64 @sysconf(i32 58)"},
+		{triple: "x86_64-darwin", contains: "call i64 @sysconf(i32 58)"},
+		{triple: "x86_64-pc-windows-msvc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.triple, func(t *testing.T) {
+			program := parser.New(lexer.New(input)).ParseProgram()
+			analyzer := semantic.New()
+			analyzer.Analyze(program)
+
+			generator := NewWithAnalyzer(analyzer)
+			generator.SetTargetTriple(tt.triple)
+			ir, err := generator.GenerateProgram(program)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GenerateProgram() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !strings.Contains(ir, tt.contains) {
+				t.Errorf("IR does not contain %q", tt.contains)
+			}
+		})
+	}
+}
+
+// TestCompoundAssignmentEvaluatesLeftOnce tests that the left-hand side of a
+// compound assignment is evaluated only once.
+func TestCompoundAssignmentEvaluatesLeftOnce(t *testing.T) {
+	input := `
+package main
+
+func next() int {
+    return 1
+}
+
+func main() {
+    var a = [1, 2, 3]
+    a[next()] += 1
+}
+`
+	l := lexer.New(input)
+	p := parser.New(l)
+	program := p.ParseProgram()
+	if len(p.Errors()) > 0 {
+		t.Fatalf("Parser errors: %v", p.Errors())
+	}
+
+	analyzer := semantic.New()
+	analyzer.Analyze(program)
+
+	generator := NewWithAnalyzer(analyzer)
+	ir, err := generator.GenerateProgram(program)
+	if err != nil {
+		t.Fatalf("GenerateProgram() error = %v", err)
+	}
+
+	if n := strings.Count(ir, "call i32 @next()"); n != 1 {
+		t.Errorf("expected next() to be called once, got %d calls", n)
+	}
+	if !strings.Contains(ir, "add i32") || !strings.Contains(ir, "store i32") {
+		t.Errorf("IR does not contain load/add/store sequence:\n%s", ir)
+	}
+}
+
 // TestDebugInfo tests the debug information generation.
 func TestDebugInfo(t *testing.T) {
 	// Create a simple program
@@ -0,0 +1,526 @@
+package irgen
+
+import (
+	"fmt"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// Map'ler, programla birlikte bağlanan C runtime'ında (codegen/runtime/map.c)
+// uygulanır. Bir map değeri runtime'ın map yapısını gösteren bir işaretçidir;
+// sıfır değeri nil map'tir. Her anahtar ve değer tipi çifti için ayrı bir
+// opak tip (gominus.map.N) tanımlanır. Runtime anahtarları bilmediğinden her
+// anahtar tipi için bir özet (gominus.hash.N) ve bir eşitlik (gominus.eq.N)
+// fonksiyonu üretilip map oluşturulurken runtime'a verilir. Anahtarlar ve
+// değerler runtime'a adresleriyle verilir.
+
+// mapInfo, bir map tipini, anahtar ve değer tiplerini ve anahtar tipinin
+// özet ve eşitlik fonksiyonlarını tutar.
+type mapInfo struct {
+	Type  *types.PointerType
+	Key   types.Type
+	Value types.Type
+	hash  *ir.Func
+	eq    *ir.Func
+}
+
+// mapIterType, range döngüsünün runtime yineleyicisidir: { map, indeks, sıra, dönem }.
+var mapIterType = types.NewStruct(bytePtr, types.I64, types.I64, types.I64)
+
+// Runtime'a verilen özet ve eşitlik fonksiyonlarının tipleri.
+var (
+	mapHashFuncType = types.NewFunc(types.I64, bytePtr)
+	mapEqFuncType   = types.NewFunc(types.I32, bytePtr, bytePtr)
+)
+
+// FNV-1a sabitleri; struct anahtarların alan özetlerini birleştirir.
+const (
+	fnvOffset = -3750763034362895579 // 0xcbf29ce484222325
+	fnvPrime  = 1099511628211
+)
+
+// mapType, verilen anahtar ve değer tiplerindeki map'lerin tipini döndürür.
+func (g *IRGenerator) mapType(key, val types.Type) *types.PointerType {
+	for _, info := range g.mapTypes {
+		if info.Key.Equal(key) && info.Value.Equal(val) {
+			return info.Type
+		}
+	}
+
+	opaque := types.NewStruct()
+	opaque.Opaque = true
+	g.module.NewTypeDef(fmt.Sprintf("gominus.map.%d", len(g.mapTypes)), opaque)
+	info := &mapInfo{Type: types.NewPointer(opaque), Key: key, Value: val}
+	g.mapTypes = append(g.mapTypes, info)
+	return info.Type
+}
+
+// mapInfoOf, t bir map tipiyse map bilgisini, değilse nil döndürür.
+func (g *IRGenerator) mapInfoOf(t types.Type) *mapInfo {
+	ptr, ok := t.(*types.PointerType)
+	if !ok {
+		return nil
+	}
+	name := ptr.ElemType.Name()
+	for _, info := range g.mapTypes {
+		if name != "" && info.Type.ElemType.Name() == name {
+			return info
+		}
+	}
+	return nil
+}
+
+// mapRuntimeFunc, runtime'ın map fonksiyonlarından birini bildirir.
+func (g *IRGenerator) mapRuntimeFunc(name string) *ir.Func {
+	switch name {
+	case "gominus_makemap":
+		return g.getExternalFunction(name, bytePtr, ir.NewParam("keysize", types.I64), ir.NewParam("valsize", types.I64),
+			ir.NewParam("hash", types.NewPointer(mapHashFuncType)), ir.NewParam("eq", types.NewPointer(mapEqFuncType)),
+			ir.NewParam("hint", types.I64))
+	case "gominus_mapaccess", "gominus_mapassign":
+		return g.getExternalFunction(name, bytePtr, ir.NewParam("m", bytePtr), ir.NewParam("key", bytePtr))
+	case "gominus_mapdelete":
+		return g.getExternalFunction(name, types.Void, ir.NewParam("m", bytePtr), ir.NewParam("key", bytePtr))
+	case "gominus_maplen":
+		return g.getExternalFunction(name, types.I64, ir.NewParam("m", bytePtr))
+	case "gominus_mapiterinit":
+		return g.getExternalFunction(name, types.Void, ir.NewParam("m", bytePtr), ir.NewParam("it", types.NewPointer(mapIterType)))
+	case "gominus_mapiternext":
+		return g.getExternalFunction(name, types.I32, ir.NewParam("it", types.NewPointer(mapIterType)),
+			ir.NewParam("key", types.NewPointer(bytePtr)), ir.NewParam("val", types.NewPointer(bytePtr)))
+	case "gominus_strhash":
+		return g.getExternalFunction(name, types.I64, ir.NewParam("s", bytePtr), ir.NewParam("len", types.I64))
+	case "gominus_strequal":
+		return g.getExternalFunction(name, types.I32, ir.NewParam("a", bytePtr), ir.NewParam("alen", types.I64),
+			ir.NewParam("b", bytePtr), ir.NewParam("blen", types.I64))
+	}
+	panic("bilinmeyen map runtime fonksiyonu: " + name)
+}
+
+// keyFuncs, map'in anahtar tipi için özet ve eşitlik fonksiyonlarını
+// döndürür. Fonksiyonlar aynı anahtar tipindeki map'ler arasında paylaşılır.
+func (g *IRGenerator) keyFuncs(info *mapInfo) (*ir.Func, *ir.Func) {
+	if info.hash != nil {
+		return info.hash, info.eq
+	}
+	for _, other := range g.mapTypes {
+		if other.hash != nil && other.Key.Equal(info.Key) {
+			info.hash, info.eq = other.hash, other.eq
+			return info.hash, info.eq
+		}
+	}
+
+	g.labelCounter++
+	keyPtr := types.NewPointer(info.Key)
+
+	keyParam := ir.NewParam("key", bytePtr)
+	info.hash = g.module.NewFunc(fmt.Sprintf("gominus.hash.%d", g.labelCounter), types.I64, keyParam)
+	info.hash.Linkage = enum.LinkageInternal
+	entry := info.hash.NewBlock("entry")
+	key := entry.NewLoad(info.Key, entry.NewBitCast(keyParam, keyPtr))
+	entry.NewRet(g.hashValue(entry, key, info.Key))
+
+	aParam, bParam := ir.NewParam("a", bytePtr), ir.NewParam("b", bytePtr)
+	info.eq = g.module.NewFunc(fmt.Sprintf("gominus.eq.%d", g.labelCounter), types.I32, aParam, bParam)
+	info.eq.Linkage = enum.LinkageInternal
+	entry = info.eq.NewBlock("entry")
+	a := entry.NewLoad(info.Key, entry.NewBitCast(aParam, keyPtr))
+	b := entry.NewLoad(info.Key, entry.NewBitCast(bParam, keyPtr))
+	entry.NewRet(entry.NewZExt(g.equalValues(entry, a, b, info.Key), types.I32))
+
+	return info.hash, info.eq
+}
+
+// hashValue, bir anahtar değerinin 64 bitlik özetini üretir. Eşit anahtarlar
+// eşit özetler üretir: ondalık sayılarda -0 ve +0 aynı özete sahiptir,
+// string'ler içerikleriyle, struct'lar alanlarıyla özetlenir.
+func (g *IRGenerator) hashValue(block *ir.Block, v value.Value, t types.Type) value.Value {
+	switch t := t.(type) {
+	case *types.IntType:
+		if t.BitSize < 64 {
+			return block.NewZExt(v, types.I64)
+		}
+		return v
+	case *types.FloatType:
+		normalized := block.NewFAdd(v, constant.NewFloat(t, 0))
+		if t.Kind == types.FloatKindDouble {
+			return block.NewBitCast(normalized, types.I64)
+		}
+		return block.NewZExt(block.NewBitCast(normalized, types.I32), types.I64)
+	case *types.PointerType:
+		return block.NewPtrToInt(v, types.I64)
+	case *types.StructType:
+		if g.isStringType(t) {
+			return block.NewCall(g.mapRuntimeFunc("gominus_strhash"), block.NewExtractValue(v, 0), block.NewExtractValue(v, 1))
+		}
+		var h value.Value = constant.NewInt(types.I64, fnvOffset)
+		for i, field := range t.Fields {
+			fieldHash := g.hashValue(block, block.NewExtractValue(v, uint64(i)), field)
+			h = block.NewMul(block.NewXor(h, fieldHash), constant.NewInt(types.I64, fnvPrime))
+		}
+		return h
+	}
+	g.ReportError("Desteklenmeyen map anahtar tipi: %s", t)
+	return constant.NewInt(types.I64, 0)
+}
+
+// equalValues, iki anahtar değerinin eşit olup olmadığını üretir.
+func (g *IRGenerator) equalValues(block *ir.Block, a, b value.Value, t types.Type) value.Value {
+	switch t := t.(type) {
+	case *types.IntType:
+		return block.NewICmp(enum.IPredEQ, a, b)
+	case *types.FloatType:
+		return block.NewFCmp(enum.FPredOEQ, a, b)
+	case *types.PointerType:
+		return block.NewICmp(enum.IPredEQ, a, b)
+	case *types.StructType:
+		if g.isStringType(t) {
+			result := block.NewCall(g.mapRuntimeFunc("gominus_strequal"),
+				block.NewExtractValue(a, 0), block.NewExtractValue(a, 1), block.NewExtractValue(b, 0), block.NewExtractValue(b, 1))
+			return block.NewICmp(enum.IPredNE, result, constant.NewInt(types.I32, 0))
+		}
+		var eq value.Value = constant.True
+		for i, field := range t.Fields {
+			fieldEq := g.equalValues(block, block.NewExtractValue(a, uint64(i)), block.NewExtractValue(b, uint64(i)), field)
+			eq = block.NewAnd(eq, fieldEq)
+		}
+		return eq
+	}
+	g.ReportError("Desteklenmeyen map anahtar tipi: %s", t)
+	return constant.False
+}
+
+// newMap, runtime'da boş bir map oluşturur.
+func (g *IRGenerator) newMap(info *mapInfo, hint value.Value) value.Value {
+	hash, eq := g.keyFuncs(info)
+	m := g.currentBB.NewCall(g.mapRuntimeFunc("gominus_makemap"), sizeOf(info.Key), sizeOf(info.Value), hash, eq, hint)
+	return g.currentBB.NewBitCast(m, info.Type)
+}
+
+// generateMakeMap, make(map[K]V, boyut) için IR üretir. Negatif bir boyut
+// panic başlatır.
+func (g *IRGenerator) generateMakeMap(t *ast.MapType, args []ast.Expression) value.Value {
+	if len(args) > 2 {
+		g.ReportError("make() map için en fazla 2 argüman alır, %d verildi", len(args))
+		return nil
+	}
+	info := g.mapInfoOf(g.resolveType(t))
+	if info == nil {
+		return nil
+	}
+
+	var hint value.Value = constant.NewInt(types.I64, 0)
+	if len(args) == 2 {
+		val := g.generateExpression(args[1])
+		if val == nil {
+			return nil
+		}
+		if _, isInt := val.Type().(*types.IntType); !isInt {
+			g.ReportError("Map boyutu tamsayı olmalıdır")
+			return nil
+		}
+		hint = g.convertIntWidth(val, types.I64, g.isUnsignedExpr(args[1]))
+		negative := g.currentBB.NewICmp(enum.IPredSLT, hint, constant.NewInt(types.I64, 0))
+		g.generatePanicIf(negative, "makemap", "makemap: size out of range")
+	}
+
+	return g.newMap(info, hint)
+}
+
+// compositeMapInfo, bir bileşik değişmezin tipi bir map ise map bilgisini
+// döndürür.
+func (g *IRGenerator) compositeMapInfo(expr *ast.CompositeLiteral) *mapInfo {
+	switch t := expr.Type.(type) {
+	case *ast.MapType:
+		return g.mapInfoOf(g.resolveType(t))
+	case *ast.Identifier:
+		if named, exists := g.typeTable[t.Value]; exists {
+			return g.mapInfoOf(named)
+		}
+	}
+	return nil
+}
+
+// generateMapLiteral, map[K]V{k: v, ...} için IR üretir. Çiftler kaynak
+// sırasıyla değerlendirilip yeni bir map'e eklenir.
+func (g *IRGenerator) generateMapLiteral(expr *ast.CompositeLiteral, info *mapInfo) value.Value {
+	m := g.newMap(info, constant.NewInt(types.I64, int64(len(expr.Elements))))
+	for _, element := range expr.Elements {
+		kv, ok := element.(*ast.KeyValueExpression)
+		if !ok {
+			g.ReportError("Map değişmezinde anahtar eksik: %s", element.String())
+			return nil
+		}
+
+		key := g.generateExpression(kv.Key)
+		if key == nil {
+			return nil
+		}
+		keySlot := g.mapKeySlot(info, key, g.isUnsignedExpr(kv.Key))
+		if keySlot == nil {
+			return nil
+		}
+		val := g.generateExpression(kv.Value)
+		if val == nil {
+			return nil
+		}
+		val = g.convertAssignedValue(val, info.Value, g.isUnsignedExpr(kv.Value))
+
+		slot := g.currentBB.NewCall(g.mapRuntimeFunc("gominus_mapassign"), g.currentBB.NewBitCast(m, bytePtr), keySlot)
+		g.currentBB.NewStore(val, g.currentBB.NewBitCast(slot, types.NewPointer(info.Value)))
+	}
+	return m
+}
+
+// mapOperand, map işlemlerinde kullanılan map'in bilgisini döndürür; değer
+// bir map değilse hata raporlanır ve nil döner.
+func (g *IRGenerator) mapOperand(m value.Value, op string) *mapInfo {
+	info := g.mapInfoOf(m.Type())
+	if info == nil {
+		g.ReportError("%s işlemi bir map gerektirir: %s", op, m.Type())
+	}
+	return info
+}
+
+// mapKeySlot, anahtarı map'in anahtar tipine uyarlayıp runtime'a adresi
+// verilecek geçici bir belleğe yazar. Anahtar map'in anahtar tipine
+// uyarlanamıyorsa hata bildirilir ve nil döner.
+func (g *IRGenerator) mapKeySlot(info *mapInfo, key value.Value, unsigned bool) value.Value {
+	key = g.convertAssignedValue(key, info.Key, unsigned)
+	if !key.Type().Equal(info.Key) {
+		g.ReportError("%s tipindeki anahtar %s anahtarlı map'te kullanılamaz", key.Type(), info.Key)
+		return nil
+	}
+	g.labelCounter++
+	slot := g.entryAlloca(info.Key, fmt.Sprintf("mapkey.%d", g.labelCounter))
+	g.currentBB.NewStore(key, slot)
+	return g.currentBB.NewBitCast(slot, bytePtr)
+}
+
+// mapAccess, anahtarın değerini ve anahtarın map'te bulunup bulunmadığını
+// döndürür. Anahtar yoksa veya map nil ise değer tipinin sıfır değeri okunur.
+func (g *IRGenerator) mapAccess(info *mapInfo, m, keySlot value.Value) (value.Value, value.Value) {
+	g.labelCounter++
+	labelSuffix := fmt.Sprintf("%d", g.labelCounter)
+	foundBlock := g.currentFunc.NewBlock("map.found." + labelSuffix)
+	doneBlock := g.currentFunc.NewBlock("map.done." + labelSuffix)
+
+	slot := g.currentBB.NewCall(g.mapRuntimeFunc("gominus_mapaccess"), g.currentBB.NewBitCast(m, bytePtr), keySlot)
+	found := g.currentBB.NewICmp(enum.IPredNE, slot, constant.NewNull(bytePtr))
+	missBlock := g.currentBB
+	g.currentBB.NewCondBr(found, foundBlock, doneBlock)
+
+	g.currentBB = foundBlock
+	val := g.currentBB.NewLoad(info.Value, g.currentBB.NewBitCast(slot, types.NewPointer(info.Value)))
+	g.currentBB.NewBr(doneBlock)
+
+	g.currentBB = doneBlock
+	result := g.currentBB.NewPhi(ir.NewIncoming(val, foundBlock), ir.NewIncoming(zeroValue(info.Value), missBlock))
+	return result, found
+}
+
+// generateMapIndex, m[k] okuması için IR üretir ve değerle birlikte anahtarın
+// bulunup bulunmadığını döndürür.
+func (g *IRGenerator) generateMapIndex(info *mapInfo, m value.Value, keyExpr ast.Expression) (value.Value, value.Value) {
+	key := g.generateExpression(keyExpr)
+	if key == nil {
+		return nil, nil
+	}
+	keySlot := g.mapKeySlot(info, key, g.isUnsignedExpr(keyExpr))
+	if keySlot == nil {
+		return nil, nil
+	}
+	return g.mapAccess(info, m, keySlot)
+}
+
+// isIndexExpression, bir ifadenin indeks ifadesi (a[i], m[k]) olup
+// olmadığını belirler.
+func isIndexExpression(expr ast.Expression) bool {
+	_, ok := expr.(*ast.IndexExpression)
+	return ok
+}
+
+// mapIndexOkValues, v, ok := m[k] biçimindeki atamalar için değeri ve
+// anahtarın bulunup bulunmadığını döndürür.
+func (g *IRGenerator) mapIndexOkValues(expr *ast.IndexExpression) []value.Value {
+	m := g.generateExpression(expr.Left)
+	if m == nil {
+		return nil
+	}
+	info := g.mapOperand(m, "Virgül-ok okuma")
+	if info == nil {
+		return nil
+	}
+	val, ok := g.generateMapIndex(info, m, expr.Index)
+	if val == nil {
+		return nil
+	}
+	return []value.Value{val, ok}
+}
+
+// mapAssignSlot, anahtarın değerinin yazılacağı adresi döndürür; anahtar
+// yoksa map'e eklenir. nil bir map'e yazmak panic başlatır.
+func (g *IRGenerator) mapAssignSlot(info *mapInfo, m, keySlot value.Value) value.Value {
+	slot := g.currentBB.NewCall(g.mapRuntimeFunc("gominus_mapassign"), g.currentBB.NewBitCast(m, bytePtr), keySlot)
+	g.generatePanicIf(g.currentBB.NewICmp(enum.IPredEQ, slot, constant.NewNull(bytePtr)),
+		"mapassign", "assignment to entry in nil map")
+	return g.currentBB.NewBitCast(slot, types.NewPointer(info.Value))
+}
+
+// generateMapElementAddress, atama hedefi olan m[k] için değerin adresini
+// üretir.
+func (g *IRGenerator) generateMapElementAddress(info *mapInfo, m value.Value, keyExpr ast.Expression) (value.Value, types.Type) {
+	key := g.generateExpression(keyExpr)
+	if key == nil {
+		return nil, nil
+	}
+	keySlot := g.mapKeySlot(info, key, g.isUnsignedExpr(keyExpr))
+	if keySlot == nil {
+		return nil, nil
+	}
+	return g.mapAssignSlot(info, m, keySlot), info.Value
+}
+
+// generateMapAssign, m[k] = v ve m[k] op= v atamaları için IR üretir. Map ve
+// anahtar, sağ taraftan önce değerlendirilir; değerin adresi sağ taraf
+// değerlendirildikten sonra alınır, böylece sağ taraftaki eklemeler adresi
+// geçersiz kılmaz.
+func (g *IRGenerator) generateMapAssign(expr *ast.AssignExpression, index *ast.IndexExpression, info *mapInfo, m value.Value) value.Value {
+	key := g.generateExpression(index.Index)
+	if key == nil {
+		return nil
+	}
+	keySlot := g.mapKeySlot(info, key, g.isUnsignedExpr(index.Index))
+	if keySlot == nil {
+		return nil
+	}
+
+	val := g.generateExpression(expr.Value)
+	if val == nil {
+		return nil
+	}
+	val = g.convertAssignedValue(val, info.Value, g.isUnsignedExpr(expr.Value))
+
+	if operator := expr.BinaryOperator(); operator != "" {
+		current, _ := g.mapAccess(info, m, keySlot)
+		operation := &ast.InfixExpression{
+			Token:    expr.Token,
+			Left:     expr.Left,
+			Operator: operator,
+			Right:    expr.Value,
+		}
+		val = g.generateBinaryOperation(operation, current, val)
+		if val == nil {
+			return nil
+		}
+	}
+
+	g.currentBB.NewStore(val, g.mapAssignSlot(info, m, keySlot))
+	return val
+}
+
+// generateDeleteCall, delete(m, k) built-in function için IR üretir. nil
+// map'ten veya map'te olmayan bir anahtarı silmek bir şey yapmaz.
+func (g *IRGenerator) generateDeleteCall(args []ast.Expression) value.Value {
+	if len(args) != 2 {
+		g.ReportError("delete() fonksiyonu tam olarak 2 argüman alır, %d verildi", len(args))
+		return nil
+	}
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, delete() çağrısı yapılamıyor")
+		return nil
+	}
+
+	m := g.generateExpression(args[0])
+	if m == nil {
+		return nil
+	}
+	info := g.mapOperand(m, "Silme")
+	if info == nil {
+		return nil
+	}
+	key := g.generateExpression(args[1])
+	if key == nil {
+		return nil
+	}
+
+	keySlot := g.mapKeySlot(info, key, g.isUnsignedExpr(args[1]))
+	if keySlot == nil {
+		return nil
+	}
+	g.currentBB.NewCall(g.mapRuntimeFunc("gominus_mapdelete"), g.currentBB.NewBitCast(m, bytePtr), keySlot)
+	return nil
+}
+
+// generateMapLength, len(m) için IR üretir.
+func (g *IRGenerator) generateMapLength(m value.Value) value.Value {
+	n := g.currentBB.NewCall(g.mapRuntimeFunc("gominus_maplen"), g.currentBB.NewBitCast(m, bytePtr))
+	return g.currentBB.NewTrunc(n, types.I32)
+}
+
+// generateMapRange, bir map üzerinde range döngüsü için IR üretir. Döngü
+// runtime yineleyicisiyle girdileri eklenme sırasıyla dolaşır; gövdede
+// eklenen girdiler görülebilir, henüz ulaşılmamış silinen girdiler görülmez.
+func (g *IRGenerator) generateMapRange(stmt *ast.RangeStatement, m value.Value, info *mapInfo) {
+	g.labelCounter++
+	labelSuffix := fmt.Sprintf("%d", g.labelCounter)
+
+	condBlock := g.currentFunc.NewBlock("range.next." + labelSuffix)
+	bodyBlock := g.currentFunc.NewBlock("range.body." + labelSuffix)
+	endBlock := g.currentFunc.NewBlock("range.end." + labelSuffix)
+
+	iter := g.entryAlloca(mapIterType, "range.iter."+labelSuffix)
+	keyOut := g.entryAlloca(bytePtr, "range.key."+labelSuffix)
+	valOut := g.entryAlloca(bytePtr, "range.val."+labelSuffix)
+	g.currentBB.NewCall(g.mapRuntimeFunc("gominus_mapiterinit"), g.currentBB.NewBitCast(m, bytePtr), iter)
+
+	g.currentBB.NewBr(condBlock)
+
+	// Koşul: yineleyicide girdi kaldıkça devam et
+	g.currentBB = condBlock
+	more := g.currentBB.NewCall(g.mapRuntimeFunc("gominus_mapiternext"), iter, keyOut, valOut)
+	g.currentBB.NewCondBr(g.currentBB.NewICmp(enum.IPredNE, more, constant.NewInt(types.I32, 0)), bodyBlock, endBlock)
+
+	// := ile tanımlanan değişkenler her adımda yeniden tanımlanır
+	g.currentBB = bodyBlock
+	var keySlot, valueSlot value.Value
+	restore := func() {}
+	if stmt.Define {
+		var saved []func()
+		keySlot, saved = g.defineRangeVariable(stmt.Key, info.Key, false, labelSuffix, saved)
+		if stmt.Value != nil {
+			valueSlot, saved = g.defineRangeVariable(stmt.Value, info.Value, false, labelSuffix, saved)
+		}
+		restore = func() {
+			for i := len(saved) - 1; i >= 0; i-- {
+				saved[i]()
+			}
+		}
+	}
+	if stmt.Key != nil {
+		keyPtr := g.currentBB.NewBitCast(g.currentBB.NewLoad(bytePtr, keyOut), types.NewPointer(info.Key))
+		g.assignRangeVariable(stmt.Key, keySlot, g.currentBB.NewLoad(info.Key, keyPtr), false)
+	}
+	if stmt.Value != nil {
+		valPtr := g.currentBB.NewBitCast(g.currentBB.NewLoad(bytePtr, valOut), types.NewPointer(info.Value))
+		g.assignRangeVariable(stmt.Value, valueSlot, g.currentBB.NewLoad(info.Value, valPtr), false)
+	}
+
+	g.pushBranchTarget(endBlock, condBlock)
+	if stmt.Body != nil {
+		g.generateBlockStatement(stmt.Body)
+	}
+	g.popBranchTarget()
+	if g.currentBB.Term == nil {
+		g.currentBB.NewBr(condBlock)
+	}
+
+	restore()
+	g.currentBB = endBlock
+}
@@ -0,0 +1,295 @@
+package irgen
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// NamedInfo, struct ve arayüz dışındaki bir tipe dayanan adlandırılmış bir
+// tipin (ör. type Celsius float64) bilgilerini tutar. LLVM'de yalnızca struct
+// tipleri adlandırılabildiğinden bu tipler dayanak tipleriyle temsil edilir;
+// tipin adı metot, itab ve tip tanımlayıcısı adlarında korunur.
+type NamedInfo struct {
+	Name     string
+	Type     types.Type // Dayanak LLVM tipi
+	Unsigned bool       // Dayanak tip işaretsiz bir tamsayı mı?
+	Methods  map[string]*ir.Func
+}
+
+// typeDeclKind, bir tip bildiriminin LLVM'de nasıl temsil edildiğini belirtir.
+type typeDeclKind int
+
+const (
+	namedDecl     typeDeclKind = iota // Dayanak tipiyle temsil edilen adlandırılmış tip
+	structDecl                        // Kendi LLVM struct tipine sahip tip
+	interfaceDecl                     // Kendi arayüz tipine sahip tip
+)
+
+// declKind, bir tip bildiriminin türünü belirler. Bildirilmiş bir struct veya
+// arayüz tipine dayanan tipler (type MyPoint Point) dayandıkları tipin türünü
+// alır.
+func (g *IRGenerator) declKind(ts *ast.TypeStatement, decls map[string]*ast.TypeStatement, visiting map[*ast.TypeStatement]bool) typeDeclKind {
+	switch t := ts.Type.(type) {
+	case *ast.StructType:
+		return structDecl
+	case *ast.InterfaceType:
+		return interfaceDecl
+	case *ast.Identifier:
+		if target, exists := decls[t.Value]; exists {
+			if visiting[target] {
+				return namedDecl
+			}
+			visiting[ts] = true
+			return g.declKind(target, decls, visiting)
+		}
+		if st, ok := g.typeTable[t.Value].(*types.StructType); ok {
+			if _, isInterface := g.interfaceTable[st]; isInterface {
+				return interfaceDecl
+			}
+			return structDecl
+		}
+	}
+	return namedDecl
+}
+
+// defineNamedCopy, başka bir struct veya arayüz tipine dayanan bir tipin
+// (type MyPoint Point) gövdesini dayandığı tipten kopyalar. Yeni tip kendi
+// LLVM tipine sahiptir ancak metotları kopyalanmaz.
+func (g *IRGenerator) defineNamedCopy(ts *ast.TypeStatement) {
+	st := g.typeTable[ts.Name.Value].(*types.StructType)
+	source, ok := g.resolveType(ts.Type).(*types.StructType)
+	if !ok {
+		return
+	}
+
+	if info, isInterface := g.interfaceTable[st]; isInterface {
+		if sourceInfo := g.interfaceTable[source]; sourceInfo != nil {
+			info.Methods = sourceInfo.Methods
+			info.Embeds = sourceInfo.Embeds
+		}
+		return
+	}
+	if sourceInfo := g.structTable[source]; sourceInfo != nil {
+		info := g.structTable[st]
+		info.Type.Fields = append([]types.Type{}, sourceInfo.Type.Fields...)
+		info.Fields = append([]StructFieldInfo{}, sourceInfo.Fields...)
+	}
+}
+
+// defineNamedType, struct ve arayüz dışındaki bir tipe dayanan adlandırılmış
+// bir tipi tanımlar.
+func (g *IRGenerator) defineNamedType(ts *ast.TypeStatement) {
+	t := g.resolveType(ts.Type)
+	if t == nil {
+		return
+	}
+	name := ts.Name.Value
+	g.typeTable[name] = t
+	g.namedTable[name] = &NamedInfo{
+		Name:     name,
+		Type:     t,
+		Unsigned: g.isUnsignedTypeExpr(ts.Type),
+		Methods:  make(map[string]*ir.Func),
+	}
+}
+
+// isUnsignedTypeExpr, bir tip ifadesinin işaretsiz bir tamsayı tipi olup
+// olmadığını belirler.
+func (g *IRGenerator) isUnsignedTypeExpr(expr ast.Expression) bool {
+	ident, ok := expr.(*ast.Identifier)
+	if !ok {
+		return false
+	}
+	if info := g.namedTable[ident.Value]; info != nil {
+		return info.Unsigned
+	}
+	return isUnsignedTypeName(ident.Value)
+}
+
+// namedTypeInfo, bir tip ifadesi adlandırılmış bir tipi gösteriyorsa tipin
+// bilgisini döndürür.
+func (g *IRGenerator) namedTypeInfo(expr ast.Expression) *NamedInfo {
+	if ident, ok := expr.(*ast.Identifier); ok {
+		return g.namedTable[ident.Value]
+	}
+	return nil
+}
+
+// namedTypeOf, bir ifadenin değeri adlandırılmış tipteyse tipin bilgisini
+// döndürür. LLVM tipleri adlandırılmış tipleri dayanak tiplerinden ayırt
+// etmediğinden bilgi, değişken bildirimlerinde kaydedilen tip adlarından ve
+// dönüşümlerden çıkarılır.
+func (g *IRGenerator) namedTypeOf(expr ast.Expression) *NamedInfo {
+	switch e := expr.(type) {
+	case *ast.Identifier:
+		return g.namedVars[e.Value]
+	case *ast.CallExpression:
+		if g.conversionType(e.Function) != nil {
+			return g.namedTypeInfo(e.Function)
+		}
+	case *ast.PrefixExpression:
+		if e.Operator != "!" && e.Operator != "&" {
+			return g.namedTypeOf(e.Right)
+		}
+	case *ast.InfixExpression:
+		switch e.Operator {
+		case "<<", ">>":
+			return g.namedTypeOf(e.Left)
+		case "+", "-", "*", "/", "%", "&", "|", "^", "&^":
+			if info := g.namedTypeOf(e.Left); info != nil {
+				return info
+			}
+			return g.namedTypeOf(e.Right)
+		}
+	}
+	return nil
+}
+
+// conversionType, bir çağrının fonksiyonu bir tip adı veya slice tipiyse
+// (T(x), []byte(s) dönüşümleri) o tipi döndürür; aksi halde nil döner.
+func (g *IRGenerator) conversionType(expr ast.Expression) types.Type {
+	if at, ok := expr.(*ast.ArrayType); ok && at.Size == nil {
+		return g.resolveType(at)
+	}
+	ident, ok := expr.(*ast.Identifier)
+	if !ok {
+		return nil
+	}
+	if _, isValue := g.symbolTable[ident.Value]; isValue {
+		return nil
+	}
+	return g.typeTable[ident.Value]
+}
+
+// generateConversion, T(x) biçimindeki bir tip dönüşümü için IR üretir.
+func (g *IRGenerator) generateConversion(expr *ast.CallExpression, target types.Type) value.Value {
+	if len(expr.Arguments) != 1 {
+		g.ReportError("Tip dönüşümü tek bir argüman alır: %s", expr.String())
+		return nil
+	}
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, tip dönüşümü yapılamıyor")
+		return nil
+	}
+
+	arg := expr.Arguments[0]
+	val := g.generateExpression(arg)
+	if val == nil {
+		return nil
+	}
+
+	result := g.convertValue(val, target, g.isUnsignedExpr(arg), g.isUnsignedTypeExpr(expr.Function))
+	if info := g.namedTypeInfo(expr.Function); info != nil && result != nil {
+		g.namedValues[result] = info
+	}
+	return result
+}
+
+// generateConstantConversion, global değişkenlerin ilk değerlerinde
+// kullanılan sabit bir T(x) dönüşümünü sabit katlamayla üretir.
+func (g *IRGenerator) generateConstantConversion(expr *ast.CallExpression, target types.Type) constant.Constant {
+	val := g.generateConstantExpression(expr.Arguments[0])
+	if val == nil {
+		return nil
+	}
+
+	var result constant.Constant
+	switch t := target.(type) {
+	case *types.IntType:
+		switch from := val.Type().(type) {
+		case *types.IntType:
+			switch {
+			case from.BitSize == t.BitSize:
+				result = val
+			case from.BitSize > t.BitSize:
+				result = constant.NewTrunc(val, t)
+			default:
+				result = constant.NewSExt(val, t)
+			}
+		case *types.FloatType:
+			result = constant.NewFPToSI(val, t)
+		}
+	case *types.FloatType:
+		switch from := val.Type().(type) {
+		case *types.IntType:
+			result = constant.NewSIToFP(val, t)
+		case *types.FloatType:
+			switch {
+			case val.Type().Equal(t):
+				result = val
+			case from.Kind == types.FloatKindFloat:
+				result = constant.NewFPExt(val, t)
+			default:
+				result = constant.NewFPTrunc(val, t)
+			}
+		}
+	default:
+		if val.Type().Equal(target) {
+			result = val
+		}
+	}
+
+	if result == nil {
+		g.ReportError("%s tipindeki sabit %s tipine dönüştürülemez", g.typeName(val.Type()), g.typeName(target))
+		return nil
+	}
+	if info := g.namedTypeInfo(expr.Function); info != nil {
+		g.namedValues[result] = info
+	}
+	return result
+}
+
+// convertValue, bir değeri açık bir dönüşümle hedef tipe çevirir: sayısal
+// tipler arasında değer dönüştürülür, alanları özdeş struct'lar alan alan
+// kopyalanır, arayüz hedeflerinde değer kutulanır.
+func (g *IRGenerator) convertValue(val value.Value, target types.Type, fromUnsigned, toUnsigned bool) value.Value {
+	if iface := g.interfaceInfo(target); iface != nil {
+		return g.convertToInterface(val, iface)
+	}
+	if val.Type().Equal(target) {
+		return val
+	}
+	if converted := g.convertString(val, target, fromUnsigned); converted != nil {
+		return converted
+	}
+
+	switch t := target.(type) {
+	case *types.IntType:
+		switch val.Type().(type) {
+		case *types.IntType:
+			return g.convertIntWidth(val, t, fromUnsigned)
+		case *types.FloatType:
+			if toUnsigned {
+				return g.currentBB.NewFPToUI(val, t)
+			}
+			return g.currentBB.NewFPToSI(val, t)
+		}
+	case *types.FloatType:
+		switch from := val.Type().(type) {
+		case *types.IntType:
+			if fromUnsigned {
+				return g.currentBB.NewUIToFP(val, t)
+			}
+			return g.currentBB.NewSIToFP(val, t)
+		case *types.FloatType:
+			if from.Kind == types.FloatKindFloat {
+				return g.currentBB.NewFPExt(val, t)
+			}
+			return g.currentBB.NewFPTrunc(val, t)
+		}
+	case *types.StructType:
+		if from, ok := val.Type().(*types.StructType); ok && len(from.Fields) == len(t.Fields) {
+			var result value.Value = constant.NewZeroInitializer(t)
+			for i := range t.Fields {
+				result = g.currentBB.NewInsertValue(result, g.currentBB.NewExtractValue(val, uint64(i)), uint64(i))
+			}
+			return result
+		}
+	}
+
+	g.ReportError("%s tipindeki değer %s tipine dönüştürülemez", g.typeName(val.Type()), g.typeName(target))
+	return nil
+}
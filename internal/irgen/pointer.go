@@ -0,0 +1,188 @@
+package irgen
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// generateAddressOf, &x ifadesi için IR üretir. Değişkenlerin, alanların ve
+// elemanların adresi doğrudan kullanılır; adresi alınan yerel değişkenler
+// escapingNames sayesinde yığında ayrılır. Bileşik değişmezler (&Point{1, 2})
+// yığına kopyalanır.
+func (g *IRGenerator) generateAddressOf(expr *ast.PrefixExpression) value.Value {
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, adres ifadesi değerlendirilemiyor")
+		return nil
+	}
+
+	if _, isLiteral := expr.Right.(*ast.CompositeLiteral); isLiteral {
+		val := g.generateExpression(expr.Right)
+		if val == nil {
+			return nil
+		}
+		cell := g.currentBB.NewBitCast(g.currentBB.NewCall(g.getMallocFunction(), sizeOf(val.Type())), types.NewPointer(val.Type()))
+		g.currentBB.NewStore(val, cell)
+		return cell
+	}
+
+	addr, _ := g.generateAddress(expr.Right)
+	return addr
+}
+
+// generateDereference, *p ifadesi için IR üretir.
+func (g *IRGenerator) generateDereference(expr *ast.PrefixExpression) value.Value {
+	ptr, elemType := g.dereferenceAddress(expr)
+	if ptr == nil {
+		return nil
+	}
+	return g.currentBB.NewLoad(elemType, ptr)
+}
+
+// dereferenceAddress, *p ifadesinin gösterdiği adresi ve tipini döndürür.
+// nil işaretçiler panic ile sonlanır.
+func (g *IRGenerator) dereferenceAddress(expr *ast.PrefixExpression) (value.Value, types.Type) {
+	ptr := g.generateExpression(expr.Right)
+	if ptr == nil {
+		return nil, nil
+	}
+	ptrType, ok := ptr.Type().(*types.PointerType)
+	if !ok {
+		g.ReportError("* işleci bir işaretçi gerektirir: %s", expr.Right.String())
+		return nil, nil
+	}
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, işaretçi değerlendirilemiyor")
+		return nil, nil
+	}
+	g.checkNilPointer(ptr)
+	return ptr, ptrType.ElemType
+}
+
+// checkNilPointer, bir işaretçinin nil olması durumunda programı panic ile
+// sonlandıran kontrolü üretir.
+func (g *IRGenerator) checkNilPointer(ptr value.Value) {
+	isNil := g.currentBB.NewICmp(enum.IPredEQ, ptr, constant.NewNull(ptr.Type().(*types.PointerType)))
+	g.generatePanicIf(isNil, "nilptr", "runtime error: invalid memory address or nil pointer dereference")
+}
+
+// structPointerInfo, t bir struct işaretçisiyse (*Point) struct'ın bilgisini
+// döndürür; aksi halde nil döner.
+func (g *IRGenerator) structPointerInfo(t types.Type) *StructInfo {
+	ptr, ok := t.(*types.PointerType)
+	if !ok {
+		return nil
+	}
+	st, ok := ptr.ElemType.(*types.StructType)
+	if !ok {
+		return nil
+	}
+	return g.structTable[st]
+}
+
+// generatePointerOperation, işaretçiler üzerindeki aritmetik ve karşılaştırma
+// işlemleri için IR üretir: p + n ve n + p eleman boyutu kadar ilerler, p - q
+// iki işaretçi arasındaki eleman sayısını verir, p == q ve p != q adresleri
+// karşılaştırır. İşlem bir işaretçi işlemi değilse nil döner.
+func (g *IRGenerator) generatePointerOperation(expr *ast.InfixExpression, left, right value.Value) value.Value {
+	leftPtr, leftOk := left.Type().(*types.PointerType)
+	rightPtr, rightOk := right.Type().(*types.PointerType)
+
+	switch expr.Operator {
+	case "+":
+		if leftOk && types.IsInt(right.Type()) {
+			return g.offsetPointer(left, leftPtr, right, g.isUnsignedExpr(expr.Right), false)
+		}
+		if rightOk && types.IsInt(left.Type()) {
+			return g.offsetPointer(right, rightPtr, left, g.isUnsignedExpr(expr.Left), false)
+		}
+	case "-":
+		if leftOk && types.IsInt(right.Type()) {
+			return g.offsetPointer(left, leftPtr, right, g.isUnsignedExpr(expr.Right), true)
+		}
+		if leftOk && rightOk && leftPtr.Equal(rightPtr) {
+			diff := g.currentBB.NewSub(g.currentBB.NewPtrToInt(left, types.I64), g.currentBB.NewPtrToInt(right, types.I64))
+			return g.currentBB.NewTrunc(g.currentBB.NewSDiv(diff, sizeOf(leftPtr.ElemType)), types.I32)
+		}
+	case "==", "!=":
+		if !leftOk || !rightOk || !leftPtr.Equal(rightPtr) {
+			return nil
+		}
+		if _, isNull := right.(*constant.Null); isNull {
+			return nil
+		}
+		if expr.Operator == "==" {
+			return g.currentBB.NewICmp(enum.IPredEQ, left, right)
+		}
+		return g.currentBB.NewICmp(enum.IPredNE, left, right)
+	}
+	return nil
+}
+
+// offsetPointer, bir işaretçiyi n eleman ileri (veya geri) taşır.
+func (g *IRGenerator) offsetPointer(ptr value.Value, ptrType *types.PointerType, n value.Value, unsigned, negate bool) value.Value {
+	index := g.convertIntWidth(n, types.I64, unsigned)
+	if negate {
+		index = g.currentBB.NewSub(constant.NewInt(types.I64, 0), index)
+	}
+	return g.currentBB.NewGetElementPtr(ptrType.ElemType, ptr, index)
+}
+
+// generateAllocCall, alloc(T) ve alloc(T, n) çağrıları için IR üretir.
+// Bellek sıfırlanmış olarak ayrılır ve free ile serbest bırakılana kadar
+// yaşar.
+func (g *IRGenerator) generateAllocCall(args []ast.Expression) value.Value {
+	if len(args) != 1 && len(args) != 2 {
+		g.ReportError("alloc() fonksiyonu 1 veya 2 argüman alır, %d verildi", len(args))
+		return nil
+	}
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, alloc() çağrısı yapılamıyor")
+		return nil
+	}
+
+	elemType := g.resolveType(args[0])
+	if elemType == nil {
+		return nil
+	}
+	var count value.Value = constant.NewInt(types.I32, 1)
+	if len(args) == 2 {
+		count = g.generateExpression(args[1])
+		if count == nil {
+			return nil
+		}
+		if !types.IsInt(count.Type()) {
+			g.ReportError("alloc() boyutu tamsayı olmalıdır")
+			return nil
+		}
+	}
+	return g.allocElements(elemType, count)
+}
+
+// generateFreeCall, alloc ile ayrılan belleği serbest bırakan free(p) ve
+// delete(p) çağrıları için IR üretir.
+func (g *IRGenerator) generateFreeCall(name string, args []ast.Expression) value.Value {
+	if len(args) != 1 {
+		g.ReportError("%s() fonksiyonu 1 argüman alır, %d verildi", name, len(args))
+		return nil
+	}
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, %s() çağrısı yapılamıyor", name)
+		return nil
+	}
+
+	ptr := g.generateExpression(args[0])
+	if ptr == nil {
+		return nil
+	}
+	if !types.IsPointer(ptr.Type()) {
+		g.ReportError("%s() bir işaretçi gerektirir: %s", name, args[0].String())
+		return nil
+	}
+	free := g.getExternalFunction("free", types.Void, ir.NewParam("ptr", bytePtr))
+	g.currentBB.NewCall(free, g.currentBB.NewBitCast(ptr, bytePtr))
+	return nil
+}
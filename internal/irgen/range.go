@@ -0,0 +1,355 @@
+package irgen
+
+import (
+	"fmt"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// rangeKind, range ile dolaşılan değerin türünü belirtir.
+type rangeKind int
+
+const (
+	rangeInteger rangeKind = iota // for i := range n
+	rangeArray                    // Array ve slice
+	rangeString                   // UTF-8 string (bayt indeksi ve rune)
+)
+
+// decodeRuneFuncName, UTF-8 çözme yardımcı fonksiyonunun adıdır.
+const decodeRuneFuncName = "gominus.decoderune"
+
+// generateRangeStatement, bir for-range döngüsü için IR üretir.
+//
+// Dolaşılan ifade ve uzunluğu döngüden önce bir kez hesaplanır. Döngü gizli bir
+// sayaçla yürür ve değişkenlere her adımın başında yeni değerler atanır; böylece
+// gövdede değişkenlere yapılan atamalar dolaşmayı etkilemez. İndeks her zaman
+// uzunluktan küçük olduğundan eleman erişiminde sınır denetimi yapılmaz.
+func (g *IRGenerator) generateRangeStatement(stmt *ast.RangeStatement) {
+	if g.currentFunc == nil || g.currentBB == nil {
+		g.ReportError("Geçerli bir fonksiyon yok, range döngüsü değerlendirilemiyor")
+		return
+	}
+
+	iterable := g.generateExpression(stmt.Iterable)
+	if iterable == nil {
+		return
+	}
+	if elem := g.chanElemType(iterable.Type()); elem != nil {
+		g.generateChanRange(stmt, iterable, elem)
+		return
+	}
+	if info := g.mapInfoOf(iterable.Type()); info != nil {
+		g.generateMapRange(stmt, iterable, info)
+		return
+	}
+
+	// Dolaşma türünü, sayaç tipini ve uzunluğu belirle
+	var kind rangeKind
+	var length value.Value
+	counterType := types.I32
+	var valueType types.Type
+	switch t := iterable.Type().(type) {
+	case *types.IntType:
+		if t.BitSize > 1 {
+			kind = rangeInteger
+			length = iterable
+			counterType = t
+		}
+	case *types.PointerType:
+		kind = rangeArray
+		valueType = g.elementType(t)
+		length = g.generateLength(iterable)
+	case *types.StructType:
+		if g.isStringType(t) {
+			kind = rangeString
+			valueType = types.I32
+			length = g.generateLength(iterable)
+		}
+	}
+	if length == nil {
+		g.ReportError("range ifadesi için desteklenmeyen tip: %s", iterable.Type())
+		return
+	}
+	if kind == rangeInteger && stmt.Value != nil {
+		g.ReportError("Tamsayı üzerinde range en fazla bir değişken alabilir")
+		return
+	}
+
+	g.labelCounter++
+	labelSuffix := fmt.Sprintf("%d", g.labelCounter)
+
+	condBlock := g.currentFunc.NewBlock("range.cond." + labelSuffix)
+	bodyBlock := g.currentFunc.NewBlock("range.body." + labelSuffix)
+	postBlock := g.currentFunc.NewBlock("range.post." + labelSuffix)
+	endBlock := g.currentFunc.NewBlock("range.end." + labelSuffix)
+
+	// Gizli sayaç (string'de bir sonraki rune'un bayt indeksi ayrıca tutulur)
+	counter := g.currentBB.NewAlloca(counterType)
+	counter.SetName("range.index." + labelSuffix)
+	g.currentBB.NewStore(constant.NewInt(counterType, 0), counter)
+	var next *ir.InstAlloca
+	if kind == rangeString {
+		next = g.currentBB.NewAlloca(types.I32)
+		next.SetName("range.next." + labelSuffix)
+	}
+
+	unsignedCounter := kind == rangeInteger && g.isUnsignedExpr(stmt.Iterable)
+	g.currentBB.NewBr(condBlock)
+
+	// Koşul: indeks < uzunluk
+	g.currentBB = condBlock
+	index := g.currentBB.NewLoad(counterType, counter)
+	pred := enum.IPredSLT
+	if unsignedCounter {
+		pred = enum.IPredULT
+	}
+	g.currentBB.NewCondBr(g.currentBB.NewICmp(pred, index, length), bodyBlock, endBlock)
+
+	// Gövde: değişkenleri bağla ve gövdeyi üret; continue post bloğuna gider.
+	// := ile tanımlanan değişkenler her adımda yeniden tanımlanır.
+	g.currentBB = bodyBlock
+	var keySlot, valueSlot value.Value
+	var restore func()
+	if stmt.Define {
+		var saved []func()
+		keySlot, saved = g.defineRangeVariable(stmt.Key, counterType, unsignedCounter, labelSuffix, saved)
+		if valueType != nil {
+			valueSlot, saved = g.defineRangeVariable(stmt.Value, valueType, false, labelSuffix, saved)
+		}
+		restore = func() {
+			for i := len(saved) - 1; i >= 0; i-- {
+				saved[i]()
+			}
+		}
+	}
+	var elem value.Value
+	switch kind {
+	case rangeArray:
+		if stmt.Value != nil {
+			elemPtr, elemType := g.generateElementAddress(iterable, index, false)
+			if elemPtr != nil {
+				elem = g.currentBB.NewLoad(elemType, elemPtr)
+			}
+		}
+	case rangeString:
+		decoded := g.currentBB.NewCall(g.getDecodeRuneFunc(), g.stringData(iterable), length, index)
+		elem = g.currentBB.NewExtractValue(decoded, 0)
+		size := g.currentBB.NewExtractValue(decoded, 1)
+		g.currentBB.NewStore(g.currentBB.NewAdd(index, size), next)
+	}
+	g.assignRangeVariable(stmt.Key, keySlot, index, unsignedCounter)
+	g.assignRangeVariable(stmt.Value, valueSlot, elem, false)
+
+	g.pushBranchTarget(endBlock, postBlock)
+	if stmt.Body != nil {
+		g.generateBlockStatement(stmt.Body)
+	}
+	g.popBranchTarget()
+	if g.currentBB.Term == nil {
+		g.currentBB.NewBr(postBlock)
+	}
+
+	// Post: sayacı ilerlet
+	g.currentBB = postBlock
+	var advanced value.Value
+	if kind == rangeString {
+		advanced = g.currentBB.NewLoad(types.I32, next)
+	} else {
+		current := g.currentBB.NewLoad(counterType, counter)
+		advanced = g.currentBB.NewAdd(current, constant.NewInt(counterType, 1))
+	}
+	g.currentBB.NewStore(advanced, counter)
+	g.currentBB.NewBr(condBlock)
+
+	if restore != nil {
+		restore()
+	}
+	g.currentBB = endBlock
+}
+
+// elementType, bir array veya slice pointer'ının eleman tipini döndürür.
+func (g *IRGenerator) elementType(ptrType *types.PointerType) types.Type {
+	switch t := ptrType.ElemType.(type) {
+	case *types.ArrayType:
+		return t.ElemType
+	case *types.StructType:
+		// Slice struct: {data *T, len int32, cap int32}
+		if len(t.Fields) >= 1 {
+			if dataPtr, ok := t.Fields[0].(*types.PointerType); ok {
+				return dataPtr.ElemType
+			}
+		}
+	}
+	return ptrType.ElemType
+}
+
+// defineRangeVariable, := ile tanımlanan bir range değişkeni için bellek ayırır
+// ve sembol tablosuna ekler. Döngü gövdesinin başında çağrılır: bir closure'ın
+// yakaladığı veya adresi alınan değişkenler her adımda yığında yeni bir hücre
+// alır, böylece her adım kendi değişkenini görür. Diğerleri için giriş
+// bloğunda tek bir alloca yeterlidir. Döngüden sonra önceki tanımı geri
+// yükleyecek fonksiyon saved listesine eklenir. "_" için bellek ayrılmaz. Aynı
+// fonksiyonda aynı adlı döngü değişkenleri çakışmasın diye IR adına döngü son
+// eki eklenir.
+func (g *IRGenerator) defineRangeVariable(target ast.Expression, varType types.Type, unsigned bool,
+	labelSuffix string, saved []func()) (value.Value, []func()) {
+
+	ident, ok := target.(*ast.Identifier)
+	if !ok || ident.Value == "_" {
+		return nil, saved
+	}
+
+	name := ident.Value
+	prevVal, hadVal := g.symbolTable[name]
+	prevUnsigned, hadUnsigned := g.unsignedVars[name]
+	saved = append(saved, func() {
+		if hadVal {
+			g.symbolTable[name] = prevVal
+		} else {
+			delete(g.symbolTable, name)
+		}
+		if hadUnsigned {
+			g.unsignedVars[name] = prevUnsigned
+		} else {
+			delete(g.unsignedVars, name)
+		}
+	})
+
+	var addr value.Value
+	if g.escapingVars[name] {
+		addr = g.newVariable(g.currentBB, name, name+"."+labelSuffix, varType)
+	} else {
+		addr = g.entryAlloca(varType, name+"."+labelSuffix)
+	}
+	g.symbolTable[name] = addr
+	g.unsignedVars[name] = unsigned
+
+	return addr, saved
+}
+
+// assignRangeVariable, bir adımın indeks/anahtar veya değerini hedefe yazar.
+// slot nil ise hedef = ile atanan bir ifadedir ve adresi her adımda hesaplanır.
+func (g *IRGenerator) assignRangeVariable(target ast.Expression, slot value.Value, val value.Value, unsigned bool) {
+	if target == nil || val == nil {
+		return
+	}
+	if ident, ok := target.(*ast.Identifier); ok && ident.Value == "_" {
+		return
+	}
+
+	var addr value.Value
+	var elemType types.Type
+	if slot != nil {
+		addr, elemType = slot, slot.Type().(*types.PointerType).ElemType
+	} else {
+		addr, elemType = g.generateAddress(target)
+		if addr == nil {
+			return
+		}
+	}
+
+	// Tamsayı değerler hedefin genişliğine uyarlanır
+	if intType, ok := elemType.(*types.IntType); ok && intType.BitSize > 1 {
+		if valType, isInt := val.Type().(*types.IntType); isInt && valType.BitSize > 1 {
+			val = g.convertIntWidth(val, intType, unsigned)
+		}
+	}
+
+	g.currentBB.NewStore(val, addr)
+}
+
+// getDecodeRuneFunc, string üzerinde range için UTF-8 çözme fonksiyonunu
+// bulur veya üretir:
+//
+//	gominus.decoderune(s *i8, len i32, pos i32) {rune i32, size i32}
+//
+// Geçersiz veya eksik kodlamalarda Go'da olduğu gibi {U+FFFD, 1} döner.
+func (g *IRGenerator) getDecodeRuneFunc() *ir.Func {
+	if fn := g.getFunction(decodeRuneFuncName); fn != nil {
+		return fn
+	}
+
+	resultType := types.NewStruct(types.I32, types.I32)
+	str := ir.NewParam("s", types.NewPointer(types.I8))
+	length := ir.NewParam("len", types.I32)
+	pos := ir.NewParam("pos", types.I32)
+	fn := g.module.NewFunc(decodeRuneFuncName, resultType, str, length, pos)
+	fn.Linkage = enum.LinkageInternal
+	g.symbolTable[decodeRuneFuncName] = fn
+
+	i32 := func(v int64) constant.Constant { return constant.NewInt(types.I32, v) }
+	loadByte := func(block *ir.Block, offset value.Value) value.Value {
+		ptr := block.NewGetElementPtr(types.I8, str, offset)
+		return block.NewZExt(block.NewLoad(types.I8, ptr), types.I32)
+	}
+	result := func(block *ir.Block, r, size value.Value) {
+		agg := block.NewInsertValue(constant.NewUndef(resultType), r, 0)
+		block.NewRet(block.NewInsertValue(agg, size, 1))
+	}
+
+	entry := fn.NewBlock("entry")
+	ascii := fn.NewBlock("ascii")
+	multi := fn.NewBlock("multi")
+	cont := fn.NewBlock("cont")
+	check := fn.NewBlock("check")
+	valid := fn.NewBlock("valid")
+	invalid := fn.NewBlock("invalid")
+
+	// entry: ilk bayt ASCII ise doğrudan döndür
+	runeSlot := entry.NewAlloca(types.I32)
+	b0 := loadByte(entry, pos)
+	entry.NewCondBr(entry.NewICmp(enum.IPredULT, b0, i32(0x80)), ascii, multi)
+	result(ascii, b0, i32(1))
+
+	// multi: ilk bayttan genişliği ve başlangıç bitlerini belirle
+	isTwo := multi.NewICmp(enum.IPredULT, b0, i32(0xE0))
+	isThree := multi.NewICmp(enum.IPredULT, b0, i32(0xF0))
+	width := multi.NewSelect(isTwo, i32(2), multi.NewSelect(isThree, i32(3), i32(4)))
+	mask := multi.NewSelect(isTwo, i32(0x1F), multi.NewSelect(isThree, i32(0x0F), i32(0x07)))
+	multi.NewStore(multi.NewAnd(b0, mask), runeSlot)
+	badLead := multi.NewOr(
+		multi.NewICmp(enum.IPredULT, b0, i32(0xC2)),
+		multi.NewICmp(enum.IPredUGT, b0, i32(0xF4)))
+	truncated := multi.NewICmp(enum.IPredSGT, multi.NewAdd(pos, width), length)
+	multi.NewCondBr(multi.NewOr(badLead, truncated), invalid, cont)
+
+	// cont: devam baytlarını (10xxxxxx) sırayla oku
+	contBlocks := []*ir.Block{cont, fn.NewBlock("cont.2"), fn.NewBlock("cont.3")}
+	for k, block := range contBlocks {
+		after := check
+		if k+1 < len(contBlocks) {
+			after = contBlocks[k+1]
+		}
+		offset := i32(int64(k + 1))
+		read := fn.NewBlock(fmt.Sprintf("read.%d", k+1))
+		block.NewCondBr(block.NewICmp(enum.IPredSGT, width, offset), read, check)
+
+		b := loadByte(read, read.NewAdd(pos, offset))
+		ok := read.NewICmp(enum.IPredEQ, read.NewAnd(b, i32(0xC0)), i32(0x80))
+		shifted := read.NewShl(read.NewLoad(types.I32, runeSlot), i32(6))
+		read.NewStore(read.NewOr(shifted, read.NewAnd(b, i32(0x3F))), runeSlot)
+		read.NewCondBr(ok, after, invalid)
+	}
+
+	// check: fazla uzun kodlamaları, vekil (surrogate) ve aralık dışı değerleri reddet
+	r := check.NewLoad(types.I32, runeSlot)
+	minRune := check.NewSelect(check.NewICmp(enum.IPredEQ, width, i32(2)), i32(0x80),
+		check.NewSelect(check.NewICmp(enum.IPredEQ, width, i32(3)), i32(0x800), i32(0x10000)))
+	overlong := check.NewICmp(enum.IPredULT, r, minRune)
+	surrogate := check.NewAnd(
+		check.NewICmp(enum.IPredUGE, r, i32(0xD800)),
+		check.NewICmp(enum.IPredULE, r, i32(0xDFFF)))
+	tooLarge := check.NewICmp(enum.IPredUGT, r, i32(0x10FFFF))
+	check.NewCondBr(check.NewOr(check.NewOr(overlong, surrogate), tooLarge), invalid, valid)
+
+	result(valid, r, width)
+	result(invalid, i32(0xFFFD), i32(1))
+
+	return fn
+}
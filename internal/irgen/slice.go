@@ -0,0 +1,337 @@
+package irgen
+
+import (
+	"fmt"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// Slice'lar { T* veri, i32 uzunluk, i32 kapasite } başlığını gösteren
+// işaretçilerdir. make, slice değişmezleri, dilimleme ve append'in
+// oluşturduğu başlıklar ile eleman dizileri heap'te ayrılır; böylece bir
+// fonksiyonda oluşturulan slice döndürülebilir ve slice'ların slice'ı
+// (çok boyutlu slice'lar) her satır için ayrı bir dizi tutar. Dilimleme yeni
+// bir başlık oluşturur ama veriyi kopyalamaz; kapasitesi dolan bir slice'a
+// yapılan append elemanları yeni bir diziye taşır.
+
+// sliceTypeOf, elemanları elemType tipinde olan slice'ın tipini döndürür.
+func sliceTypeOf(elemType types.Type) *types.PointerType {
+	return types.NewPointer(types.NewStruct(types.NewPointer(elemType), types.I32, types.I32))
+}
+
+// sliceStruct, bir slice başlığı işaretçisinin gösterdiği struct tipini
+// döndürür; tip bir slice değilse nil döner.
+func sliceStruct(t types.Type) *types.StructType {
+	ptr, ok := t.(*types.PointerType)
+	if !ok {
+		return nil
+	}
+	st, ok := ptr.ElemType.(*types.StructType)
+	if !ok || st.Name() != "" || len(st.Fields) != 3 {
+		return nil
+	}
+	if _, ok := st.Fields[0].(*types.PointerType); !ok {
+		return nil
+	}
+	return st
+}
+
+// sliceElemType, bir slice başlığı işaretçisinin eleman tipini döndürür;
+// değer bir slice değilse nil döner.
+func sliceElemType(t types.Type) types.Type {
+	if st := sliceStruct(t); st != nil {
+		return st.Fields[0].(*types.PointerType).ElemType
+	}
+	return nil
+}
+
+// sliceFieldPtr, slice başlığının verilen alanının adresini hesaplar.
+func (g *IRGenerator) sliceFieldPtr(slice value.Value, field int64) value.Value {
+	st := sliceStruct(slice.Type())
+	return g.currentBB.NewGetElementPtr(st, slice, constant.NewInt(types.I32, 0), constant.NewInt(types.I32, field))
+}
+
+// sliceData, sliceLen ve sliceCap, bir slice başlığının alanlarını okur.
+func (g *IRGenerator) sliceData(slice value.Value) value.Value {
+	st := sliceStruct(slice.Type())
+	return g.currentBB.NewLoad(st.Fields[0], g.sliceFieldPtr(slice, 0))
+}
+
+func (g *IRGenerator) sliceLen(slice value.Value) value.Value {
+	return g.currentBB.NewLoad(types.I32, g.sliceFieldPtr(slice, 1))
+}
+
+func (g *IRGenerator) sliceCap(slice value.Value) value.Value {
+	return g.currentBB.NewLoad(types.I32, g.sliceFieldPtr(slice, 2))
+}
+
+// newSlice, heap'te verilen alanlarla yeni bir slice başlığı oluşturur.
+func (g *IRGenerator) newSlice(sliceType *types.PointerType, data, length, capacity value.Value) value.Value {
+	st := sliceType.ElemType.(*types.StructType)
+	mem := g.currentBB.NewCall(g.getMallocFunction(), sizeOf(st))
+	slice := g.currentBB.NewBitCast(mem, sliceType)
+	for i, field := range []value.Value{data, length, capacity} {
+		g.currentBB.NewStore(field, g.sliceFieldPtr(slice, int64(i)))
+	}
+	return slice
+}
+
+// allocElements, heap'te sıfırlanmış count elemanlık bir dizi ayırır.
+func (g *IRGenerator) allocElements(elemType types.Type, count value.Value) value.Value {
+	calloc := g.getExternalFunction("calloc", bytePtr, ir.NewParam("n", types.I64), ir.NewParam("size", types.I64))
+	mem := g.currentBB.NewCall(calloc, g.convertIntWidth(count, types.I64, false), sizeOf(elemType))
+	return g.currentBB.NewBitCast(mem, types.NewPointer(elemType))
+}
+
+// moveElements, count elemanı src'den dst'ye kopyalar; bölgeler çakışabilir.
+func (g *IRGenerator) moveElements(elemType types.Type, dst, src, count value.Value) {
+	memmove := g.getExternalFunction("memmove", bytePtr,
+		ir.NewParam("dst", bytePtr), ir.NewParam("src", bytePtr), ir.NewParam("n", types.I64))
+	size := g.currentBB.NewMul(g.convertIntWidth(count, types.I64, false), sizeOf(elemType))
+	g.currentBB.NewCall(memmove, g.currentBB.NewBitCast(dst, bytePtr), g.currentBB.NewBitCast(src, bytePtr), size)
+}
+
+// pointedArrayType, t bir diziyi gösteren işaretçiyse dizinin tipini
+// döndürür; değilse nil döner.
+func pointedArrayType(t types.Type) *types.ArrayType {
+	if ptr, ok := t.(*types.PointerType); ok {
+		if arrType, ok := ptr.ElemType.(*types.ArrayType); ok {
+			return arrType
+		}
+	}
+	return nil
+}
+
+// newArray, sıfırlanmış bir dizi için yer ayırır ve diziyi gösteren
+// işaretçiyi döndürür. Diziler değişkenlerde bu işaretçi olarak tutulur.
+func (g *IRGenerator) newArray(arrayType *types.ArrayType) value.Value {
+	array := g.currentBB.NewAlloca(arrayType)
+	g.currentBB.NewStore(constant.NewZeroInitializer(arrayType), array)
+	return array
+}
+
+// sliceIndexValue, bir dilimleme sınırını slice alanlarının genişliğine (i32)
+// dönüştürür.
+func (g *IRGenerator) sliceIndexValue(expr ast.Expression) value.Value {
+	val := g.generateExpression(expr)
+	if val == nil {
+		return nil
+	}
+	if !types.IsInt(val.Type()) {
+		g.ReportError("Dilimleme sınırı tamsayı olmalıdır, alınan: %s", g.typeName(val.Type()))
+		return nil
+	}
+	return g.convertIntWidth(val, types.I32, g.isUnsignedExpr(expr))
+}
+
+// generateSliceExpression, s[i:j] ve s[i:j:k] için IR üretir. String'ler
+// string olarak, diziler ve slice'lar yeni bir slice başlığı olarak
+// dilimlenir.
+func (g *IRGenerator) generateSliceExpression(expr *ast.SliceExpression) value.Value {
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, dilimleme ifadesi değerlendirilemiyor")
+		return nil
+	}
+	operand := g.generateExpression(expr.Left)
+	if operand == nil {
+		return nil
+	}
+	if g.isStringType(operand.Type()) {
+		if expr.Max != nil {
+			g.ReportError("3 indeksli dilimleme string'lerde kullanılamaz")
+			return nil
+		}
+		return g.generateStringSlice(operand, expr)
+	}
+
+	// Dilimlenen verinin başı, uzunluğu ve kapasitesi
+	var data, length, capacity value.Value
+	var elemType types.Type
+	if elemType = sliceElemType(operand.Type()); elemType != nil {
+		data, length, capacity = g.sliceData(operand), g.sliceLen(operand), g.sliceCap(operand)
+	} else if arrayType := pointedArrayType(operand.Type()); arrayType != nil {
+		elemType = arrayType.ElemType
+		zero := constant.NewInt(types.I32, 0)
+		data = g.currentBB.NewGetElementPtr(arrayType, operand, zero, zero)
+		length = constant.NewInt(types.I32, int64(arrayType.Len))
+		capacity = length
+	} else {
+		g.ReportError("Dilimleme işlemi bir string, dizi veya slice gerektirir, %s alındı", g.typeName(operand.Type()))
+		return nil
+	}
+
+	var low, high, max value.Value = constant.NewInt(types.I32, 0), length, capacity
+	if expr.Low != nil {
+		if low = g.sliceIndexValue(expr.Low); low == nil {
+			return nil
+		}
+	}
+	if expr.High != nil {
+		if high = g.sliceIndexValue(expr.High); high == nil {
+			return nil
+		}
+	}
+	if expr.Max != nil {
+		if max = g.sliceIndexValue(expr.Max); max == nil {
+			return nil
+		}
+	}
+
+	g.generateSliceBoundsCheck(low, high, max, capacity)
+
+	newData := g.currentBB.NewGetElementPtr(elemType, data, low)
+	return g.newSlice(sliceTypeOf(elemType), newData, g.currentBB.NewSub(high, low), g.currentBB.NewSub(max, low))
+}
+
+// generateSliceBoundsCheck, 0 <= low <= high <= max <= capacity koşulunu
+// denetler; sağlanmazsa panic başlatılır.
+func (g *IRGenerator) generateSliceBoundsCheck(low, high, max, capacity value.Value) {
+	var outOfRange value.Value = g.currentBB.NewICmp(enum.IPredSLT, low, constant.NewInt(types.I32, 0))
+	for _, pair := range [][2]value.Value{{low, high}, {high, max}, {max, capacity}} {
+		inverted := g.currentBB.NewICmp(enum.IPredSGT, pair[0], pair[1])
+		outOfRange = g.currentBB.NewOr(outOfRange, inverted)
+	}
+	g.generatePanicIf(outOfRange, "slice", "runtime error: slice bounds out of range")
+}
+
+// copySource, copy ve append(s, t...) için kaynak değerin elemanlarını ve
+// eleman sayısını döndürür; kaynak bir slice veya string olabilir.
+func (g *IRGenerator) copySource(src value.Value, elemType types.Type, op string) (value.Value, value.Value) {
+	if g.isStringType(src.Type()) && elemType.Equal(types.I8) {
+		return g.stringData(src), g.currentBB.NewTrunc(g.stringLen(src), types.I32)
+	}
+	if srcElem := sliceElemType(src.Type()); srcElem != nil && srcElem.Equal(elemType) {
+		return g.sliceData(src), g.sliceLen(src)
+	}
+	g.ReportError("%s işleminde kaynak %s, %s elemanlı slice'a kopyalanamaz", op, g.typeName(src.Type()), g.typeName(elemType))
+	return nil, nil
+}
+
+// generateCopyCall, copy(dst, src) için IR üretir: min(len(dst), len(src))
+// eleman kopyalanır ve bu sayı döndürülür.
+func (g *IRGenerator) generateCopyCall(args []ast.Expression) value.Value {
+	if len(args) != 2 {
+		g.ReportError("copy() fonksiyonu 2 argüman alır, %d verildi", len(args))
+		return nil
+	}
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, copy() çağrısı yapılamıyor")
+		return nil
+	}
+
+	dst := g.generateExpression(args[0])
+	if dst == nil {
+		return nil
+	}
+	elemType := sliceElemType(dst.Type())
+	if elemType == nil {
+		g.ReportError("copy() fonksiyonunun ilk argümanı slice olmalıdır")
+		return nil
+	}
+	src := g.generateExpression(args[1])
+	if src == nil {
+		return nil
+	}
+	srcData, srcLen := g.copySource(src, elemType, "copy")
+	if srcData == nil {
+		return nil
+	}
+
+	dstLen := g.sliceLen(dst)
+	shorter := g.currentBB.NewICmp(enum.IPredSLT, srcLen, dstLen)
+	count := g.currentBB.NewSelect(shorter, srcLen, dstLen)
+	g.moveElements(elemType, g.sliceData(dst), srcData, count)
+	return count
+}
+
+// growSlice, slice'tan extra eleman daha uzun yeni bir slice başlığı üretir:
+// kapasite yetmezse elemanlar en az iki katı kapasiteli yeni bir diziye
+// taşınır. İşlenenin başlığı değişmez, böylece b := append(a, x) a'nın
+// uzunluğunu etkilemez. Yeni başlık ve eklenen elemanların yazılacağı ilk
+// konumun adresi döndürülür.
+func (g *IRGenerator) growSlice(slice value.Value, elemType types.Type, extra value.Value) (value.Value, value.Value) {
+	currentLen := g.sliceLen(slice)
+	currentCap := g.sliceCap(slice)
+	currentData := g.sliceData(slice)
+	newLen := g.currentBB.NewAdd(currentLen, extra)
+
+	needsRealloc := g.currentBB.NewICmp(enum.IPredSGT, newLen, currentCap)
+	g.labelCounter++
+	reallocBlock := g.currentFunc.NewBlock(fmt.Sprintf("realloc.%d", g.labelCounter))
+	appendBlock := g.currentFunc.NewBlock(fmt.Sprintf("append.%d", g.labelCounter))
+	entryBlock := g.currentBB
+	g.currentBB.NewCondBr(needsRealloc, reallocBlock, appendBlock)
+
+	g.currentBB = reallocBlock
+	doubleCap := g.currentBB.NewMul(currentCap, constant.NewInt(types.I32, 2))
+	minCap := g.currentBB.NewICmp(enum.IPredSLT, doubleCap, newLen)
+	newCap := g.currentBB.NewSelect(minCap, newLen, doubleCap)
+	newData := g.allocElements(elemType, newCap)
+	g.moveElements(elemType, newData, currentData, currentLen)
+	g.currentBB.NewBr(appendBlock)
+
+	g.currentBB = appendBlock
+	data := g.currentBB.NewPhi(ir.NewIncoming(currentData, entryBlock), ir.NewIncoming(newData, reallocBlock))
+	capacity := g.currentBB.NewPhi(ir.NewIncoming(currentCap, entryBlock), ir.NewIncoming(newCap, reallocBlock))
+	result := g.newSlice(slice.Type().(*types.PointerType), data, newLen, capacity)
+	return result, g.currentBB.NewGetElementPtr(elemType, data, currentLen)
+}
+
+// compositeArrayType, bir dizi veya slice değişmezinin tipini döndürür;
+// değişmez bir dizi veya slice değilse nil döner.
+func (g *IRGenerator) compositeArrayType(expr *ast.CompositeLiteral) types.Type {
+	var litType types.Type
+	switch t := expr.Type.(type) {
+	case *ast.ArrayType:
+		litType = g.resolveType(t)
+	case *ast.Identifier:
+		litType = g.typeTable[t.Value]
+	}
+	if sliceStruct(litType) == nil && pointedArrayType(litType) == nil {
+		return nil
+	}
+	return litType
+}
+
+// generateArrayCompositeLiteral, []T{...} ve [N]T{...} için IR üretir.
+// Elemanlar sırayla yeni ayrılan diziye yazılır; dizinin kalan elemanları
+// sıfır değerini alır.
+func (g *IRGenerator) generateArrayCompositeLiteral(expr *ast.CompositeLiteral, litType types.Type) value.Value {
+	var elements, result value.Value
+	var elemType types.Type
+	count := int64(len(expr.Elements))
+	if elemType = sliceElemType(litType); elemType != nil {
+		elements = g.allocElements(elemType, constant.NewInt(types.I32, count))
+		length := constant.NewInt(types.I32, count)
+		result = g.newSlice(litType.(*types.PointerType), elements, length, length)
+	} else {
+		arrayType := pointedArrayType(litType)
+		if uint64(count) > arrayType.Len {
+			g.ReportError("%s değişmezinde çok fazla eleman: %d", expr.Type.String(), count)
+			return nil
+		}
+		elemType = arrayType.ElemType
+		result = g.newArray(arrayType)
+		zero := constant.NewInt(types.I32, 0)
+		elements = g.currentBB.NewGetElementPtr(arrayType, result, zero, zero)
+	}
+
+	for i, element := range expr.Elements {
+		if _, keyed := element.(*ast.KeyValueExpression); keyed {
+			g.ReportError("Dizi değişmezlerinde indeksli elemanlar desteklenmiyor: %s", element.String())
+			return nil
+		}
+		val := g.generateExpression(element)
+		if val == nil {
+			return nil
+		}
+		val = g.convertAssignedValue(val, elemType, g.isUnsignedExpr(element))
+		g.currentBB.NewStore(val, g.currentBB.NewGetElementPtr(elemType, elements, constant.NewInt(types.I32, int64(i))))
+	}
+	return result
+}
@@ -0,0 +1,273 @@
+package irgen
+
+import (
+	"fmt"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/enum"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// String'ler { i8* veri, i64 uzunluk } değerleri olarak tutulur (%string).
+// Veri NUL ile sonlanmak zorunda değildir; len(s) uzunluk alanını okur,
+// dilimleme veriyi kopyalamadan aynı belleği paylaşır. Birleştirme,
+// karşılaştırma ve dönüşümler programla birlikte bağlanan C runtime'ındaki
+// (codegen/runtime/string.c) gominus_* fonksiyonlarına çağrı olarak üretilir.
+// Runtime'a string'ler veri ve uzunluk olarak ayrı ayrı geçirilir; string
+// döndüren fonksiyonlar sonucu verilen adrese yazar.
+//
+// Birleştirme ve dönüşümlerin sonuçları tempStrings'e kaydedilir. Böyle bir
+// değer saklanmadan tüketildiğinde (karşılaştırma, yazdırma, len, []byte
+// dönüşümü) releaseTemporary tamponunu serbest bırakır; değişkenlere atanan
+// string'ler serbest bırakılmaz.
+
+// newStringType, string değerlerinin LLVM tipini oluşturur.
+func newStringType() *types.StructType {
+	return types.NewStruct(bytePtr, types.I64)
+}
+
+// stringType, modülün string tipini döndürür.
+func (g *IRGenerator) stringType() *types.StructType {
+	return g.typeTable["string"].(*types.StructType)
+}
+
+// isStringType, bir tipin string tipi olup olmadığını kontrol eder.
+func (g *IRGenerator) isStringType(t types.Type) bool {
+	st, ok := t.(*types.StructType)
+	return ok && st.Name() == "string"
+}
+
+// stringRuntimeFunc, string runtime'ının bir fonksiyonunu bildirir.
+func (g *IRGenerator) stringRuntimeFunc(name string) *ir.Func {
+	out := ir.NewParam("out", types.NewPointer(g.stringType()))
+	data, length := ir.NewParam("s", bytePtr), ir.NewParam("len", types.I64)
+	switch name {
+	case "gominus_concatstrings":
+		return g.getExternalFunction(name, types.I32, out,
+			ir.NewParam("parts", types.NewPointer(g.stringType())), ir.NewParam("n", types.I64))
+	case "gominus_cmpstring":
+		return g.getExternalFunction(name, types.I32, ir.NewParam("a", bytePtr), ir.NewParam("alen", types.I64),
+			ir.NewParam("b", bytePtr), ir.NewParam("blen", types.I64))
+	case "gominus_cstring", "gominus_stringtoslicebyte", "gominus_stringtoslicerune":
+		return g.getExternalFunction(name, bytePtr, data, length)
+	case "gominus_releasestring":
+		return g.getExternalFunction(name, types.Void, ir.NewParam("s", bytePtr), ir.NewParam("owned", types.I32))
+	case "gominus_intstring":
+		return g.getExternalFunction(name, types.Void, out, ir.NewParam("r", types.I64))
+	case "gominus_slicebytetostring", "gominus_slicerunetostring":
+		return g.getExternalFunction(name, types.Void, out, ir.NewParam("slice", bytePtr))
+	}
+	panic("bilinmeyen string runtime fonksiyonu: " + name)
+}
+
+// stringLiteral, bir string sabiti üretir. Veri, C fonksiyonlarına
+// geçirilebilmesi için NUL ile sonlanan salt okunur bir global dizide tutulur.
+func (g *IRGenerator) stringLiteral(s string) constant.Constant {
+	global := g.module.NewGlobalDef("", constant.NewCharArrayFromString(s+"\x00"))
+	global.Immutable = true
+	zero := constant.NewInt(types.I32, 0)
+	data := constant.NewGetElementPtr(global.ContentType, global, zero, zero)
+	return constant.NewStruct(g.stringType(), data, constant.NewInt(types.I64, int64(len(s))))
+}
+
+// stringData ve stringLen, bir string değerinin alanlarını okur.
+func (g *IRGenerator) stringData(s value.Value) value.Value {
+	return g.currentBB.NewExtractValue(s, 0)
+}
+
+func (g *IRGenerator) stringLen(s value.Value) value.Value {
+	return g.currentBB.NewExtractValue(s, 1)
+}
+
+// makeString, veri ve uzunluktan bir string değeri oluşturur.
+func (g *IRGenerator) makeString(data, length value.Value) value.Value {
+	var s value.Value = constant.NewZeroInitializer(g.stringType())
+	s = g.currentBB.NewInsertValue(s, data, 0)
+	return g.currentBB.NewInsertValue(s, length, 1)
+}
+
+// stringResult, sonucunu bir adrese yazan runtime fonksiyonunu çağırır ve
+// sonucu döndürür. Sonuç geçici bir string olarak kaydedilir; owned, tamponun
+// sonuca ait olup olmadığını belirten değerdir ve nil ise çağrının dönüş
+// değeri kullanılır.
+func (g *IRGenerator) stringResult(name string, owned value.Value, args ...value.Value) value.Value {
+	g.labelCounter++
+	out := g.entryAlloca(g.stringType(), fmt.Sprintf("str.%d", g.labelCounter))
+	call := g.currentBB.NewCall(g.stringRuntimeFunc(name), append([]value.Value{out}, args...)...)
+	if owned == nil {
+		owned = call
+	}
+	result := g.currentBB.NewLoad(g.stringType(), out)
+	g.tempStrings[result] = owned
+	return result
+}
+
+// releaseTemporary, değerlerden geçici string olanların tamponlarını
+// serbest bırakır. Yalnızca saklanmadan tüketilen değerler için çağrılır.
+func (g *IRGenerator) releaseTemporary(vals ...value.Value) {
+	for _, val := range vals {
+		owned, ok := g.tempStrings[val]
+		if !ok {
+			continue
+		}
+		delete(g.tempStrings, val)
+		g.currentBB.NewCall(g.stringRuntimeFunc("gominus_releasestring"), g.stringData(val), owned)
+	}
+}
+
+// cString, bir string'in NUL ile sonlanan kopyasını üretir; panic mesajları
+// gibi C string'i bekleyen yerlerde kullanılır.
+func (g *IRGenerator) cString(s value.Value) value.Value {
+	return g.currentBB.NewCall(g.stringRuntimeFunc("gominus_cstring"), g.stringData(s), g.stringLen(s))
+}
+
+// additionOperands, a + b + c biçimindeki bir toplama zincirinin
+// işlenenlerini soldan sağa döndürür.
+func additionOperands(expr *ast.InfixExpression) []ast.Expression {
+	var operands []ast.Expression
+	if left, ok := expr.Left.(*ast.InfixExpression); ok && left.Operator == "+" {
+		operands = additionOperands(left)
+	} else {
+		operands = []ast.Expression{expr.Left}
+	}
+	return append(operands, expr.Right)
+}
+
+// generateStringConcatChain, a + b + c biçimindeki bir string birleştirme
+// zincirini ara sonuç üretmeden tek bir runtime çağrısıyla üretir.
+func (g *IRGenerator) generateStringConcatChain(operands []ast.Expression) value.Value {
+	parts := make([]value.Value, 0, len(operands))
+	for _, operand := range operands {
+		part := g.generateExpression(operand)
+		if part == nil {
+			return nil
+		}
+		if !g.isStringType(part.Type()) {
+			g.ReportError("String birleştirmesinde %s tipindeki değer kullanılamaz", g.typeName(part.Type()))
+			return nil
+		}
+		parts = append(parts, part)
+	}
+	return g.generateStringConcat(parts)
+}
+
+// generateStringConcat, string parçalarını birleştirir.
+func (g *IRGenerator) generateStringConcat(parts []value.Value) value.Value {
+	g.labelCounter++
+	arrayType := types.NewArray(uint64(len(parts)), g.stringType())
+	buf := g.entryAlloca(arrayType, fmt.Sprintf("concat.%d", g.labelCounter))
+	zero := constant.NewInt(types.I32, 0)
+	for i, part := range parts {
+		g.currentBB.NewStore(part, g.currentBB.NewGetElementPtr(arrayType, buf, zero, constant.NewInt(types.I32, int64(i))))
+	}
+	first := g.currentBB.NewGetElementPtr(arrayType, buf, zero, zero)
+	return g.stringResult("gominus_concatstrings", nil, first, constant.NewInt(types.I64, int64(len(parts))))
+}
+
+// generateStringComparison, iki string'i bayt sırasına göre karşılaştırır.
+// Operatör bir karşılaştırma değilse nil döner.
+func (g *IRGenerator) generateStringComparison(operator string, left, right value.Value) value.Value {
+	preds := map[string]enum.IPred{
+		"==": enum.IPredEQ, "!=": enum.IPredNE,
+		"<": enum.IPredSLT, "<=": enum.IPredSLE, ">": enum.IPredSGT, ">=": enum.IPredSGE,
+	}
+	pred, ok := preds[operator]
+	if !ok {
+		return nil
+	}
+	cmp := g.currentBB.NewCall(g.stringRuntimeFunc("gominus_cmpstring"),
+		g.stringData(left), g.stringLen(left), g.stringData(right), g.stringLen(right))
+	return g.currentBB.NewICmp(pred, cmp, constant.NewInt(types.I32, 0))
+}
+
+// stringIndexValue, bir indeks veya dilimleme sınırını i64'e genişletir.
+func (g *IRGenerator) stringIndexValue(expr ast.Expression) value.Value {
+	val := g.generateExpression(expr)
+	if val == nil {
+		return nil
+	}
+	if !types.IsInt(val.Type()) {
+		g.ReportError("String indeksi tamsayı olmalıdır, alınan: %s", g.typeName(val.Type()))
+		return nil
+	}
+	return g.convertIntWidth(val, types.I64, g.isUnsignedExpr(expr))
+}
+
+// generateStringIndex, s[i] için IR üretir: indeks uzunluğa karşı denetlenir
+// ve i'inci bayt okunur.
+func (g *IRGenerator) generateStringIndex(s value.Value, indexExpr ast.Expression) value.Value {
+	index := g.stringIndexValue(indexExpr)
+	if index == nil {
+		return nil
+	}
+	outOfRange := g.currentBB.NewICmp(enum.IPredUGE, index, g.stringLen(s))
+	g.generatePanicIf(outOfRange, "bounds", "runtime error: index out of range")
+	ptr := g.currentBB.NewGetElementPtr(types.I8, g.stringData(s), index)
+	return g.currentBB.NewLoad(types.I8, ptr)
+}
+
+// generateStringSlice, s[i:j] için IR üretir. Sonuç, verinin kopyasını
+// değil aynı belleğin bir bölümünü gösterir; 0 <= i <= j <= len(s)
+// sağlanmazsa panic başlatılır.
+func (g *IRGenerator) generateStringSlice(s value.Value, expr *ast.SliceExpression) value.Value {
+	length := g.stringLen(s)
+	var low, high value.Value = constant.NewInt(types.I64, 0), length
+	if expr.Low != nil {
+		if low = g.stringIndexValue(expr.Low); low == nil {
+			return nil
+		}
+	}
+	if expr.High != nil {
+		if high = g.stringIndexValue(expr.High); high == nil {
+			return nil
+		}
+	}
+
+	// Karşılaştırmalar işaretsizdir: negatif sınırlar çok büyük sayılır
+	badHigh := g.currentBB.NewICmp(enum.IPredUGT, high, length)
+	badLow := g.currentBB.NewICmp(enum.IPredUGT, low, high)
+	g.generatePanicIf(g.currentBB.NewOr(badHigh, badLow), "slice", "runtime error: slice bounds out of range")
+
+	data := g.currentBB.NewGetElementPtr(types.I8, g.stringData(s), low)
+	return g.makeString(data, g.currentBB.NewSub(high, low))
+}
+
+// convertString, string ile []byte, []rune ve rune arasındaki dönüşümleri
+// üretir. Dönüşüm bir string dönüşümü değilse nil döner. String'e
+// dönüşümler her zaman yeni bir tampon ayırır; string'den dönüşümler
+// baytları kopyaladığından geçici bir kaynak string serbest bırakılır.
+func (g *IRGenerator) convertString(val value.Value, target types.Type, fromUnsigned bool) value.Value {
+	if g.isStringType(target) {
+		owned := constant.NewInt(types.I32, 1)
+		if types.IsInt(val.Type()) {
+			r := g.convertIntWidth(val, types.I64, fromUnsigned)
+			return g.stringResult("gominus_intstring", owned, r)
+		}
+		switch sliceElemType(val.Type()) {
+		case types.I8:
+			return g.stringResult("gominus_slicebytetostring", owned, g.currentBB.NewBitCast(val, bytePtr))
+		case types.I32:
+			return g.stringResult("gominus_slicerunetostring", owned, g.currentBB.NewBitCast(val, bytePtr))
+		}
+		return nil
+	}
+
+	if !g.isStringType(val.Type()) {
+		return nil
+	}
+	var name string
+	switch sliceElemType(target) {
+	case types.I8:
+		name = "gominus_stringtoslicebyte"
+	case types.I32:
+		name = "gominus_stringtoslicerune"
+	default:
+		return nil
+	}
+	slice := g.currentBB.NewCall(g.stringRuntimeFunc(name), g.stringData(val), g.stringLen(val))
+	g.releaseTemporary(val)
+	return g.currentBB.NewBitCast(slice, target)
+}
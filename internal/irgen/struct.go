@@ -0,0 +1,641 @@
+package irgen
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+
+	"github.com/llir/llvm/ir"
+	"github.com/llir/llvm/ir/constant"
+	"github.com/llir/llvm/ir/types"
+	"github.com/llir/llvm/ir/value"
+)
+
+// StructInfo, bir struct tipi hakkında bilgi tutar.
+type StructInfo struct {
+	Name    string // Anonim struct'lar için boş
+	Type    *types.StructType
+	Fields  []StructFieldInfo
+	Methods map[string]*ir.Func // Alıcılı metotlar ve yükseltilmiş metot sarmalayıcıları
+}
+
+// StructFieldInfo, bir struct alanı hakkında bilgi tutar. Alanın LLVM
+// struct'ındaki indeksi, Fields dilimindeki sırasıdır.
+type StructFieldInfo struct {
+	Name     string
+	Type     types.Type
+	Embedded bool
+	Tag      string
+}
+
+// fieldPath, bir alanın (gömülü struct'lardan yükseltilmiş olabilir) struct
+// içindeki indeks yolunu ve tipini döndürür. Alanlar en sığ derinlikten
+// başlayarak aranır; bulunamazsa veya aynı derinlikte birden fazla varsa nil döner.
+func (g *IRGenerator) fieldPath(info *StructInfo, name string) ([]int, types.Type) {
+	type candidate struct {
+		info *StructInfo
+		path []int
+	}
+
+	current := []candidate{{info: info}}
+	visited := map[*StructInfo]bool{}
+	for len(current) > 0 {
+		var next []candidate
+		var foundPath []int
+		var foundType types.Type
+		count := 0
+
+		for _, c := range current {
+			if visited[c.info] {
+				continue
+			}
+			visited[c.info] = true
+
+			for i, field := range c.info.Fields {
+				path := append(append([]int{}, c.path...), i)
+				if field.Name == name {
+					foundPath, foundType = path, field.Type
+					count++
+				}
+				if innerInfo := g.embeddedInfo(field); innerInfo != nil {
+					next = append(next, candidate{info: innerInfo, path: path})
+				}
+			}
+		}
+
+		if count == 1 {
+			return foundPath, foundType
+		}
+		if count > 1 {
+			return nil, nil
+		}
+		current = next
+	}
+
+	return nil, nil
+}
+
+// embeddedInfo, gömülü bir alanın (T veya *T) struct bilgisini döndürür.
+// Alan gömülü değilse veya bir struct'ı göstermiyorsa nil döner.
+func (g *IRGenerator) embeddedInfo(field StructFieldInfo) *StructInfo {
+	if !field.Embedded {
+		return nil
+	}
+	if info := g.structPointerInfo(field.Type); info != nil {
+		return info
+	}
+	if st, ok := field.Type.(*types.StructType); ok {
+		return g.structTable[st]
+	}
+	return nil
+}
+
+// fieldAddress, fieldPath'in döndürdüğü yoldaki alanın adresini block'ta
+// üretir. Gömülü struct'lar tek bir getelementptr ile geçilir; yoldaki gömülü
+// işaretçiler yüklenerek gösterdikleri struct'tan devam edilir.
+func (g *IRGenerator) fieldAddress(block *ir.Block, info *StructInfo, addr value.Value, path []int) value.Value {
+	base := info.Type
+	indices := []value.Value{constant.NewInt(types.I32, 0)}
+	for i, index := range path {
+		indices = append(indices, constant.NewInt(types.I32, int64(index)))
+		field := info.Fields[index]
+		if i == len(path)-1 {
+			break
+		}
+		info = g.embeddedInfo(field)
+		if types.IsPointer(field.Type) {
+			addr = block.NewLoad(field.Type, block.NewGetElementPtr(base, addr, indices...))
+			base = info.Type
+			indices = []value.Value{constant.NewInt(types.I32, 0)}
+		}
+	}
+	return block.NewGetElementPtr(base, addr, indices...)
+}
+
+// declareTypes, verilen ifadelerdeki tip bildirimleri için LLVM tiplerini
+// oluşturur. Struct'lar birbirine ileriye doğru başvurabildiğinden önce tüm
+// struct ve arayüz tipleri adlandırılmış (opak) tipler olarak tanımlanır.
+// Ardından takma adlar ve dayanak tipleriyle temsil edilen adlandırılmış
+// tipler, struct ve arayüz gövdeleri, en son başka bir struct veya arayüze
+// dayanan tiplerin gövdeleri doldurulur. prefix, fonksiyon içindeki tiplerin
+// LLVM adlarını ayırt eder.
+func (g *IRGenerator) declareTypes(stmts []ast.Statement, prefix string) {
+	decls := map[string]*ast.TypeStatement{}
+	declared := []*ast.TypeStatement{}
+	for _, stmt := range stmts {
+		if ts, ok := stmt.(*ast.TypeStatement); ok {
+			declared = append(declared, ts)
+			if _, exists := decls[ts.Name.Value]; !exists {
+				decls[ts.Name.Value] = ts
+			}
+		}
+	}
+
+	kinds := map[*ast.TypeStatement]typeDeclKind{}
+	for _, ts := range declared {
+		kind := namedDecl
+		if !ts.Alias {
+			kind = g.declKind(ts, decls, map[*ast.TypeStatement]bool{})
+		}
+		kinds[ts] = kind
+
+		name := ts.Name.Value
+		switch kind {
+		case structDecl:
+			st := types.NewStruct()
+			g.module.NewTypeDef(prefix+name, st)
+			g.typeTable[name] = st
+			g.structTable[st] = &StructInfo{Name: name, Type: st}
+		case interfaceDecl:
+			st := newInterfaceType()
+			g.module.NewTypeDef(prefix+name, st)
+			g.typeTable[name] = st
+			g.interfaceTable[st] = &InterfaceInfo{Name: name, Type: st, Methods: make(map[string]*types.FuncType)}
+		}
+	}
+
+	defined := map[*ast.TypeStatement]bool{}
+	var define func(ts *ast.TypeStatement)
+	define = func(ts *ast.TypeStatement) {
+		if defined[ts] {
+			return
+		}
+		defined[ts] = true
+
+		// Dayanılan tip aynı aşamada tanımlanıyorsa önce o tanımlanır
+		if ident, ok := ts.Type.(*ast.Identifier); ok {
+			if dep, exists := decls[ident.Value]; exists && kinds[dep] == kinds[ts] {
+				define(dep)
+			}
+		}
+
+		switch t := ts.Type.(type) {
+		case *ast.StructType:
+			if !ts.Alias {
+				g.defineStructFields(t, g.structTable[g.typeTable[ts.Name.Value].(*types.StructType)])
+				return
+			}
+		case *ast.InterfaceType:
+			if !ts.Alias {
+				g.defineInterfaceMethods(t, g.interfaceTable[g.typeTable[ts.Name.Value].(*types.StructType)])
+				return
+			}
+		}
+
+		switch {
+		case ts.Alias:
+			// Takma ad, hedef tipin kendisidir
+			if t := g.resolveType(ts.Type); t != nil {
+				g.typeTable[ts.Name.Value] = t
+			}
+			if info := g.namedTypeInfo(ts.Type); info != nil {
+				g.namedTable[ts.Name.Value] = info
+			}
+		case kinds[ts] == namedDecl:
+			g.defineNamedType(ts)
+		default:
+			g.defineNamedCopy(ts)
+		}
+	}
+
+	for _, ts := range declared {
+		if kinds[ts] == namedDecl {
+			define(ts)
+		}
+	}
+	for _, ts := range declared {
+		switch ts.Type.(type) {
+		case *ast.StructType, *ast.InterfaceType:
+			define(ts)
+		}
+	}
+	for _, ts := range declared {
+		define(ts)
+	}
+}
+
+// defineStructFields, bir struct'ın alanlarını çözümleyip LLVM struct
+// tipine ve struct bilgisine ekler. Dizi alanları struct'ın içinde tutulur;
+// böylece sıfır değerleri kullanılabilir ve struct kopyaları dizileri de
+// kopyalar.
+func (g *IRGenerator) defineStructFields(st *ast.StructType, info *StructInfo) {
+	for _, field := range st.Fields {
+		fieldType := g.resolveType(field.Type)
+		if fieldType == nil {
+			return
+		}
+		if arrayType := pointedArrayType(fieldType); arrayType != nil {
+			fieldType = arrayType
+		}
+
+		tag := ""
+		if field.Tag != nil {
+			tag = field.Tag.Value
+		}
+
+		info.Type.Fields = append(info.Type.Fields, fieldType)
+		info.Fields = append(info.Fields, StructFieldInfo{
+			Name:     field.FieldName(),
+			Type:     fieldType,
+			Embedded: field.Embedded(),
+			Tag:      tag,
+		})
+	}
+}
+
+// resolveType, bir tip ifadesinin LLVM tipini döndürür. Diziler ve slice'lar
+// diğer ifadelerde olduğu gibi işaretçi olarak temsil edilir.
+func (g *IRGenerator) resolveType(expr ast.Expression) types.Type {
+	switch e := expr.(type) {
+	case *ast.Identifier:
+		if t, exists := g.typeTable[e.Value]; exists {
+			return t
+		}
+		g.ReportError("Bilinmeyen tip: %s", e.Value)
+		return nil
+	case *ast.ArrayType:
+		elementType := g.resolveType(e.ElementType)
+		if elementType == nil {
+			return nil
+		}
+		if e.Size == nil {
+			// Slice struct: {data *T, len int32, cap int32}
+			return sliceTypeOf(elementType)
+		}
+		size, ok := e.Size.(*ast.IntegerLiteral)
+		if !ok {
+			g.ReportError("Dizi boyutu sabit bir tamsayı olmalıdır: %s", e.Size.String())
+			return nil
+		}
+		return types.NewPointer(types.NewArray(uint64(size.Value), elementType))
+	case *ast.PointerType:
+		elementType := g.resolveType(e.Elem)
+		if elementType == nil {
+			return nil
+		}
+		return types.NewPointer(elementType)
+	case *ast.PrefixExpression:
+		// alloc(*int, n) gibi ifade konumundaki işaretçi tipleri
+		if e.Operator != "*" {
+			g.ReportError("Geçersiz tip ifadesi: %s", e.String())
+			return nil
+		}
+		return g.resolveType(&ast.PointerType{Token: e.Token, Elem: e.Right})
+	case *ast.Ellipsis:
+		// Variadic parametre, fonksiyon içinde bir slice'tır
+		return g.resolveType(&ast.ArrayType{Token: e.Token, ElementType: e.Element})
+	case *ast.ChanType:
+		elementType := g.resolveType(e.Value)
+		if elementType == nil {
+			return nil
+		}
+		return g.chanType(elementType)
+	case *ast.MapType:
+		keyType := g.resolveType(e.Key)
+		valueType := g.resolveType(e.Value)
+		if keyType == nil || valueType == nil {
+			return nil
+		}
+		return g.mapType(keyType, valueType)
+	case *ast.StructType:
+		info := &StructInfo{Type: types.NewStruct()}
+		g.structTable[info.Type] = info
+		g.defineStructFields(e, info)
+		return info.Type
+	case *ast.InterfaceType:
+		if len(e.Methods) == 0 && len(e.Embeds) == 0 {
+			return g.anyInterface().Type
+		}
+		info := &InterfaceInfo{Type: newInterfaceType(), Methods: make(map[string]*types.FuncType)}
+		g.interfaceTable[info.Type] = info
+		g.defineInterfaceMethods(e, info)
+		return info.Type
+	case *ast.FuncType:
+		// Fonksiyon değerleri { fn, env } closure çiftleri olarak tutulur
+		params := make([]types.Type, 0, len(e.Parameters))
+		for _, param := range e.Parameters {
+			paramType := g.resolveType(param)
+			if paramType == nil {
+				return nil
+			}
+			params = append(params, paramType)
+		}
+		variadic := false
+		if n := len(e.Parameters); n > 0 {
+			_, variadic = e.Parameters[n-1].(*ast.Ellipsis)
+		}
+		return g.funcValueType(g.resultType(e.ReturnType), params, variadic)
+	default:
+		g.ReportError("Desteklenmeyen tip ifadesi: %T", expr)
+		return nil
+	}
+}
+
+// generateTypeStatement, bir fonksiyon içindeki tip bildirimi için tipleri
+// oluşturur. Global tipler GenerateProgram başında tanımlanır.
+func (g *IRGenerator) generateTypeStatement(stmt *ast.TypeStatement) {
+	if g.currentFunc == nil {
+		return
+	}
+	g.declareTypes([]ast.Statement{stmt}, g.currentFunc.Name()+".")
+}
+
+// generateCompositeLiteral, bir struct değişmezi için IR üretir. Değer sıfır
+// değerinden başlayarak verilen alanlar insertvalue ile eklenerek oluşturulur;
+// sonuç bir struct değeridir ve atamalarda kopyalanır.
+func (g *IRGenerator) generateCompositeLiteral(expr *ast.CompositeLiteral) value.Value {
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, bileşik değişmez değerlendirilemiyor")
+		return nil
+	}
+
+	if mapInfo := g.compositeMapInfo(expr); mapInfo != nil {
+		return g.generateMapLiteral(expr, mapInfo)
+	}
+	if arrayType := g.compositeArrayType(expr); arrayType != nil {
+		return g.generateArrayCompositeLiteral(expr, arrayType)
+	}
+
+	info := g.compositeLiteralInfo(expr)
+	if info == nil {
+		return nil
+	}
+
+	var agg value.Value = constant.NewZeroInitializer(info.Type)
+	for i, element := range expr.Elements {
+		index, valueExpr := g.compositeElementField(info, i, element)
+		if index < 0 {
+			return nil
+		}
+
+		val := g.generateExpression(valueExpr)
+		if val == nil {
+			return nil
+		}
+		val = g.convertAssignedValue(val, info.Fields[index].Type, g.isUnsignedExpr(valueExpr))
+		agg = g.currentBB.NewInsertValue(agg, val, uint64(index))
+	}
+
+	return agg
+}
+
+// generateConstantCompositeLiteral, global değişkenlerin ilk değeri olarak
+// kullanılan bir struct değişmezi için sabit üretir.
+func (g *IRGenerator) generateConstantCompositeLiteral(expr *ast.CompositeLiteral) constant.Constant {
+	info := g.compositeLiteralInfo(expr)
+	if info == nil {
+		return nil
+	}
+
+	fields := make([]constant.Constant, len(info.Fields))
+	for i, field := range info.Fields {
+		fields[i] = constant.NewZeroInitializer(field.Type)
+	}
+
+	for i, element := range expr.Elements {
+		index, valueExpr := g.compositeElementField(info, i, element)
+		if index < 0 {
+			return nil
+		}
+
+		val := g.generateConstantExpression(valueExpr)
+		if val == nil {
+			return nil
+		}
+		switch fieldType := info.Fields[index].Type.(type) {
+		case *types.IntType:
+			if c, ok := val.(*constant.Int); ok {
+				val = constant.NewInt(fieldType, c.X.Int64())
+			}
+		case *types.FloatType:
+			if c, ok := val.(*constant.Int); ok {
+				val = constant.NewFloat(fieldType, float64(c.X.Int64()))
+			}
+		}
+		fields[index] = val
+	}
+
+	return constant.NewStruct(info.Type, fields...)
+}
+
+// compositeLiteralInfo, bir bileşik değişmezin struct bilgisini döndürür.
+func (g *IRGenerator) compositeLiteralInfo(expr *ast.CompositeLiteral) *StructInfo {
+	litType := g.resolveType(expr.Type)
+	if litType == nil {
+		return nil
+	}
+
+	st, ok := litType.(*types.StructType)
+	if info, exists := g.structTable[st]; ok && exists {
+		return info
+	}
+
+	g.ReportError("Desteklenmeyen bileşik değişmez tipi: %s", expr.Type.String())
+	return nil
+}
+
+// compositeElementField, bir bileşik değişmez elemanının alan indeksini ve
+// değer ifadesini döndürür. Konumsal elemanlar tanım sırasıyla eşleşir.
+func (g *IRGenerator) compositeElementField(info *StructInfo, position int, element ast.Expression) (int, ast.Expression) {
+	kv, keyed := element.(*ast.KeyValueExpression)
+	if !keyed {
+		if position >= len(info.Fields) {
+			g.ReportError("Struct değişmezinde çok fazla değer: %s", info.Type)
+			return -1, nil
+		}
+		return position, element
+	}
+
+	if key, ok := kv.Key.(*ast.Identifier); ok {
+		for i, field := range info.Fields {
+			if field.Name == key.Value {
+				return i, kv.Value
+			}
+		}
+	}
+
+	g.ReportError("%s tipinde '%s' adında bir alan yok", info.Type, kv.Key.String())
+	return -1, nil
+}
+
+// convertAssignedValue, atanan bir değeri hedef tipine uyarlar: tamsayılar
+// hedefin genişliğine, ondalık hedeflere atanan tamsayılar ondalığa, arayüz
+// hedeflerine atanan değerler arayüz değerine, fonksiyon hedeflerine atanan
+// nil ise sıfır closure'a dönüştürülür. Struct içindeki dizi alanlarına
+// atanan diziler kopyalanmak üzere yüklenir.
+func (g *IRGenerator) convertAssignedValue(val value.Value, targetType types.Type, unsigned bool) value.Value {
+	if arrayType, ok := targetType.(*types.ArrayType); ok && pointedArrayType(val.Type()) != nil {
+		return g.currentBB.NewLoad(arrayType, val)
+	}
+	if iface := g.interfaceInfo(targetType); iface != nil {
+		return g.convertToInterface(val, iface)
+	}
+	if _, isNull := val.(*constant.Null); isNull && closureSignature(targetType) != nil {
+		return constant.NewZeroInitializer(targetType)
+	}
+	if _, isNull := val.(*constant.Null); isNull && types.IsPointer(targetType) {
+		return constant.NewNull(targetType.(*types.PointerType))
+	}
+
+	valType, isInt := val.Type().(*types.IntType)
+	if !isInt || valType.BitSize == 1 {
+		return val
+	}
+
+	switch t := targetType.(type) {
+	case *types.IntType:
+		if t.BitSize > 1 {
+			return g.convertIntWidth(val, t, unsigned)
+		}
+	case *types.FloatType:
+		if unsigned {
+			return g.currentBB.NewUIToFP(val, t)
+		}
+		return g.currentBB.NewSIToFP(val, t)
+	}
+	return val
+}
+
+// generateStructComparison, aynı tipteki iki struct değerini == ve != için
+// alan alan karşılaştırır. İşlenenler struct değilse nil döner.
+func (g *IRGenerator) generateStructComparison(operator string, left, right value.Value) value.Value {
+	st, ok := left.Type().(*types.StructType)
+	if !ok || g.structTable[st] == nil || !st.Equal(right.Type()) {
+		return nil
+	}
+	switch operator {
+	case "==":
+		return g.equalValues(g.currentBB, left, right, st)
+	case "!=":
+		return g.currentBB.NewXor(g.equalValues(g.currentBB, left, right, st), constant.True)
+	}
+	return nil
+}
+
+// generateObjectAddress, üye erişimindeki nesneyi değerlendirir. Struct
+// değerleri kopyalanmadan adresleri üzerinden kullanılır; adresi olmayan
+// struct değerleri (ör. değişmezler) geçici bir belleğe yazılır. Struct
+// işaretçileri nil kontrolünden sonra adres olarak, diğer nesneler (ör. sınıf
+// işaretçileri) değer olarak döndürülür.
+func (g *IRGenerator) generateObjectAddress(expr ast.Expression) value.Value {
+	var addr, obj value.Value
+	var elemType types.Type
+
+	switch e := expr.(type) {
+	case *ast.Identifier:
+		addr, elemType = g.variableAddress(e.Value)
+	case *ast.MemberExpression:
+		fieldPtr, fieldType, method := g.generateMemberAccess(e)
+		if fieldPtr == nil {
+			return method
+		}
+		addr, elemType = fieldPtr, fieldType
+	case *ast.IndexExpression:
+		// Map elemanları adreslenemez; değer okunup geçici belleğe yazılır
+		container := g.generateIndexContainer(e)
+		if container == nil {
+			return nil
+		}
+		if info := g.mapInfoOf(container.Type()); info != nil {
+			obj, _ = g.generateMapIndex(info, container, e.Index)
+			if obj == nil {
+				return nil
+			}
+			break
+		}
+		addr, elemType = g.indexAddress(container, e)
+		if addr == nil {
+			return nil
+		}
+	case *ast.PrefixExpression:
+		if e.Operator == "*" {
+			addr, elemType = g.dereferenceAddress(e)
+			if addr == nil {
+				return nil
+			}
+		}
+	}
+
+	if addr != nil {
+		if st, ok := elemType.(*types.StructType); ok && g.structTable[st] != nil {
+			return addr
+		}
+		if g.currentBB == nil {
+			return nil
+		}
+		obj = g.currentBB.NewLoad(elemType, addr)
+	}
+
+	if obj == nil {
+		obj = g.generateExpression(expr)
+	}
+	if obj == nil || g.currentBB == nil {
+		return nil
+	}
+	if st, ok := obj.Type().(*types.StructType); ok && g.structTable[st] != nil {
+		tmp := g.currentBB.NewAlloca(st)
+		g.currentBB.NewStore(obj, tmp)
+		return tmp
+	}
+	if g.structPointerInfo(obj.Type()) != nil {
+		g.checkNilPointer(obj)
+	}
+	return obj
+}
+
+// generateStructFieldAccess, bir struct adresi üzerinden alanın adresini ve
+// tipini üretir. Yükseltilmiş alanlara gömülü struct'lar üzerinden tek bir
+// getelementptr ile erişilir.
+func (g *IRGenerator) generateStructFieldAccess(obj value.Value, info *StructInfo, name string) (value.Value, types.Type) {
+	path, fieldType := g.fieldPath(info, name)
+	if path == nil {
+		g.ReportError("%s tipinde '%s' adında bir alan yok", info.Type, name)
+		return nil, nil
+	}
+	if g.currentBB == nil {
+		g.ReportError("Geçerli bir blok yok, alan erişimi değerlendirilemiyor")
+		return nil, nil
+	}
+
+	return g.fieldAddress(g.currentBB, info, obj, path), fieldType
+}
+
+// memberType, bir üye erişiminin tipini kod üretmeden belirler.
+func (g *IRGenerator) memberType(expr *ast.MemberExpression) types.Type {
+	member, ok := expr.Member.(*ast.Identifier)
+	if !ok {
+		return nil
+	}
+
+	switch t := g.getExpressionType(expr.Object).(type) {
+	case *types.StructType:
+		if info, exists := g.structTable[t]; exists {
+			_, fieldType := g.fieldPath(info, member.Value)
+			return fieldValueType(fieldType)
+		}
+	case *types.PointerType:
+		// Struct işaretçilerinin alanlarına otomatik olarak erişilir (p.x)
+		if info := g.structPointerInfo(t); info != nil {
+			_, fieldType := g.fieldPath(info, member.Value)
+			return fieldValueType(fieldType)
+		}
+		for name, typ := range g.typeTable {
+			if typ != t.ElemType {
+				continue
+			}
+			if classInfo, exists := g.classTable[name]; exists {
+				if field, exists := classInfo.Fields[member.Value]; exists {
+					return field.Type
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// fieldValueType, bir struct alanının ifadelerdeki tipini döndürür. Struct
+// içinde tutulan dizi alanları, diğer diziler gibi işaretçi olarak kullanılır.
+func fieldValueType(fieldType types.Type) types.Type {
+	if arrayType, ok := fieldType.(*types.ArrayType); ok {
+		return types.NewPointer(arrayType)
+	}
+	return fieldType
+}
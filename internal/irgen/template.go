@@ -191,7 +191,7 @@ func (g *IRGenerator) instantiateTemplateClass(templateInfo *TemplateInfo, class
 
 				// Parametreleri ekle
 				for i, param := range funcStmt.Parameters {
-					paramName := param.Value
+					paramName := parameterName(param)
 					paramType := paramTypes[i+1] // +1 çünkü ilk parametre this
 					method.Params = append(method.Params, ir.NewParam(paramName, paramType))
 				}
@@ -270,7 +270,7 @@ func (g *IRGenerator) instantiateTemplateFunction(templateInfo *TemplateInfo, fu
 
 	// Parametreleri ekle
 	for i, param := range funcStmt.Parameters {
-		paramName := param.Value
+		paramName := parameterName(param)
 		fn.Params = append(fn.Params, ir.NewParam(paramName, paramTypes[i]))
 	}
 
@@ -1,139 +1,546 @@
 package lexer
 
 import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
 	"github.com/inkbytefo/go-minus/internal/token"
 )
 
+// bom, dosya başında izin verilen UTF-8 bayt sırası işaretidir.
+const bom = 0xFEFF
+
+// Mode, lexer'ın davranışını belirleyen bayrakları tutar.
+type Mode uint
+
+const (
+	// ScanComments, yorumların atlanmak yerine COMMENT token'ı olarak döndürülmesini sağlar.
+	ScanComments Mode = 1 << iota
+)
+
+// readChunkSize, akış modunda okuyucudan tek seferde okunan bayt sayısıdır.
+const readChunkSize = 4096
+
 // Lexer holds the state of the scanner.
+// Tüm konumlar (position, readPosition, startPos) dosya başına göre mutlak
+// bayt offset'leridir. Girdi bir string olarak verildiğinde input tüm
+// kaynağı tutar; io.Reader'dan okunurken input yalnızca base offset'inden
+// başlayan bir penceredir ve tarama ilerledikçe kaydırılır.
 type Lexer struct {
-	input        string
-	position     int  // current position in input (points to current char)
-	readPosition int  // current reading position in input (after current char)
-	ch           byte // current char under examination
-	line         int  // current line number
-	column       int  // current column number
-	startPos     int  // token başlangıç pozisyonu
-	startLine    int  // token başlangıç satırı
-	startColumn  int  // token başlangıç sütunu
+	file         *token.File // konumların çözümlendiği kaynak dosya
+	reader       io.Reader   // akış modunda girdi kaynağı (string girdide nil)
+	readErr      error       // okuyucudan alınan son hata (io.EOF dahil)
+	input        string      // girdi veya akış modunda girdi penceresi
+	base         int         // input[0]'ın dosyadaki offset'i
+	position     int         // current position in input (points to current char)
+	readPosition int         // current reading position in input (after current char)
+	ch           rune        // current char under examination
+	line         int         // current line number
+	column       int         // current column number (rune-based)
+	startPos     int         // token başlangıç pozisyonu
+	startLine    int         // token başlangıç satırı
+	startColumn  int         // token başlangıç sütunu
+	insertSemi   bool        // satır sonunda otomatik noktalı virgül eklenmeli mi
+	mode         Mode        // tarama modu (örn. ScanComments)
+	errors       []string    // tarama sırasında karşılaşılan hatalar
+	errorOffsets []int       // her hatanın raporlandığı tarama offset'i
 }
 
 // New creates a new Lexer.
+// Girdi, adsız bir dosya olarak kendi FileSet'ine eklenir; tanılamalarda
+// yalnızca satır ve sütun görünür.
 func New(input string) *Lexer {
+	return NewFile(token.NewFileSet().AddFile("", -1, len(input)), input, 0)
+}
+
+// NewWithMode, verilen tarama moduyla yeni bir Lexer oluşturur.
+func NewWithMode(input string, mode Mode) *Lexer {
+	return NewFile(token.NewFileSet().AddFile("", -1, len(input)), input, mode)
+}
+
+// NewFile, bir FileSet'e eklenmiş dosya için yeni bir Lexer oluşturur.
+// Dosyanın boyutu girdinin uzunluğuyla aynı olmalıdır. Token konumları ve
+// hata mesajları dosya adını içerir (örn. "main.gom:12:5: ...").
+func NewFile(file *token.File, input string, mode Mode) *Lexer {
+	if file.Size() != len(input) {
+		panic(fmt.Sprintf("dosya boyutu (%d) girdi uzunluğuyla (%d) eşleşmiyor", file.Size(), len(input)))
+	}
+	file.SetSource(input)
+
 	l := &Lexer{
-		input:       input,
-		line:        1,
-		column:      1,
-		startLine:   1,
-		startColumn: 1,
+		file:   file,
+		mode:   mode,
+		input:  input,
+		errors: []string{},
 	}
-	l.readChar() // Initialize l.ch, l.position, and l.readPosition
+	l.reset(0, 1)
 	return l
 }
 
-// readChar gives us the next character and advances our position in the input string.
-func (l *Lexer) readChar() {
-	if l.readPosition >= len(l.input) {
-		l.ch = 0 // ASCII code for "NUL" character, signifying EOF or not read anything yet
-	} else {
-		l.ch = l.input[l.readPosition]
+// NewReader, bir io.Reader'dan okuyan akış tabanlı yeni bir Lexer oluşturur.
+// Girdi parçalar halinde okunur; bellekte yalnızca taranmakta olan token ve
+// ileri bakış için gereken veri tutulur. file.Size() okunacak toplam bayt
+// sayısına eşit olmalıdır. Kaynağın tamamı bellekte olmadığından dosyanın
+// satır tablosu tarama ilerledikçe doldurulur ve FileSet üzerinden çözülen
+// sütunlar bayt cinsindendir; token'ların kendi konumları yine karakter
+// (rune) tabanlıdır.
+func NewReader(file *token.File, r io.Reader, mode Mode) *Lexer {
+	l := &Lexer{
+		file:   file,
+		reader: r,
+		mode:   mode,
+		errors: []string{},
+	}
+	l.reset(0, 1)
+	return l
+}
+
+// reset, taramayı verilen satır başı offset'inden yeniden başlatır.
+func (l *Lexer) reset(offset, line int) {
+	l.readPosition = offset
+	l.ch = 0
+	l.line = line
+	l.column = 0
+	l.startPos = offset
+	l.startLine = line
+	l.startColumn = 1
+	l.insertSemi = false
+	l.readChar() // Initialize l.ch, l.position, and l.readPosition
+
+	// Dosya başındaki BOM karakterini yok say
+	if offset == 0 && l.ch == bom {
+		l.readChar()
+		l.column = 1
 	}
+}
+
+// Seek, lexer'ı verilen bayt offset'inden yeniden taramaya hazırlar.
+// Artımlı düzenlemelerde (örn. LSP) değişikliğin bulunduğu yerden itibaren
+// yeniden tarama yapmak için kullanılır. Satır ve sütun bilgisi, offset'in
+// bulunduğu satırın başından ilerlenerek yeniden hesaplanır; bu nedenle offset
+// bir token başlangıcı olmalıdır. Yeniden taranacak bölgeye ait hatalar
+// listeden çıkarılır. Akış modunda satır başı artık arabellekte değilse
+// okuyucunun io.Seeker olması gerekir.
+func (l *Lexer) Seek(offset int) error {
+	if offset < 0 || offset > l.file.Size() {
+		return fmt.Errorf("geçersiz offset %d (dosya boyutu %d)", offset, l.file.Size())
+	}
+
+	line := l.file.Line(l.file.Pos(offset))
+	lineStart := l.file.Offset(l.file.LineStart(line))
+
+	if lineStart < l.base {
+		seeker, ok := l.reader.(io.Seeker)
+		if !ok {
+			return fmt.Errorf("offset %d artık arabellekte değil ve okuyucu konumlandırılamıyor", offset)
+		}
+		if _, err := seeker.Seek(int64(lineStart), io.SeekStart); err != nil {
+			return err
+		}
+		l.input, l.base, l.readErr = "", lineStart, nil
+	}
+
+	// Yeniden taranacak bölgede raporlanan hataları at
+	for i, errOffset := range l.errorOffsets {
+		if errOffset >= lineStart {
+			l.errors = l.errors[:i]
+			l.errorOffsets = l.errorOffsets[:i]
+			break
+		}
+	}
+
+	l.reset(lineStart, line)
+	for l.position < offset && l.ch != 0 {
+		l.readChar()
+	}
+	return nil
+}
 
+// Offset, geçerli karakterin dosyadaki bayt offset'ini döndürür.
+func (l *Lexer) Offset() int {
+	return l.position
+}
+
+// File, lexer'ın taradığı kaynak dosyayı döndürür.
+func (l *Lexer) File() *token.File {
+	return l.file
+}
+
+// Mode, lexer'ın tarama modunu döndürür.
+func (l *Lexer) Mode() Mode {
+	return l.mode
+}
+
+// Errors, tarama sırasında karşılaşılan hataları döndürür.
+func (l *Lexer) Errors() []string {
+	return l.errors
+}
+
+// addError, geçerli karakterin konumuyla birlikte bir hata ekler.
+func (l *Lexer) addError(format string, args ...interface{}) {
+	l.addErrorAt(l.line, l.column, format, args...)
+}
+
+// addErrorAt, verilen konumla birlikte bir hata ekler.
+func (l *Lexer) addErrorAt(line, column int, format string, args ...interface{}) {
+	pos := token.Position{Filename: l.file.Name(), Line: line, Column: column}
+	msg := pos.String() + ": " + fmt.Sprintf(format, args...)
+	l.errors = append(l.errors, msg)
+	l.errorOffsets = append(l.errorOffsets, l.position)
+}
+
+// text, [from, to) mutlak offset aralığındaki girdiyi döndürür.
+// Aralık arabellekte olmalıdır.
+func (l *Lexer) text(from, to int) string {
+	return l.input[from-l.base : to-l.base]
+}
+
+// fill, akış modunda okuyucudan bir parça daha okuyup arabelleğe ekler.
+// Geçerli token'dan önceki veriler arabellekten atılır. Yeni veri
+// eklenemezse (string girdi, dosya sonu veya okuma hatası) false döner.
+func (l *Lexer) fill() bool {
+	if l.reader == nil || l.readErr != nil {
+		return false
+	}
+
+	// Seek ile arabelleğin ötesine konumlanıldığında token başlangıcı
+	// henüz okunmamış olabilir
+	if discard := min(l.startPos-l.base, len(l.input)); discard >= readChunkSize {
+		l.input = l.input[discard:]
+		l.base += discard
+	}
+
+	buf := make([]byte, readChunkSize)
+	for {
+		n, err := l.reader.Read(buf)
+		if room := l.file.Size() - (l.base + len(l.input)); n > room {
+			l.addError("okunan veri dosya boyutunu (%d bayt) aşıyor", l.file.Size())
+			n, err = room, io.EOF
+		}
+		l.input += string(buf[:n])
+		if err != nil {
+			if err != io.EOF {
+				l.addError("okuma hatası: %v", err)
+			}
+			l.readErr = err
+			return n > 0
+		}
+		if n > 0 {
+			return true
+		}
+	}
+}
+
+// ensure, mümkünse [offset, offset+n) aralığının arabellekte olmasını sağlar.
+func (l *Lexer) ensure(offset, n int) {
+	for offset+n-l.base > len(l.input) && l.fill() {
+	}
+}
+
+// byteAt, verilen mutlak offset'teki baytı döndürür. Gerekirse okuyucudan
+// veri okunur; offset girdinin dışındaysa false döner.
+func (l *Lexer) byteAt(offset int) (byte, bool) {
+	l.ensure(offset, 1)
+	if offset-l.base >= len(l.input) {
+		return 0, false
+	}
+	return l.input[offset-l.base], true
+}
+
+// readChar gives us the next character and advances our position in the input string.
+// Girdi UTF-8 olarak çözülür; sütunlar bayt değil rune sayısına göre ilerler.
+func (l *Lexer) readChar() {
 	// Satır ve sütun numaralarını güncelle
 	if l.ch == '\n' {
 		l.line++
 		l.column = 1
+		if l.reader != nil {
+			l.file.AddLine(l.readPosition)
+		}
 	} else {
 		l.column++
 	}
 
 	l.position = l.readPosition
-	l.readPosition++
+	l.ensure(l.readPosition, utf8.UTFMax)
+	if l.readPosition-l.base >= len(l.input) {
+		l.ch = 0 // ASCII code for "NUL" character, signifying EOF or not read anything yet
+		return
+	}
+
+	rest := l.input[l.readPosition-l.base:]
+	r, width := rune(rest[0]), 1
+	if r >= utf8.RuneSelf {
+		r, width = utf8.DecodeRuneInString(rest)
+		if r == utf8.RuneError && width == 1 {
+			l.addError("geçersiz UTF-8 kodlaması: 0x%02X baytı (ofset %d)", rest[0], l.readPosition)
+		} else if r == bom && l.position > 0 {
+			l.addError("geçersiz BOM karakteri (ofset %d)", l.readPosition)
+		}
+	} else if r == 0 {
+		l.addError("geçersiz NUL karakteri (ofset %d)", l.readPosition)
+		r = utf8.RuneError
+	}
+
+	l.ch = r
+	l.readPosition += width
 }
 
 // peekChar returns the next character without advancing the position
-func (l *Lexer) peekChar() byte {
-	if l.readPosition >= len(l.input) {
+func (l *Lexer) peekChar() rune {
+	l.ensure(l.readPosition, utf8.UTFMax)
+	if l.readPosition-l.base >= len(l.input) {
 		return 0
 	}
-	return l.input[l.readPosition]
+	r := rune(l.input[l.readPosition-l.base])
+	if r >= utf8.RuneSelf {
+		r, _ = utf8.DecodeRuneInString(l.input[l.readPosition-l.base:])
+	}
+	return r
 }
 
-// isLetter checks if the character is a letter or underscore
-func isLetter(ch byte) bool {
-	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_'
+// isLetter checks if the character is a letter or underscore.
+// Go belirtimindeki gibi tüm Unicode harfleri kabul edilir.
+func isLetter(ch rune) bool {
+	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_' ||
+		ch >= utf8.RuneSelf && unicode.IsLetter(ch)
 }
 
-// isDigit checks if the character is a digit
-func isDigit(ch byte) bool {
+// isDigit checks if the character is a decimal digit
+func isDigit(ch rune) bool {
 	return '0' <= ch && ch <= '9'
 }
 
+// isIdentDigit, bir tanımlayıcının ilk karakterinden sonra gelebilecek
+// Unicode rakamlarını (Nd kategorisi) kontrol eder.
+func isIdentDigit(ch rune) bool {
+	return isDigit(ch) || ch >= utf8.RuneSelf && unicode.IsDigit(ch)
+}
+
 // isWhitespace checks if the character is a whitespace
-func isWhitespace(ch byte) bool {
+func isWhitespace(ch rune) bool {
 	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
 }
 
 // readIdentifier reads an identifier from the input
 func (l *Lexer) readIdentifier() string {
 	position := l.position
-	for isLetter(l.ch) || isDigit(l.ch) { // Tanımlayıcılar harf veya alt çizgi ile başlar, sonrasında harf, rakam veya alt çizgi gelebilir
+	for isLetter(l.ch) || isIdentDigit(l.ch) { // Tanımlayıcılar harf veya alt çizgi ile başlar, sonrasında harf, rakam veya alt çizgi gelebilir
 		l.readChar()
 	}
-	return l.input[position:l.position]
+	return l.text(position, l.position)
 }
 
-// readNumber reads a number from the input
-func (l *Lexer) readNumber() string {
+// readNumber reads a number from the input.
+// Go sayı literali sözdizimi kabaca taranır (0x, 0o, 0b önekleri, '_' ayırıcıları,
+// ondalık ve onaltılık üsler); literalin geçerliliği ayrıştırıcıda denetlenir.
+// İkinci dönüş değeri literalin ondalık (FLOAT) olup olmadığını belirtir.
+func (l *Lexer) readNumber() (string, bool) {
 	position := l.position
-	for isDigit(l.ch) {
-		l.readChar()
+	isFloat := false
+	hex := false
+
+	if l.ch == '0' && (l.peekChar() == 'x' || l.peekChar() == 'X') {
+		hex = true
+		l.readChar() // '0'
+		l.readChar() // 'x'
 	}
 
-	// Ondalık sayılar için nokta kontrolü
-	if l.ch == '.' && isDigit(l.peekChar()) {
-		l.readChar() // Noktayı geç
-		for isDigit(l.ch) {
+	for {
+		switch {
+		case isLetter(l.ch) || isDigit(l.ch):
+			exponent := !hex && (l.ch == 'e' || l.ch == 'E') || hex && (l.ch == 'p' || l.ch == 'P')
+			l.readChar()
+			if exponent {
+				isFloat = true
+				if l.ch == '+' || l.ch == '-' {
+					l.readChar()
+				}
+			}
+		case l.ch == '.' && !isFloat:
+			isFloat = true
 			l.readChar()
+		default:
+			return l.text(position, l.position), isFloat
 		}
 	}
+}
 
-	return l.input[position:l.position]
+// readString reads an interpreted string literal from the input.
+// Kaçış dizileri Go kurallarına göre çözülür; \x ve sekizlik kaçışlar ham bayt üretir.
+func (l *Lexer) readString() string {
+	var out strings.Builder
+	for {
+		l.readChar()
+		switch l.ch {
+		case '"':
+			// Bitiş tırnak işaretini atlama - NextToken'da readChar() çağrılacak
+			return out.String()
+		case '\n', 0:
+			l.addErrorAt(l.startLine, l.startColumn, "sonlandırılmamış string literali")
+			return out.String()
+		case '\\':
+			if r, isByte := l.readEscape('"'); isByte {
+				out.WriteByte(byte(r))
+			} else if r >= 0 {
+				out.WriteRune(r)
+			}
+		default:
+			// Geçersiz UTF-8 baytları olduğu gibi korunur
+			out.WriteString(l.text(l.position, l.readPosition))
+		}
+	}
 }
 
-// readString reads a string literal from the input
-func (l *Lexer) readString(delimiter byte) string {
-	position := l.position + 1 // Başlangıç tırnak işaretini atla
+// readRawString reads a backquoted raw string literal from the input.
+// Ham string'ler birden fazla satıra yayılabilir, kaçış dizisi içermez ve
+// Go'da olduğu gibi içindeki '\r' karakterleri atılır.
+func (l *Lexer) readRawString() string {
+	var out strings.Builder
 	for {
 		l.readChar()
-		if l.ch == delimiter || l.ch == 0 {
-			break
+		switch {
+		case l.ch == '`':
+			return out.String()
+		case l.ch == 0:
+			l.addErrorAt(l.startLine, l.startColumn, "sonlandırılmamış ham string literali")
+			return out.String()
+		case l.ch == '\r':
+			continue
+		default:
+			out.WriteString(l.text(l.position, l.readPosition))
+		}
+	}
+}
+
+// readCharLiteral reads a rune literal from the input and returns the decoded rune
+// as its UTF-8 encoding.
+func (l *Lexer) readCharLiteral() string {
+	var runes []rune
+	for {
+		l.readChar()
+		switch l.ch {
+		case '\'':
+			switch len(runes) {
+			case 0:
+				l.addErrorAt(l.startLine, l.startColumn, "boş karakter literali")
+				return ""
+			case 1:
+				return string(runes[0])
+			default:
+				l.addErrorAt(l.startLine, l.startColumn, "karakter literali birden fazla karakter içeriyor")
+				return string(runes)
+			}
+		case '\n', 0:
+			l.addErrorAt(l.startLine, l.startColumn, "sonlandırılmamış karakter literali")
+			return string(runes)
+		case '\\':
+			if r, _ := l.readEscape('\''); r >= 0 {
+				runes = append(runes, r)
+			}
+		default:
+			runes = append(runes, l.ch)
 		}
+	}
+}
+
+// readEscape, '\\' karakterinden sonra gelen kaçış dizisini çözer.
+// Çağrıldığında l.ch ters eğik çizgidir; dönüşte dizinin son karakterindedir.
+// isByte, değerin ham bir bayt (\x, sekizlik) olduğunu belirtir. Hatalı
+// dizilerde -1 döner.
+func (l *Lexer) readEscape(quote rune) (r rune, isByte bool) {
+	line, column := l.line, l.column
+	next := l.peekChar()
 
-		// Kaçış dizileri için
-		if l.ch == '\\' {
-			l.readChar() // Kaçış karakterini atla
+	switch next {
+	case 'a', 'b', 'f', 'n', 'r', 't', 'v', '\\', quote:
+		l.readChar()
+		return simpleEscapes[next], false
+	case '0', '1', '2', '3', '4', '5', '6', '7':
+		value := l.readEscapeDigits(line, column, 3, 8)
+		if value > 255 {
+			l.addErrorAt(line, column, "sekizlik kaçış değeri 255'ten büyük: %d", value)
+			return -1, false
+		}
+		return value, value >= 0
+	case 'x':
+		l.readChar()
+		value := l.readEscapeDigits(line, column, 2, 16)
+		return value, value >= 0
+	case 'u', 'U':
+		l.readChar()
+		n := 4
+		if next == 'U' {
+			n = 8
+		}
+		value := l.readEscapeDigits(line, column, n, 16)
+		if value < 0 {
+			return -1, false
 		}
+		if !utf8.ValidRune(value) {
+			l.addErrorAt(line, column, "kaçış dizisi geçersiz bir Unicode kod noktası: U+%04X", value)
+			return -1, false
+		}
+		return value, false
+	case '\n', 0:
+		l.addErrorAt(line, column, "kaçış dizisi sonlandırılmamış")
+		return -1, false
+	default:
+		l.readChar()
+		l.addErrorAt(line, column, "bilinmeyen kaçış dizisi: \\%c", next)
+		return -1, false
 	}
+}
 
-	if l.ch == 0 {
-		// Sonlandırılmamış string
-		return l.input[position:l.position]
+// readEscapeDigits, verilen tabanda tam olarak n rakam okur.
+// Rakamlar tükenirse hata raporlanır ve -1 döner; okunmayan karakter tüketilmez.
+func (l *Lexer) readEscapeDigits(line, column, n, base int) rune {
+	var value rune
+	for i := 0; i < n; i++ {
+		d := digitVal(l.peekChar())
+		if d >= base {
+			l.addErrorAt(line, column, "kaçış dizisinde geçersiz karakter: %q", l.peekChar())
+			return -1
+		}
+		l.readChar()
+		value = value*rune(base) + rune(d)
 	}
+	return value
+}
 
-	result := l.input[position:l.position]
-	// Bitiş tırnak işaretini atlama - NextToken'da readChar() çağrılacak
-	return result
+// simpleEscapes, tek karakterlik kaçış dizilerinin değerlerini tutar.
+var simpleEscapes = map[rune]rune{
+	'a': '\a', 'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t', 'v': '\v',
+	'\\': '\\', '\'': '\'', '"': '"',
+}
+
+// digitVal, bir onaltılık rakamın sayısal değerini döndürür (rakam değilse 16).
+func digitVal(ch rune) int {
+	switch {
+	case '0' <= ch && ch <= '9':
+		return int(ch - '0')
+	case 'a' <= ch && ch <= 'f':
+		return int(ch - 'a' + 10)
+	case 'A' <= ch && ch <= 'F':
+		return int(ch - 'A' + 10)
+	}
+	return 16
 }
 
-// skipWhitespace skips whitespace characters
+// skipWhitespace skips whitespace characters.
+// Otomatik noktalı virgül beklenirken satır sonu atlanmaz.
 func (l *Lexer) skipWhitespace() {
-	for isWhitespace(l.ch) {
+	for isWhitespace(l.ch) && !(l.ch == '\n' && l.insertSemi) {
 		l.readChar()
 	}
 }
 
-// skipComment skips a comment
-func (l *Lexer) skipComment() {
+// readComment reads a comment and returns its text including the comment markers.
+func (l *Lexer) readComment() string {
+	position := l.position
 	if l.ch == '/' && l.peekChar() == '/' {
 		// Tek satırlık yorum
 		for l.ch != '\n' && l.ch != 0 {
@@ -151,8 +558,11 @@ func (l *Lexer) skipComment() {
 		if l.ch != 0 {
 			l.readChar() // '*' karakterini atla
 			l.readChar() // '/' karakterini atla
+		} else {
+			l.addErrorAt(l.startLine, l.startColumn, "sonlandırılmamış yorum")
 		}
 	}
+	return strings.TrimSuffix(l.text(position, l.position), "\r")
 }
 
 // newToken creates a new token
@@ -162,26 +572,115 @@ func (l *Lexer) newToken(tokenType token.TokenType, literal string) token.Token
 		Literal: literal,
 		Line:    l.startLine,
 		Column:  l.startColumn,
-		Pos:     l.startPos,
-		End:     l.position,
+		Pos:     l.file.Pos(l.startPos),
+		End:     l.file.Pos(l.position),
+		Position: token.Position{
+			Filename: l.file.Name(),
+			Line:     l.startLine,
+			Column:   l.startColumn,
+			Offset:   l.startPos,
+		},
 	}
 }
 
 // NextToken returns the next token from the input.
+// Go'daki gibi, satırın son token'ı bir tanımlayıcı, literal, break, continue,
+// fallthrough, return, ++, --, ), ] veya } ise satır sonunda (ya da dosya
+// sonunda) otomatik olarak bir noktalı virgül üretilir. Bu token'ın literali "\n"dir.
 func (l *Lexer) NextToken() token.Token {
-	l.skipWhitespace()
+	for {
+		l.skipWhitespace()
+
+		// Token başlangıç pozisyonunu kaydet
+		l.startPos = l.position
+		l.startLine = l.line
+		l.startColumn = l.column
+
+		// Otomatik noktalı virgül ekleme
+		if l.insertSemi && l.atLineEnd() {
+			l.insertSemi = false
+			tok := l.newToken(token.SEMICOLON, "\n")
+			if l.ch == '\n' {
+				l.readChar()
+			}
+			return tok
+		}
 
-	// Token başlangıç pozisyonunu kaydet
-	l.startPos = l.position
-	l.startLine = l.line
-	l.startColumn = l.column
+		// Yorum kontrolü; ScanComments modunda yorumlar token olarak döner.
+		// Yorumlar otomatik noktalı virgül durumunu değiştirmez.
+		if l.ch == '/' && (l.peekChar() == '/' || l.peekChar() == '*') {
+			literal := l.readComment()
+			if l.mode&ScanComments != 0 {
+				return l.newToken(token.COMMENT, literal)
+			}
+			continue
+		}
+		break
+	}
 
-	// Yorum kontrolü
-	if l.ch == '/' && (l.peekChar() == '/' || l.peekChar() == '*') {
-		l.skipComment()
-		return l.NextToken()
+	tok := l.scanToken()
+	l.insertSemi = insertsSemicolon(tok.Type)
+	return tok
+}
+
+// insertsSemicolon, satır sonunda kendisinden sonra otomatik noktalı virgül
+// eklenmesine yol açan token türlerini belirler.
+func insertsSemicolon(t token.TokenType) bool {
+	switch t {
+	case token.IDENT, token.INT, token.FLOAT, token.IMAG, token.CHAR, token.STRING,
+		token.TRUE, token.FALSE, token.NULL, token.THIS, token.SUPER,
+		token.BREAK, token.CONTINUE, token.FALLTHROUGH, token.RETURN,
+		token.INCREMENT, token.DECREMENT,
+		token.RPAREN, token.RBRACKET, token.RBRACE:
+		return true
 	}
+	return false
+}
 
+// atLineEnd, geçerli konumdan satır sonuna (veya dosya sonuna) kadar yalnızca
+// boşluk ve yorum bulunup bulunmadığını kontrol eder. Satır sonu içeren bir
+// blok yorum da satır sonu sayılır.
+func (l *Lexer) atLineEnd() bool {
+	for i := l.position; ; {
+		ch, ok := l.byteAt(i)
+		if !ok {
+			return true
+		}
+		switch ch {
+		case '\n':
+			return true
+		case ' ', '\t', '\r':
+			i++
+		case '/':
+			next, _ := l.byteAt(i + 1)
+			if next == '/' {
+				return true
+			}
+			if next != '*' {
+				return false
+			}
+			// Blok yorumun sonuna kadar ilerle; yorum satır sonu içeriyorsa
+			// veya sonlandırılmamışsa satır sonu sayılır
+			for i += 2; ; i++ {
+				c, ok := l.byteAt(i)
+				if !ok || c == '\n' {
+					return true
+				}
+				if c == '*' {
+					if n, _ := l.byteAt(i + 1); n == '/' {
+						i += 2
+						break
+					}
+				}
+			}
+		default:
+			return false
+		}
+	}
+}
+
+// scanToken, boşluk ve yorumlar atlandıktan sonra bir sonraki token'ı tarar.
+func (l *Lexer) scanToken() token.Token {
 	var tok token.Token
 
 	switch l.ch {
@@ -256,6 +755,9 @@ func (l *Lexer) NextToken() token.Token {
 			} else {
 				tok = l.newToken(token.LEFT_SHIFT, "<<")
 			}
+		} else if l.peekChar() == '-' {
+			l.readChar()
+			tok = l.newToken(token.LARROW, "<-") // Kanal gönderme/alma operatörü
 		} else {
 			tok = l.newToken(token.LT, "<")
 		}
@@ -281,6 +783,14 @@ func (l *Lexer) NextToken() token.Token {
 		} else if l.peekChar() == '=' {
 			l.readChar()
 			tok = l.newToken(token.AND_ASSIGN, "&=")
+		} else if l.peekChar() == '^' {
+			l.readChar()
+			if l.peekChar() == '=' {
+				l.readChar()
+				tok = l.newToken(token.AND_NOT_ASSIGN, "&^=")
+			} else {
+				tok = l.newToken(token.AND_NOT, "&^")
+			}
 		} else {
 			tok = l.newToken(token.BIT_AND, "&")
 		}
@@ -320,6 +830,18 @@ func (l *Lexer) NextToken() token.Token {
 	case ';':
 		tok = l.newToken(token.SEMICOLON, ";")
 	case '.':
+		if isDigit(l.peekChar()) {
+			// .5 gibi tam kısmı olmayan ondalık sayı
+			return l.readNumberToken()
+		}
+		if l.peekChar() == '.' {
+			if b, _ := l.byteAt(l.readPosition + 1); b == '.' {
+				l.readChar()
+				l.readChar()
+				tok = l.newToken(token.ELLIPSIS, "...")
+				break
+			}
+		}
 		tok = l.newToken(token.DOT, ".")
 	case '(':
 		tok = l.newToken(token.LPAREN, "(")
@@ -336,13 +858,13 @@ func (l *Lexer) NextToken() token.Token {
 
 	// String ve karakter literalleri
 	case '"':
-		literal := l.readString('"')
+		literal := l.readString()
 		tok = l.newToken(token.STRING, literal)
 	case '\'':
-		literal := l.readString('\'')
+		literal := l.readCharLiteral()
 		tok = l.newToken(token.CHAR, literal)
 	case '`':
-		literal := l.readString('`')
+		literal := l.readRawString()
 		tok = l.newToken(token.STRING, literal)
 
 	case 0:
@@ -355,14 +877,10 @@ func (l *Lexer) NextToken() token.Token {
 			return l.newToken(tokenType, literal)
 		} else if isDigit(l.ch) {
 			// Sayı literali
-			literal := l.readNumber()
-			// Basit bir kontrol: eğer nokta içeriyorsa FLOAT, değilse INT
-			if contains(literal, '.') {
-				return l.newToken(token.FLOAT, literal)
-			}
-			return l.newToken(token.INT, literal)
+			return l.readNumberToken()
 		} else {
-			tok = l.newToken(token.ILLEGAL, string(l.ch))
+			// Geçersiz UTF-8 baytları için ham baytı koru
+			tok = l.newToken(token.ILLEGAL, l.text(l.position, l.readPosition))
 		}
 	}
 
@@ -370,12 +888,15 @@ func (l *Lexer) NextToken() token.Token {
 	return tok
 }
 
-// contains checks if a string contains a specific character
-func contains(s string, c byte) bool {
-	for i := 0; i < len(s); i++ {
-		if s[i] == c {
-			return true
-		}
+// readNumberToken, bir sayı literalini okuyup INT, FLOAT veya IMAG token'ı
+// üretir. 'i' ile biten literaller (2i, 1.5i) sanal sayılardır.
+func (l *Lexer) readNumberToken() token.Token {
+	literal, isFloat := l.readNumber()
+	if strings.HasSuffix(literal, "i") {
+		return l.newToken(token.IMAG, literal)
 	}
-	return false
+	if isFloat {
+		return l.newToken(token.FLOAT, literal)
+	}
+	return l.newToken(token.INT, literal)
 }
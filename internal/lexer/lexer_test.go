@@ -1,7 +1,9 @@
 package lexer
 
 import (
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/inkbytefo/go-minus/internal/testutil"
 	"github.com/inkbytefo/go-minus/internal/token"
@@ -46,6 +48,7 @@ func TestNextToken(t *testing.T) {
 				testutil.CreateTestToken(token.IDENT, "foobar", 1, 1),
 				testutil.CreateTestToken(token.INT, "123", 1, 8),
 				testutil.CreateTestToken(token.FLOAT, "456.789", 1, 12),
+				testutil.CreateTestToken(token.SEMICOLON, "\n", 1, 19),
 				testutil.CreateTestToken(token.EOF, "", 1, 20),
 			},
 		},
@@ -55,6 +58,7 @@ func TestNextToken(t *testing.T) {
 			Expected: []token.Token{
 				testutil.CreateTestToken(token.STRING, "hello world", 1, 1),
 				testutil.CreateTestToken(token.CHAR, "c", 1, 15),
+				testutil.CreateTestToken(token.SEMICOLON, "\n", 1, 18),
 				testutil.CreateTestToken(token.EOF, "", 1, 18),
 			},
 		},
@@ -121,9 +125,138 @@ func TestNextToken(t *testing.T) {
 				testutil.CreateTestToken(token.TEMPLATE, "template", 1, 1),
 				testutil.CreateTestToken(token.THIS, "this", 1, 10),
 				testutil.CreateTestToken(token.SUPER, "super", 1, 15),
+				testutil.CreateTestToken(token.SEMICOLON, "\n", 1, 20),
 				testutil.CreateTestToken(token.EOF, "", 1, 21),
 			},
 		},
+		{
+			Name:  "Number literals",
+			Input: "0x1F 0o17 0b1010 1_000_000 1e-9 .5 0x1.8p-3 0b102 x.y",
+			Expected: []token.Token{
+				testutil.CreateTestToken(token.INT, "0x1F", 1, 1),
+				testutil.CreateTestToken(token.INT, "0o17", 1, 6),
+				testutil.CreateTestToken(token.INT, "0b1010", 1, 11),
+				testutil.CreateTestToken(token.INT, "1_000_000", 1, 18),
+				testutil.CreateTestToken(token.FLOAT, "1e-9", 1, 28),
+				testutil.CreateTestToken(token.FLOAT, ".5", 1, 33),
+				testutil.CreateTestToken(token.FLOAT, "0x1.8p-3", 1, 36),
+				testutil.CreateTestToken(token.INT, "0b102", 1, 45),
+				testutil.CreateTestToken(token.IDENT, "x", 1, 51),
+				testutil.CreateTestToken(token.DOT, ".", 1, 52),
+				testutil.CreateTestToken(token.IDENT, "y", 1, 53),
+				testutil.CreateTestToken(token.SEMICOLON, "\n", 1, 54),
+				testutil.CreateTestToken(token.EOF, "", 1, 54),
+			},
+		},
+		{
+			Name:  "Imaginary literals",
+			Input: "2i 1.5i 0x1p-2i i",
+			Expected: []token.Token{
+				testutil.CreateTestToken(token.IMAG, "2i", 1, 1),
+				testutil.CreateTestToken(token.IMAG, "1.5i", 1, 4),
+				testutil.CreateTestToken(token.IMAG, "0x1p-2i", 1, 9),
+				testutil.CreateTestToken(token.IDENT, "i", 1, 17),
+				testutil.CreateTestToken(token.SEMICOLON, "\n", 1, 18),
+				testutil.CreateTestToken(token.EOF, "", 1, 18),
+			},
+		},
+		{
+			Name:  "Unicode identifiers",
+			Input: "sayı ölçüm_2 değer٣ π",
+			Expected: []token.Token{
+				testutil.CreateTestToken(token.IDENT, "sayı", 1, 1),
+				testutil.CreateTestToken(token.IDENT, "ölçüm_2", 1, 6),
+				testutil.CreateTestToken(token.IDENT, "değer٣", 1, 14),
+				testutil.CreateTestToken(token.IDENT, "π", 1, 21),
+				testutil.CreateTestToken(token.SEMICOLON, "\n", 1, 22),
+				testutil.CreateTestToken(token.EOF, "", 1, 22),
+			},
+		},
+		{
+			Name:  "Multi-byte char literal",
+			Input: "'ç' \"ğüşİ\"",
+			Expected: []token.Token{
+				testutil.CreateTestToken(token.CHAR, "ç", 1, 1),
+				testutil.CreateTestToken(token.STRING, "ğüşİ", 1, 5),
+				testutil.CreateTestToken(token.SEMICOLON, "\n", 1, 11),
+				testutil.CreateTestToken(token.EOF, "", 1, 11),
+			},
+		},
+		{
+			Name:  "Channel and variadic operators",
+			Input: "c.ch <- v; x := <-c; f(args...); a.b",
+			Expected: []token.Token{
+				testutil.CreateTestToken(token.IDENT, "c", 1, 1),
+				testutil.CreateTestToken(token.DOT, ".", 1, 2),
+				testutil.CreateTestToken(token.IDENT, "ch", 1, 3),
+				testutil.CreateTestToken(token.LARROW, "<-", 1, 6),
+				testutil.CreateTestToken(token.IDENT, "v", 1, 9),
+				testutil.CreateTestToken(token.SEMICOLON, ";", 1, 10),
+				testutil.CreateTestToken(token.IDENT, "x", 1, 12),
+				testutil.CreateTestToken(token.DEFINE, ":=", 1, 14),
+				testutil.CreateTestToken(token.LARROW, "<-", 1, 17),
+				testutil.CreateTestToken(token.IDENT, "c", 1, 19),
+				testutil.CreateTestToken(token.SEMICOLON, ";", 1, 20),
+				testutil.CreateTestToken(token.IDENT, "f", 1, 22),
+				testutil.CreateTestToken(token.LPAREN, "(", 1, 23),
+				testutil.CreateTestToken(token.IDENT, "args", 1, 24),
+				testutil.CreateTestToken(token.ELLIPSIS, "...", 1, 28),
+				testutil.CreateTestToken(token.RPAREN, ")", 1, 31),
+				testutil.CreateTestToken(token.SEMICOLON, ";", 1, 32),
+				testutil.CreateTestToken(token.IDENT, "a", 1, 34),
+				testutil.CreateTestToken(token.DOT, ".", 1, 35),
+				testutil.CreateTestToken(token.IDENT, "b", 1, 36),
+			},
+		},
+		{
+			Name:  "Bitwise and shift operators",
+			Input: "a &^ b &^= c & d &= e | f |= g ^ h ^= i << j <<= k >> l >>= m ~n",
+			Expected: []token.Token{
+				testutil.CreateTestToken(token.IDENT, "a", 1, 1),
+				testutil.CreateTestToken(token.AND_NOT, "&^", 1, 3),
+				testutil.CreateTestToken(token.IDENT, "b", 1, 6),
+				testutil.CreateTestToken(token.AND_NOT_ASSIGN, "&^=", 1, 8),
+				testutil.CreateTestToken(token.IDENT, "c", 1, 12),
+				testutil.CreateTestToken(token.BIT_AND, "&", 1, 14),
+				testutil.CreateTestToken(token.IDENT, "d", 1, 16),
+				testutil.CreateTestToken(token.AND_ASSIGN, "&=", 1, 18),
+				testutil.CreateTestToken(token.IDENT, "e", 1, 21),
+				testutil.CreateTestToken(token.BIT_OR, "|", 1, 23),
+				testutil.CreateTestToken(token.IDENT, "f", 1, 25),
+				testutil.CreateTestToken(token.OR_ASSIGN, "|=", 1, 27),
+				testutil.CreateTestToken(token.IDENT, "g", 1, 30),
+				testutil.CreateTestToken(token.BIT_XOR, "^", 1, 32),
+				testutil.CreateTestToken(token.IDENT, "h", 1, 34),
+				testutil.CreateTestToken(token.XOR_ASSIGN, "^=", 1, 36),
+				testutil.CreateTestToken(token.IDENT, "i", 1, 39),
+				testutil.CreateTestToken(token.LEFT_SHIFT, "<<", 1, 41),
+				testutil.CreateTestToken(token.IDENT, "j", 1, 44),
+				testutil.CreateTestToken(token.LEFT_SHIFT_ASSIGN, "<<=", 1, 46),
+				testutil.CreateTestToken(token.IDENT, "k", 1, 50),
+				testutil.CreateTestToken(token.RIGHT_SHIFT, ">>", 1, 52),
+				testutil.CreateTestToken(token.IDENT, "l", 1, 55),
+				testutil.CreateTestToken(token.RIGHT_SHIFT_ASSIGN, ">>=", 1, 57),
+				testutil.CreateTestToken(token.IDENT, "m", 1, 61),
+				testutil.CreateTestToken(token.BIT_NOT, "~", 1, 63),
+				testutil.CreateTestToken(token.IDENT, "n", 1, 64),
+			},
+		},
+		{
+			Name:  "Labels",
+			Input: "Dongu:\n\tfor {\n\t\tbreak Dongu\n\t}",
+			Expected: []token.Token{
+				testutil.CreateTestToken(token.IDENT, "Dongu", 1, 1),
+				testutil.CreateTestToken(token.COLON, ":", 1, 6),
+				testutil.CreateTestToken(token.FOR, "for", 2, 2),
+				testutil.CreateTestToken(token.LBRACE, "{", 2, 6),
+				testutil.CreateTestToken(token.BREAK, "break", 3, 3),
+				testutil.CreateTestToken(token.IDENT, "Dongu", 3, 9),
+				testutil.CreateTestToken(token.SEMICOLON, "\n", 3, 14),
+				testutil.CreateTestToken(token.RBRACE, "}", 4, 2),
+				testutil.CreateTestToken(token.SEMICOLON, "\n", 4, 3),
+				testutil.CreateTestToken(token.EOF, "", 4, 3),
+			},
+		},
 	}
 
 	// Run tests manually to avoid import cycle
@@ -187,6 +320,44 @@ func TestNextTokenWithComments(t *testing.T) {
 	}
 }
 
+func TestScanComments(t *testing.T) {
+	input := `// Belge yorumu
+var x = 5 // satır sonu
+/* çok
+satırlı */ var y`
+
+	expected := []token.Token{
+		testutil.CreateTestToken(token.COMMENT, "// Belge yorumu", 1, 1),
+		testutil.CreateTestToken(token.VAR, "var", 2, 1),
+		testutil.CreateTestToken(token.IDENT, "x", 2, 5),
+		testutil.CreateTestToken(token.ASSIGN, "=", 2, 7),
+		testutil.CreateTestToken(token.INT, "5", 2, 9),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 2, 11),
+		testutil.CreateTestToken(token.COMMENT, "// satır sonu", 2, 11),
+		testutil.CreateTestToken(token.COMMENT, "/* çok\nsatırlı */", 3, 1),
+		testutil.CreateTestToken(token.VAR, "var", 4, 12),
+		testutil.CreateTestToken(token.IDENT, "y", 4, 16),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 4, 17),
+		testutil.CreateTestToken(token.EOF, "", 4, 17),
+	}
+
+	l := NewWithMode(input, ScanComments)
+	for i, expectedToken := range expected {
+		tok := l.NextToken()
+
+		if tok.Type != expectedToken.Type || tok.Literal != expectedToken.Literal {
+			t.Errorf("Token %d: expected %q %q, got %q %q", i, expectedToken.Type, expectedToken.Literal, tok.Type, tok.Literal)
+		}
+
+		if tok.Line != expectedToken.Line || tok.Column != expectedToken.Column {
+			t.Errorf("Token %d (%q): expected position %d:%d, got %d:%d", i, tok.Literal,
+				expectedToken.Line, expectedToken.Column, tok.Line, tok.Column)
+		}
+	}
+
+	testutil.AssertNoErrors(t, l.Errors())
+}
+
 func TestNextTokenWithWhitespace(t *testing.T) {
 	input := "   var    x   =   5   ;   "
 
@@ -237,6 +408,348 @@ func TestIllegalTokens(t *testing.T) {
 	}
 }
 
+func TestUnicodeColumns(t *testing.T) {
+	input := "var ağaç = \"çöp\"\nşeker := ağaç"
+
+	expected := []token.Token{
+		testutil.CreateTestToken(token.VAR, "var", 1, 1),
+		testutil.CreateTestToken(token.IDENT, "ağaç", 1, 5),
+		testutil.CreateTestToken(token.ASSIGN, "=", 1, 10),
+		testutil.CreateTestToken(token.STRING, "çöp", 1, 12),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 1, 17),
+		testutil.CreateTestToken(token.IDENT, "şeker", 2, 1),
+		testutil.CreateTestToken(token.DEFINE, ":=", 2, 7),
+		testutil.CreateTestToken(token.IDENT, "ağaç", 2, 10),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 2, 14),
+		testutil.CreateTestToken(token.EOF, "", 2, 14),
+	}
+
+	l := New(input)
+	for i, expectedToken := range expected {
+		tok := l.NextToken()
+
+		if tok.Type != expectedToken.Type || tok.Literal != expectedToken.Literal {
+			t.Errorf("Token %d: expected %q %q, got %q %q", i, expectedToken.Type, expectedToken.Literal, tok.Type, tok.Literal)
+		}
+
+		if tok.Position.Line != expectedToken.Line || tok.Position.Column != expectedToken.Column {
+			t.Errorf("Token %d (%q): expected position %d:%d, got %d:%d", i, tok.Literal,
+				expectedToken.Line, expectedToken.Column, tok.Position.Line, tok.Position.Column)
+		}
+	}
+
+	testutil.AssertNoErrors(t, l.Errors())
+}
+
+func TestInvalidUTF8(t *testing.T) {
+	input := "x := \xff\ny"
+
+	expected := []token.Token{
+		testutil.CreateTestToken(token.IDENT, "x", 1, 1),
+		testutil.CreateTestToken(token.DEFINE, ":=", 1, 3),
+		testutil.CreateTestToken(token.ILLEGAL, "\xff", 1, 6),
+		testutil.CreateTestToken(token.IDENT, "y", 2, 1),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 2, 2),
+		testutil.CreateTestToken(token.EOF, "", 2, 2),
+	}
+
+	l := New(input)
+	for i, expectedToken := range expected {
+		tok := l.NextToken()
+
+		if tok.Type != expectedToken.Type {
+			t.Errorf("Token %d: expected type %q, got %q", i, expectedToken.Type, tok.Type)
+		}
+
+		if tok.Literal != expectedToken.Literal {
+			t.Errorf("Token %d: expected literal %q, got %q", i, expectedToken.Literal, tok.Literal)
+		}
+	}
+
+	testutil.AssertHasErrors(t, l.Errors(), 1)
+	testutil.AssertErrorContains(t, l.Errors(), "1:6: geçersiz UTF-8 kodlaması: 0xFF baytı (ofset 5)")
+}
+
+func TestStringEscapes(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected token.Token
+	}{
+		{`"a\nb\t"`, testutil.CreateTestToken(token.STRING, "a\nb\t", 1, 1)},
+		{`"\x41\101\u00e7\U0001F600"`, testutil.CreateTestToken(token.STRING, "AAç😀", 1, 1)},
+		{`"\xff"`, testutil.CreateTestToken(token.STRING, "\xff", 1, 1)},
+		{`"çay \"demli\""`, testutil.CreateTestToken(token.STRING, "çay \"demli\"", 1, 1)},
+		{`'\t'`, testutil.CreateTestToken(token.CHAR, "\t", 1, 1)},
+		{`'\''`, testutil.CreateTestToken(token.CHAR, "'", 1, 1)},
+		{`'\u011f'`, testutil.CreateTestToken(token.CHAR, "ğ", 1, 1)},
+		{`'\377'`, testutil.CreateTestToken(token.CHAR, "\u00ff", 1, 1)},
+		{"`satır 1\n\\n satır\r 2`", testutil.CreateTestToken(token.STRING, "satır 1\n\\n satır 2", 1, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			l := New(tt.input)
+			tok := l.NextToken()
+
+			if tok.Type != tt.expected.Type || tok.Literal != tt.expected.Literal {
+				t.Errorf("expected %q %q, got %q %q", tt.expected.Type, tt.expected.Literal, tok.Type, tok.Literal)
+			}
+
+			testutil.AssertNoErrors(t, l.Errors())
+
+			if next := l.NextToken(); next.Type != token.SEMICOLON {
+				t.Errorf("expected automatic semicolon after literal, got %q %q", next.Type, next.Literal)
+			}
+		})
+	}
+}
+
+func TestMalformedStringLiterals(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`"açık`, "1:1: sonlandırılmamış string literali"},
+		{"x = \"satır\nsonu\"", "1:5: sonlandırılmamış string literali"},
+		{"`ham", "1:1: sonlandırılmamış ham string literali"},
+		{`'a`, "1:1: sonlandırılmamış karakter literali"},
+		{`''`, "1:1: boş karakter literali"},
+		{`'ab'`, "1:1: karakter literali birden fazla karakter içeriyor"},
+		{`"a\q"`, "1:3: bilinmeyen kaçış dizisi: \\q"},
+		{`"\x4"`, "kaçış dizisinde geçersiz karakter"},
+		{`"\400"`, "sekizlik kaçış değeri 255'ten büyük"},
+		{`"\uD800"`, "geçersiz bir Unicode kod noktası"},
+		{`"\'"`, "bilinmeyen kaçış dizisi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			l := New(tt.input)
+			for tok := l.NextToken(); tok.Type != token.EOF; tok = l.NextToken() {
+			}
+
+			testutil.AssertErrorContains(t, l.Errors(), tt.expected)
+		})
+	}
+}
+
+func TestAutomaticSemicolonInsertion(t *testing.T) {
+	input := `x := f(a,
+	b)
+return
+y++ // yorum
+z /* tek satır */ + 1
+} /* çok
+satırlı */ w
+`
+
+	expected := []token.Token{
+		testutil.CreateTestToken(token.IDENT, "x", 1, 1),
+		testutil.CreateTestToken(token.DEFINE, ":=", 1, 3),
+		testutil.CreateTestToken(token.IDENT, "f", 1, 6),
+		testutil.CreateTestToken(token.LPAREN, "(", 1, 7),
+		testutil.CreateTestToken(token.IDENT, "a", 1, 8),
+		testutil.CreateTestToken(token.COMMA, ",", 1, 9),
+		testutil.CreateTestToken(token.IDENT, "b", 2, 2),
+		testutil.CreateTestToken(token.RPAREN, ")", 2, 3),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 2, 4),
+		testutil.CreateTestToken(token.RETURN, "return", 3, 1),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 3, 7),
+		testutil.CreateTestToken(token.IDENT, "y", 4, 1),
+		testutil.CreateTestToken(token.INCREMENT, "++", 4, 2),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 4, 5),
+		testutil.CreateTestToken(token.IDENT, "z", 5, 1),
+		testutil.CreateTestToken(token.PLUS, "+", 5, 19),
+		testutil.CreateTestToken(token.INT, "1", 5, 21),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 5, 22),
+		testutil.CreateTestToken(token.RBRACE, "}", 6, 1),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 6, 3),
+		testutil.CreateTestToken(token.IDENT, "w", 7, 12),
+		testutil.CreateTestToken(token.SEMICOLON, "\n", 7, 13),
+		testutil.CreateTestToken(token.EOF, "", 8, 1),
+	}
+
+	l := New(input)
+	for i, expectedToken := range expected {
+		tok := l.NextToken()
+
+		if tok.Type != expectedToken.Type || tok.Literal != expectedToken.Literal {
+			t.Errorf("Token %d: expected %q %q, got %q %q", i, expectedToken.Type, expectedToken.Literal, tok.Type, tok.Literal)
+		}
+
+		if tok.Line != expectedToken.Line || tok.Column != expectedToken.Column {
+			t.Errorf("Token %d (%q): expected position %d:%d, got %d:%d", i, tok.Literal,
+				expectedToken.Line, expectedToken.Column, tok.Line, tok.Column)
+		}
+	}
+}
+
+func TestByteOrderMark(t *testing.T) {
+	l := New("\uFEFFvar")
+
+	tok := l.NextToken()
+	if tok.Type != token.VAR || tok.Position.Column != 1 || tok.Position.Offset != 3 {
+		t.Errorf("expected VAR at column 1, offset 3; got %q at column %d, offset %d",
+			tok.Type, tok.Position.Column, tok.Position.Offset)
+	}
+
+	testutil.AssertNoErrors(t, l.Errors())
+}
+
+func TestFileSetPositions(t *testing.T) {
+	fset := token.NewFileSet()
+	srcA := "var a = 1\nvar ğ = \"açık\n"
+	srcB := "\n  b := 2\n"
+	fileA := fset.AddFile("a.gom", -1, len(srcA))
+	fileB := fset.AddFile("b.gom", -1, len(srcB))
+
+	la := NewFile(fileA, srcA, 0)
+	var tokens []token.Token
+	for tok := la.NextToken(); tok.Type != token.EOF; tok = la.NextToken() {
+		tokens = append(tokens, tok)
+	}
+	lb := NewFile(fileB, srcB, 0)
+	tokB := lb.NextToken()
+
+	tests := []struct {
+		pos      token.Pos
+		expected string
+	}{
+		{tokens[0].Pos, "a.gom:1:1"},
+		{tokens[6].Pos, "a.gom:2:5"}, // ğ
+		{tokens[7].Pos, "a.gom:2:7"}, // =
+		{tokB.Pos, "b.gom:2:3"},      // b
+		{tokB.End, "b.gom:2:4"},      // b sonrası
+		{token.NoPos, "-"},
+	}
+	for _, tt := range tests {
+		if got := fset.Position(tt.pos).String(); got != tt.expected {
+			t.Errorf("Position(%d): expected %q, got %q", tt.pos, tt.expected, got)
+		}
+	}
+
+	if tokB.Position.String() != "b.gom:2:3" {
+		t.Errorf("expected token position b.gom:2:3, got %s", tokB.Position)
+	}
+	if fileA.LineCount() != 3 || fileB.LineCount() != 3 {
+		t.Errorf("expected 3 lines per file, got %d and %d", fileA.LineCount(), fileB.LineCount())
+	}
+
+	testutil.AssertErrorContains(t, la.Errors(), "a.gom:2:9: sonlandırılmamış string literali")
+}
+
+// readerTestInput, akış testlerinde kullanılan ve okuma parçasından
+// (readChunkSize) büyük bir girdi üretir.
+func readerTestInput() string {
+	var sb strings.Builder
+	sb.WriteString("\uFEFFpackage main\n")
+	for i := 0; i < 300; i++ {
+		sb.WriteString("// yorum satırı\nvar değer = \"çok baytlı \\u00e7\" /* blok\nyorum */ + 0x1F\n")
+	}
+	sb.WriteString("x := 'ğ' + `ham\nstring`")
+	return sb.String()
+}
+
+func TestNewReader(t *testing.T) {
+	input := readerTestInput()
+	if len(input) <= readChunkSize {
+		t.Fatalf("test input too small: %d bytes", len(input))
+	}
+
+	expected := New(input)
+	fset := token.NewFileSet()
+	file := fset.AddFile("akis.gom", -1, len(input))
+	l := NewReader(file, iotest.OneByteReader(strings.NewReader(input)), 0)
+
+	for i := 0; ; i++ {
+		want, got := expected.NextToken(), l.NextToken()
+		if got.Type != want.Type || got.Literal != want.Literal ||
+			got.Line != want.Line || got.Column != want.Column || got.Pos != want.Pos {
+			t.Fatalf("Token %d: expected %q %q at %d:%d, got %q %q at %d:%d", i,
+				want.Type, want.Literal, want.Line, want.Column,
+				got.Type, got.Literal, got.Line, got.Column)
+		}
+		if want.Type == token.EOF {
+			break
+		}
+	}
+
+	testutil.AssertNoErrors(t, l.Errors())
+	if len(l.input) > 2*readChunkSize {
+		t.Errorf("expected a bounded buffer, got %d bytes", len(l.input))
+	}
+	if file.LineCount() != strings.Count(input, "\n")+1 {
+		t.Errorf("expected %d lines, got %d", strings.Count(input, "\n")+1, file.LineCount())
+	}
+}
+
+func TestSeek(t *testing.T) {
+	input := readerTestInput()
+
+	// Tüm token'ları bir kez tara
+	var tokens []token.Token
+	for l := New(input); ; {
+		tok := l.NextToken()
+		tokens = append(tokens, tok)
+		if tok.Type == token.EOF {
+			break
+		}
+	}
+
+	fset := token.NewFileSet()
+	file := fset.AddFile("akis.gom", -1, len(input))
+	lexers := map[string]*Lexer{
+		"string": New(input),
+		"reader": NewReader(file, strings.NewReader(input), 0),
+	}
+
+	for name, l := range lexers {
+		t.Run(name, func(t *testing.T) {
+			// Sona kadar tara, sonra geriye ve ileriye konumlan
+			for l.NextToken().Type != token.EOF {
+			}
+
+			for _, i := range []int{len(tokens) / 2, 1, len(tokens) - 3} {
+				want := tokens[i]
+				if err := l.Seek(want.Position.Offset); err != nil {
+					t.Fatalf("Seek(%d) failed: %v", want.Position.Offset, err)
+				}
+				got := l.NextToken()
+				if got.Type != want.Type || got.Literal != want.Literal ||
+					got.Line != want.Line || got.Column != want.Column {
+					t.Errorf("after Seek(%d): expected %q %q at %d:%d, got %q %q at %d:%d",
+						want.Position.Offset, want.Type, want.Literal, want.Line, want.Column,
+						got.Type, got.Literal, got.Line, got.Column)
+				}
+			}
+			testutil.AssertNoErrors(t, l.Errors())
+		})
+	}
+
+	// Konumlandırılamayan bir okuyucu arabellekten önceki bir offset'e dönemez
+	l := NewReader(fset.AddFile("tek.gom", -1, len(input)), iotest.OneByteReader(strings.NewReader(input)), 0)
+	for l.NextToken().Type != token.EOF {
+	}
+	if err := l.Seek(0); err == nil {
+		t.Errorf("expected Seek(0) on a non-seekable reader to fail")
+	}
+}
+
+func TestSeekDropsErrors(t *testing.T) {
+	l := New("var a = 1\nvar b = \"açık\n")
+	for l.NextToken().Type != token.EOF {
+	}
+	testutil.AssertHasErrors(t, l.Errors(), 1)
+
+	// İkinci satırın yeniden taranması hatayı bir kez daha raporlamamalı
+	if err := l.Seek(10); err != nil {
+		t.Fatalf("Seek failed: %v", err)
+	}
+	for l.NextToken().Type != token.EOF {
+	}
+	testutil.AssertHasErrors(t, l.Errors(), 1)
+}
+
 func BenchmarkLexer(b *testing.B) {
 	input := `
 	package main
@@ -7,7 +7,7 @@ import (
 
 // parseClassStatement, bir sınıf tanımını ayrıştırır.
 func (p *Parser) parseClassStatement() *ast.ClassStatement {
-	stmt := &ast.ClassStatement{Token: p.curToken}
+	stmt := &ast.ClassStatement{Token: p.curToken, Doc: p.curDoc}
 
 	// Sınıf adı
 	if !p.expectPeek(token.IDENT) {
@@ -205,8 +205,13 @@ func (p *Parser) parseClassBody() *ast.BlockStatement {
 			// Erişim belirleyicisi için bir alan eklenebilir
 			// stmt.AccessModifier = accessModifier
 			body.Statements = append(body.Statements, stmt)
+		} else if p.curTokenIs(token.FUNC) && p.peekTokenIs(token.IDENT) {
+			// Metotlar: func Ad(...) dönüş { ... }
+			stmt := p.parseFunctionStatement()
+			if stmt != nil {
+				body.Statements = append(body.Statements, stmt)
+			}
 		} else if p.curTokenIs(token.FUNC) {
-			// Metotlar
 			stmt := p.parseMethodStatement()
 			// Erişim belirleyicisi için bir alan eklenebilir
 			// stmt.AccessModifier = accessModifier
@@ -315,3 +320,100 @@ func (p *Parser) parseTemplateExpression() ast.Expression {
 
 	return exp
 }
+
+// isTemplateInstantiation, peekToken'dan başlayarak bir şablon argüman listesi
+// ('<' Tip {',' Tip} '>') ve hemen ardından '(' gelip gelmediğini token
+// tüketmeden kontrol eder. Tipler tanımlayıcı, nitelikli ad (paket.Tip) veya
+// iç içe şablon örneklemesi olabilir. Bu sayede Foo<T>(x) çağrısı, a < b
+// karşılaştırmasından ayırt edilir. Lexer '>>' işaretini tek token olarak
+// taradığından iç içe örneklemelerde kapanışlar ayrı yazılmalıdır (A<B<T> >).
+func (p *Parser) isTemplateInstantiation() bool {
+	if !p.peekTokenIs(token.LT) {
+		return false
+	}
+
+	depth := 0
+	expectType := true // Bir sonraki token bir tip adı olmalı
+	for n := 1; ; n++ {
+		tok := p.lookAhead(n)
+		switch tok.Type {
+		case token.LT:
+			if n > 1 && expectType {
+				return false
+			}
+			depth++
+			expectType = true
+		case token.IDENT:
+			if !expectType {
+				return false
+			}
+			expectType = false
+		case token.DOT, token.COMMA:
+			if expectType || depth == 0 {
+				return false
+			}
+			expectType = true
+		case token.GT:
+			if expectType {
+				return false
+			}
+			depth--
+			if depth == 0 {
+				return p.lookAhead(n+1).Type == token.LPAREN
+			}
+		default:
+			return false
+		}
+	}
+}
+
+// parseTemplateInstantiation, curToken '<' iken şablon argümanlarını ayrıştırır.
+func (p *Parser) parseTemplateInstantiation(template ast.Expression) ast.Expression {
+	exp := &ast.TemplateInstantiation{Token: p.curToken, Template: template}
+
+	for {
+		if !p.expectPeek(token.IDENT) {
+			return nil
+		}
+		arg := p.parseTemplateArgument()
+		if arg == nil {
+			return nil
+		}
+		exp.TypeArguments = append(exp.TypeArguments, arg)
+
+		if !p.peekTokenIs(token.COMMA) {
+			break
+		}
+		p.nextToken() // ',' token'ını atla
+	}
+
+	if !p.expectPeek(token.GT) {
+		return nil
+	}
+	exp.Closing = p.curToken
+
+	return exp
+}
+
+// parseTemplateArgument, curToken bir tanımlayıcı iken tek bir şablon
+// argümanını (Tip, paket.Tip veya Tip<...>) ayrıştırır.
+func (p *Parser) parseTemplateArgument() ast.Expression {
+	var arg ast.Expression = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+
+	for p.peekTokenIs(token.DOT) {
+		p.nextToken()
+		member := &ast.MemberExpression{Token: p.curToken, Object: arg}
+		if !p.expectPeek(token.IDENT) {
+			return nil
+		}
+		member.Member = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+		arg = member
+	}
+
+	if p.peekTokenIs(token.LT) {
+		p.nextToken() // '<'
+		return p.parseTemplateInstantiation(arg)
+	}
+
+	return arg
+}
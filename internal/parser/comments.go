@@ -0,0 +1,41 @@
+package parser
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// scan, lexer'dan yorum olmayan bir sonraki token'ı okur.
+// Lexer lexer.ScanComments modundaysa aradaki COMMENT token'ları yorum
+// gruplarına toplanır ve p.comments listesine eklenir. Token'ın hemen önünde
+// (aralarında boş satır olmadan) duran ve önceki token'dan sonraki bir satırda
+// başlayan grup, token'ın belge yorumu olarak döndürülür.
+func (p *Parser) scan() (token.Token, *ast.CommentGroup) {
+	prevLine := p.lastLine // yorum olmayan son token'ın satırı
+
+	var group *ast.CommentGroup
+	groupEnd := 0
+
+	tok := p.l.NextToken()
+	for tok.Type == token.COMMENT {
+		comment := &ast.Comment{Token: tok, Text: tok.Literal}
+
+		// Boş satır yeni bir grup başlatır. Önceki token ile aynı satırda
+		// başlayan (satır sonu) yorum grubu yalnızca o satırla sınırlıdır.
+		trailing := group != nil && group.List[0].Token.Line == prevLine
+		if group == nil || tok.Line > groupEnd+1 || trailing && tok.Line > groupEnd {
+			group = &ast.CommentGroup{}
+			p.comments = append(p.comments, group)
+		}
+		group.List = append(group.List, comment)
+		groupEnd = comment.End().Line
+
+		tok = p.l.NextToken()
+	}
+	p.lastLine = tok.Line
+
+	if group != nil && group.List[0].Token.Line > prevLine && groupEnd+1 >= tok.Line {
+		return tok, group
+	}
+	return tok, nil
+}
@@ -12,7 +12,10 @@ func (p *Parser) parseIfExpression() ast.Expression {
 	expression := &ast.IfExpression{Token: p.curToken}
 
 	p.nextToken()
+	prevLev := p.exprLev
+	p.exprLev = -1
 	expression.Condition = p.parseExpression(LOWEST)
+	p.exprLev = prevLev
 
 	if !p.expectPeek(token.LBRACE) {
 		return nil
@@ -43,8 +46,16 @@ func (p *Parser) parseIfStatement() ast.Statement {
 
 // parseForStatement, bir for döngüsünü ayrıştırır.
 func (p *Parser) parseForStatement() ast.Statement {
+	if p.isRangeClause() {
+		return p.parseRangeStatement()
+	}
+
 	stmt := &ast.ForStatement{Token: p.curToken}
 
+	prevLev := p.exprLev
+	p.exprLev = -1
+	defer func() { p.exprLev = prevLev }()
+
 	p.nextToken()
 
 	// For loop türlerini belirle
@@ -97,12 +108,76 @@ func (p *Parser) parseForStatement() ast.Statement {
 	return stmt
 }
 
+// isRangeClause, for anahtar kelimesinden sonra '{' veya ';' gelmeden önce
+// range anahtar kelimesinin bulunup bulunmadığını kontrol eder.
+func (p *Parser) isRangeClause() bool {
+	for n := 1; ; n++ {
+		switch p.lookAhead(n).Type {
+		case token.RANGE:
+			return true
+		case token.LBRACE, token.SEMICOLON, token.EOF:
+			return false
+		}
+	}
+}
+
+// parseRangeStatement, bir for-range döngüsünü ayrıştırır.
+// Biçimler: for range x, for k := range x, for k, v := range x, for k, v = range x
+func (p *Parser) parseRangeStatement() ast.Statement {
+	stmt := &ast.RangeStatement{Token: p.curToken}
+
+	p.nextToken()
+
+	if !p.curTokenIs(token.RANGE) {
+		stmt.Key = p.parseExpression(ASSIGN)
+
+		if p.peekTokenIs(token.COMMA) {
+			p.nextToken()
+			p.nextToken()
+			stmt.Value = p.parseExpression(ASSIGN)
+		}
+
+		p.nextToken()
+		switch p.curToken.Type {
+		case token.DEFINE:
+			stmt.Define = true
+		case token.ASSIGN:
+			stmt.Define = false
+		default:
+			p.errors = append(p.errors, fmt.Sprintf("%s: range öncesinde := veya = bekleniyordu, %s alındı",
+				p.curToken.Position, p.curToken.Type))
+			return nil
+		}
+
+		if !p.expectPeek(token.RANGE) {
+			return nil
+		}
+	}
+
+	p.nextToken()
+	prevLev := p.exprLev
+	p.exprLev = -1
+	stmt.Iterable = p.parseExpression(LOWEST)
+	p.exprLev = prevLev
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	stmt.Body = p.parseBlockStatement()
+
+	return stmt
+}
+
 // parseWhileStatement, bir while döngüsünü ayrıştırır.
 func (p *Parser) parseWhileStatement() ast.Statement {
 	stmt := &ast.WhileStatement{Token: p.curToken}
 
 	p.nextToken()
+	prevLev := p.exprLev
+	p.exprLev = -1
 	stmt.Condition = p.parseExpression(LOWEST)
+	p.exprLev = prevLev
 
 	if !p.expectPeek(token.LBRACE) {
 		return nil
@@ -113,7 +188,8 @@ func (p *Parser) parseWhileStatement() ast.Statement {
 	return stmt
 }
 
-// parseSwitchStatement, bir switch ifadesini ayrıştırır.
+// parseSwitchStatement, bir switch ifadesini ayrıştırır. Tag x.(type) veya
+// v := x.(type) biçimindeyse bir tip switch'i ayrıştırılır.
 func (p *Parser) parseSwitchStatement() ast.Statement {
 	stmt := &ast.SwitchStatement{Token: p.curToken}
 
@@ -121,7 +197,10 @@ func (p *Parser) parseSwitchStatement() ast.Statement {
 
 	// Opsiyonel switch tag (ifade)
 	if !p.curTokenIs(token.LBRACE) {
+		prevLev := p.exprLev
+		p.exprLev = -1
 		stmt.Tag = p.parseExpression(LOWEST)
+		p.exprLev = prevLev
 		p.nextToken()
 	}
 
@@ -130,11 +209,15 @@ func (p *Parser) parseSwitchStatement() ast.Statement {
 		return nil
 	}
 
+	if typeSwitch := p.typeSwitchHeader(stmt); typeSwitch != nil {
+		return p.parseTypeSwitchBody(typeSwitch)
+	}
+
 	// Case clause'ları parse et
 	for p.peekTokenIs(token.CASE) || p.peekTokenIs(token.DEFAULT) {
 		p.nextToken()
 
-		caseClause := p.parseCaseClause()
+		caseClause := p.parseCaseClause(p.parseCaseValue)
 		if caseClause != nil {
 			stmt.Cases = append(stmt.Cases, caseClause)
 		}
@@ -147,20 +230,76 @@ func (p *Parser) parseSwitchStatement() ast.Statement {
 	return stmt
 }
 
-// parseCaseClause, bir case veya default clause'unu ayrıştırır.
-func (p *Parser) parseCaseClause() *ast.CaseClause {
+// typeSwitchHeader, switch tag'i x.(type) veya v := x.(type) ise bir tip
+// switch'i oluşturur; aksi halde nil döndürür.
+func (p *Parser) typeSwitchHeader(stmt *ast.SwitchStatement) *ast.TypeSwitchStatement {
+	tag := stmt.Tag
+	var binding *ast.Identifier
+
+	if infix, ok := tag.(*ast.InfixExpression); ok && infix.Operator == ":=" {
+		ident, isIdent := infix.Left.(*ast.Identifier)
+		if !isIdent {
+			return nil
+		}
+		binding, tag = ident, infix.Right
+	}
+
+	assert, ok := tag.(*ast.TypeAssertExpression)
+	if !ok || assert.Type != nil {
+		return nil
+	}
+
+	return &ast.TypeSwitchStatement{Token: stmt.Token, Binding: binding, Subject: assert.Expression}
+}
+
+// parseTypeSwitchBody, curToken '{' iken bir tip switch'inin case'lerini
+// ayrıştırır. Case değerleri tipler veya nil'dir.
+func (p *Parser) parseTypeSwitchBody(stmt *ast.TypeSwitchStatement) ast.Statement {
+	for p.peekTokenIs(token.CASE) || p.peekTokenIs(token.DEFAULT) {
+		p.nextToken()
+
+		caseClause := p.parseCaseClause(p.parseTypeCaseValue)
+		if caseClause != nil {
+			stmt.Cases = append(stmt.Cases, caseClause)
+		}
+	}
+
+	if !p.expectPeek(token.RBRACE) {
+		return nil
+	}
+	stmt.Closing = p.curToken
+
+	return stmt
+}
+
+// parseCaseValue, ifade switch'indeki bir case değerini ayrıştırır.
+func (p *Parser) parseCaseValue() ast.Expression {
+	return p.parseExpression(LOWEST)
+}
+
+// parseTypeCaseValue, tip switch'indeki bir case tipini veya nil'i ayrıştırır.
+func (p *Parser) parseTypeCaseValue() ast.Expression {
+	if p.curTokenIs(token.NULL) {
+		return &ast.NullLiteral{Token: p.curToken}
+	}
+	return p.parseType()
+}
+
+// parseCaseClause, bir case veya default clause'unu ayrıştırır. Case
+// değerleri parseValue ile ayrıştırılır.
+func (p *Parser) parseCaseClause(parseValue func() ast.Expression) *ast.CaseClause {
 	clause := &ast.CaseClause{Token: p.curToken}
 
 	if p.curTokenIs(token.CASE) {
 		p.nextToken()
 
 		// Case değerlerini parse et (virgülle ayrılmış)
-		clause.Values = append(clause.Values, p.parseExpression(LOWEST))
+		clause.Values = append(clause.Values, parseValue())
 
 		for p.peekTokenIs(token.COMMA) {
 			p.nextToken() // comma'yı atla
 			p.nextToken()
-			clause.Values = append(clause.Values, p.parseExpression(LOWEST))
+			clause.Values = append(clause.Values, parseValue())
 		}
 	}
 	// DEFAULT için Values nil kalır
@@ -169,11 +308,77 @@ func (p *Parser) parseCaseClause() *ast.CaseClause {
 		return nil
 	}
 
-	// Case body'sini parse et - statement'ları topla
-	for p.peekTokenIs(token.IDENT) || p.peekTokenIs(token.RETURN) ||
-		p.peekTokenIs(token.IF) || p.peekTokenIs(token.FOR) ||
-		p.peekTokenIs(token.WHILE) || p.peekTokenIs(token.VAR) ||
-		p.peekTokenIs(token.CONST) || p.peekTokenIs(token.FUNC) {
+	// Case body'sini parse et - sonraki case, default veya '}' gelene kadar statement'ları topla
+	for !p.peekTokenIs(token.CASE) && !p.peekTokenIs(token.DEFAULT) &&
+		!p.peekTokenIs(token.RBRACE) && !p.peekTokenIs(token.EOF) {
+
+		p.nextToken()
+		stmt := p.parseStatement()
+		if stmt != nil {
+			clause.Body = append(clause.Body, stmt)
+		}
+	}
+
+	return clause
+}
+
+// parseSelectStatement, bir select deyimini ayrıştırır. Her case bir gönderme
+// veya alma işlemi içerir; en fazla bir default olabilir.
+func (p *Parser) parseSelectStatement() ast.Statement {
+	stmt := &ast.SelectStatement{Token: p.curToken}
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	hasDefault := false
+	for p.peekTokenIs(token.CASE) || p.peekTokenIs(token.DEFAULT) {
+		p.nextToken()
+
+		clause := p.parseCommClause()
+		if clause == nil {
+			return nil
+		}
+		if clause.Comm == nil {
+			if hasDefault {
+				p.addErrorf("%s: select deyiminde birden fazla default var", clause.Token.Position)
+			}
+			hasDefault = true
+		}
+		stmt.Cases = append(stmt.Cases, clause)
+	}
+
+	if !p.expectPeek(token.RBRACE) {
+		return nil
+	}
+	stmt.Closing = p.curToken
+
+	return stmt
+}
+
+// parseCommClause, bir select deyiminin case veya default durumunu ayrıştırır.
+func (p *Parser) parseCommClause() *ast.CommClause {
+	clause := &ast.CommClause{Token: p.curToken}
+
+	if p.curTokenIs(token.CASE) {
+		pos := p.curToken.Position
+		p.nextToken()
+		clause.Comm = p.parseExpressionStatement()
+		if clause.Comm == nil {
+			return nil
+		}
+		if !isCommStatement(clause.Comm) {
+			p.addErrorf("%s: select case'i bir gönderme veya alma işlemi olmalıdır", pos)
+			return nil
+		}
+	}
+
+	if !p.expectPeek(token.COLON) {
+		return nil
+	}
+
+	for !p.peekTokenIs(token.CASE) && !p.peekTokenIs(token.DEFAULT) &&
+		!p.peekTokenIs(token.RBRACE) && !p.peekTokenIs(token.EOF) {
 
 		p.nextToken()
 		stmt := p.parseStatement()
@@ -185,6 +390,33 @@ func (p *Parser) parseCaseClause() *ast.CaseClause {
 	return clause
 }
 
+// isCommStatement, bir deyimin select case'inde kullanılabilecek bir
+// iletişim işlemi olup olmadığını belirler: ch <- v, <-ch, v := <-ch,
+// v = <-ch veya v, ok := <-ch.
+func isCommStatement(stmt ast.Statement) bool {
+	switch s := stmt.(type) {
+	case *ast.SendStatement:
+		return true
+	case *ast.ExpressionStatement:
+		switch e := s.Expression.(type) {
+		case *ast.InfixExpression:
+			return e.Operator == ":=" && isReceive(e.Right)
+		case *ast.AssignExpression:
+			return e.Token.Type == token.ASSIGN && isReceive(e.Value)
+		}
+		return isReceive(s.Expression)
+	case *ast.MultiAssignStatement:
+		return len(s.Left) == 2 && len(s.Values) == 1 && isReceive(s.Values[0])
+	}
+	return false
+}
+
+// isReceive, bir ifadenin kanaldan alma işlemi (<-ch) olup olmadığını belirler.
+func isReceive(expr ast.Expression) bool {
+	prefix, ok := expr.(*ast.PrefixExpression)
+	return ok && prefix.Operator == "<-"
+}
+
 // parseTryCatchStatement, bir try-catch ifadesini ayrıştırır.
 func (p *Parser) parseTryCatchStatement() *ast.TryCatchStatement {
 	stmt := &ast.TryCatchStatement{Token: p.curToken}
@@ -198,8 +430,8 @@ func (p *Parser) parseTryCatchStatement() *ast.TryCatchStatement {
 
 	// En az bir catch bloğu olmalı
 	if !p.peekTokenIs(token.CATCH) {
-		msg := fmt.Sprintf("Satır %d, Sütun %d: try bloğundan sonra catch bloğu bekleniyor",
-			p.curToken.Line, p.curToken.Column)
+		msg := fmt.Sprintf("%s: try bloğundan sonra catch bloğu bekleniyor",
+			p.curToken.Position)
 		p.errors = append(p.errors, msg)
 		return nil
 	}
@@ -293,6 +525,49 @@ func (p *Parser) parseThrowStatement() *ast.ThrowStatement {
 	return stmt
 }
 
+// parseDeferStatement, bir defer deyimini ayrıştırır. Ertelenen ifade bir
+// fonksiyon çağrısı olmalıdır.
+func (p *Parser) parseDeferStatement() ast.Statement {
+	stmt := &ast.DeferStatement{Token: p.curToken}
+	if stmt.Call = p.parseStatementCall("defer edilen"); stmt.Call == nil {
+		return nil
+	}
+	return stmt
+}
+
+// parseGoStatement, bir go deyimini ayrıştırır. Başlatılan ifade bir
+// fonksiyon çağrısı olmalıdır.
+func (p *Parser) parseGoStatement() ast.Statement {
+	stmt := &ast.GoStatement{Token: p.curToken}
+	if stmt.Call = p.parseStatementCall("go ile başlatılan"); stmt.Call == nil {
+		return nil
+	}
+	return stmt
+}
+
+// parseStatementCall, defer ve go deyimlerinin anahtar kelimeden sonra gelen
+// çağrısını ayrıştırır. İfade bir çağrı değilse hata bildirilir ve nil döner.
+func (p *Parser) parseStatementCall(what string) *ast.CallExpression {
+	pos := p.curToken.Position
+	p.nextToken()
+
+	expr := p.parseExpression(LOWEST)
+	call, ok := expr.(*ast.CallExpression)
+	if !ok {
+		if expr != nil {
+			p.addErrorf("%s: %s ifade bir fonksiyon çağrısı olmalıdır", pos, what)
+		}
+		return nil
+	}
+
+	// Opsiyonel noktalı virgül
+	if p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+
+	return call
+}
+
 // parseScopeStatement, bir scope ifadesini ayrıştırır.
 func (p *Parser) parseScopeStatement() *ast.ScopeStatement {
 	stmt := &ast.ScopeStatement{Token: p.curToken}
@@ -305,3 +580,16 @@ func (p *Parser) parseScopeStatement() *ast.ScopeStatement {
 
 	return stmt
 }
+
+// parseUnsafeStatement, bir unsafe bloğunu ayrıştırır.
+func (p *Parser) parseUnsafeStatement() *ast.UnsafeStatement {
+	stmt := &ast.UnsafeStatement{Token: p.curToken}
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	stmt.Body = p.parseBlockStatement()
+
+	return stmt
+}
@@ -7,7 +7,11 @@ import (
 )
 
 // Errors, ayrıştırma sırasında karşılaşılan hataları döndürür.
+// Lexer hataları (örn. geçersiz UTF-8) ayrıştırma hatalarından önce listelenir.
 func (p *Parser) Errors() []string {
+	if lexErrors := p.l.Errors(); len(lexErrors) > 0 {
+		return append(append([]string{}, lexErrors...), p.errors...)
+	}
 	return p.errors
 }
 
@@ -38,7 +42,7 @@ func (p *Parser) synchronize() {
 		switch p.peekToken.Type {
 		case token.PACKAGE, token.IMPORT, token.FUNC, token.VAR, token.CONST,
 			token.IF, token.FOR, token.WHILE, token.CLASS, token.RETURN,
-			token.TRY, token.THROW, token.SCOPE:
+			token.TRY, token.THROW, token.DEFER, token.GO, token.SELECT, token.SCOPE:
 			return
 		}
 
@@ -48,36 +52,36 @@ func (p *Parser) synchronize() {
 
 // reportUnexpectedToken, beklenmeyen token hatası rapor eder.
 func (p *Parser) reportUnexpectedToken(expected, actual token.TokenType) {
-	msg := fmt.Sprintf("Satır %d, Sütun %d: %s bekleniyordu, %s alındı",
-		p.curToken.Line, p.curToken.Column, expected, actual)
+	msg := fmt.Sprintf("%s: %s bekleniyordu, %s alındı",
+		p.curToken.Position, expected, actual)
 	p.addError(msg)
 }
 
 // reportUnexpectedTokenWithMessage, özel mesajla beklenmeyen token hatası rapor eder.
 func (p *Parser) reportUnexpectedTokenWithMessage(message string) {
-	msg := fmt.Sprintf("Satır %d, Sütun %d: %s",
-		p.curToken.Line, p.curToken.Column, message)
+	msg := fmt.Sprintf("%s: %s",
+		p.curToken.Position, message)
 	p.addError(msg)
 }
 
 // reportMissingToken, eksik token hatası rapor eder.
 func (p *Parser) reportMissingToken(expected token.TokenType) {
-	msg := fmt.Sprintf("Satır %d, Sütun %d: %s eksik",
-		p.curToken.Line, p.curToken.Column, expected)
+	msg := fmt.Sprintf("%s: %s eksik",
+		p.curToken.Position, expected)
 	p.addError(msg)
 }
 
 // reportInvalidSyntax, geçersiz syntax hatası rapor eder.
 func (p *Parser) reportInvalidSyntax(context string) {
-	msg := fmt.Sprintf("Satır %d, Sütun %d: %s içinde geçersiz syntax",
-		p.curToken.Line, p.curToken.Column, context)
+	msg := fmt.Sprintf("%s: %s içinde geçersiz syntax",
+		p.curToken.Position, context)
 	p.addError(msg)
 }
 
 // reportSemanticError, semantik hata rapor eder.
 func (p *Parser) reportSemanticError(message string) {
-	msg := fmt.Sprintf("Satır %d, Sütun %d: Semantik hata: %s",
-		p.curToken.Line, p.curToken.Column, message)
+	msg := fmt.Sprintf("%s: Semantik hata: %s",
+		p.curToken.Position, message)
 	p.addError(msg)
 }
 
@@ -107,8 +111,8 @@ func (p *Parser) errorCount() int {
 // recoverFromPanic, panic durumunda parser'ı kurtarır.
 func (p *Parser) recoverFromPanic() {
 	if r := recover(); r != nil {
-		msg := fmt.Sprintf("Satır %d, Sütun %d: Parser panic: %v",
-			p.curToken.Line, p.curToken.Column, r)
+		msg := fmt.Sprintf("%s: Parser panic: %v",
+			p.curToken.Position, r)
 		p.addError(msg)
 		p.synchronize()
 	}
@@ -2,7 +2,9 @@ package parser
 
 import (
 	"fmt"
-	"strconv"
+	"math/big"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/inkbytefo/go-minus/internal/ast"
 	"github.com/inkbytefo/go-minus/internal/token"
@@ -33,45 +35,113 @@ func (p *Parser) parseExpression(precedence int) ast.Expression {
 
 // noPrefixParseFnError, bir prefix ayrıştırma fonksiyonu bulunamadığında bir hata ekler.
 func (p *Parser) noPrefixParseFnError(t token.TokenType) {
-	msg := fmt.Sprintf("Satır %d, Sütun %d: %s için prefix ayrıştırma fonksiyonu bulunamadı",
-		p.curToken.Line, p.curToken.Column, t)
+	msg := fmt.Sprintf("%s: %s için prefix ayrıştırma fonksiyonu bulunamadı",
+		p.curToken.Position, t)
 	p.errors = append(p.errors, msg)
 }
 
 // parseIdentifier, bir tanımlayıcıyı ayrıştırır.
+// Ardından bir şablon argüman listesi ve '(' geliyorsa (Foo<T>(x)) tanımlayıcı
+// bir şablon örneklemesi olarak ayrıştırılır.
 func (p *Parser) parseIdentifier() ast.Expression {
+	ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+	if p.isTemplateInstantiation() {
+		p.nextToken() // '<'
+		return p.parseTemplateInstantiation(ident)
+	}
+	if p.compositeLiteralAllowed() {
+		p.nextToken()
+		return p.parseCompositeLiteral(ident)
+	}
+	return ident
+}
+
+// parseBuiltinKeyword, anahtar sözcük olarak ayrılmış ama ifade konumunda
+// yerleşik bir fonksiyon adı olarak kullanılan tokenları (delete(m, k))
+// tanımlayıcı olarak ayrıştırır.
+func (p *Parser) parseBuiltinKeyword() ast.Expression {
 	return &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
 }
 
 // parseIntegerLiteral, bir tamsayı değişmez değerini ayrıştırır.
+// Değer keyfi hassasiyetle saklanır; int64'e sığan değerler Value alanına da yazılır.
 func (p *Parser) parseIntegerLiteral() ast.Expression {
 	lit := &ast.IntegerLiteral{Token: p.curToken}
 
-	value, err := strconv.ParseInt(p.curToken.Literal, 0, 64)
-	if err != nil {
-		msg := fmt.Sprintf("Satır %d, Sütun %d: %q bir tamsayıya dönüştürülemedi",
-			p.curToken.Line, p.curToken.Column, p.curToken.Literal)
+	if _, msg := checkNumberLiteral(p.curToken.Literal); msg != "" {
+		p.addErrorf("%s: geçersiz sayı literali %q: %s",
+			p.curToken.Position, p.curToken.Literal, msg)
+		return nil
+	}
+
+	value, ok := new(big.Int).SetString(p.curToken.Literal, 0)
+	if !ok {
+		msg := fmt.Sprintf("%s: %q bir tamsayıya dönüştürülemedi",
+			p.curToken.Position, p.curToken.Literal)
 		p.errors = append(p.errors, msg)
 		return nil
 	}
 
-	lit.Value = value
+	lit.Constant = value
+	if value.IsInt64() {
+		lit.Value = value.Int64()
+	}
 	return lit
 }
 
 // parseFloatLiteral, bir ondalık sayı değişmez değerini ayrıştırır.
+// Onaltılık ondalık sayılar (0x1p-2) dahil tüm Go biçimleri desteklenir.
 func (p *Parser) parseFloatLiteral() ast.Expression {
 	lit := &ast.FloatLiteral{Token: p.curToken}
 
-	value, err := strconv.ParseFloat(p.curToken.Literal, 64)
+	if _, msg := checkNumberLiteral(p.curToken.Literal); msg != "" {
+		p.addErrorf("%s: geçersiz sayı literali %q: %s",
+			p.curToken.Position, p.curToken.Literal, msg)
+		return nil
+	}
+
+	value, _, err := big.ParseFloat(p.curToken.Literal, 0, ast.FloatPrecision, big.ToNearestEven)
 	if err != nil {
-		msg := fmt.Sprintf("Satır %d, Sütun %d: %q bir ondalık sayıya dönüştürülemedi",
-			p.curToken.Line, p.curToken.Column, p.curToken.Literal)
+		msg := fmt.Sprintf("%s: %q bir ondalık sayıya dönüştürülemedi",
+			p.curToken.Position, p.curToken.Literal)
 		p.errors = append(p.errors, msg)
 		return nil
 	}
 
-	lit.Value = value
+	lit.Constant = value
+	lit.Value, _ = value.Float64()
+	return lit
+}
+
+// parseImaginaryLiteral, bir sanal sayı değişmez değerini (2i, 1.5i)
+// ayrıştırır. 'i' sonekinden önceki kısım bir tamsayı veya ondalık sayı
+// literalidir; Go'da olduğu gibi 0 ile başlayan onluk rakamlar sekizlik
+// sayılmaz (0789i).
+func (p *Parser) parseImaginaryLiteral() ast.Expression {
+	lit := &ast.ImaginaryLiteral{Token: p.curToken}
+	mantissa := strings.TrimSuffix(p.curToken.Literal, "i")
+
+	// Başa eklenen rakam eski stil sekizlik denetimini devre dışı bırakır
+	check := mantissa
+	if len(mantissa) > 1 && mantissa[0] == '0' && ('0' <= mantissa[1] && mantissa[1] <= '9' || mantissa[1] == '_') {
+		check = "1" + mantissa
+	}
+	if _, msg := checkNumberLiteral(check); msg != "" {
+		p.addErrorf("%s: geçersiz sayı literali %q: %s",
+			p.curToken.Position, p.curToken.Literal, msg)
+		return nil
+	}
+
+	value, _, err := big.ParseFloat(mantissa, 0, ast.FloatPrecision, big.ToNearestEven)
+	if err != nil {
+		msg := fmt.Sprintf("%s: %q bir sanal sayıya dönüştürülemedi",
+			p.curToken.Position, p.curToken.Literal)
+		p.errors = append(p.errors, msg)
+		return nil
+	}
+
+	lit.Constant = value
+	lit.Value, _ = value.Float64()
 	return lit
 }
 
@@ -81,14 +151,16 @@ func (p *Parser) parseStringLiteral() ast.Expression {
 }
 
 // parseCharLiteral, bir karakter değişmez değerini ayrıştırır.
+// Lexer kaçış dizilerini çözdüğü için literal tek bir rune'un UTF-8 kodlamasıdır.
 func (p *Parser) parseCharLiteral() ast.Expression {
-	if len(p.curToken.Literal) != 1 {
-		msg := fmt.Sprintf("Satır %d, Sütun %d: %q bir karakter değil",
-			p.curToken.Line, p.curToken.Column, p.curToken.Literal)
+	value, size := utf8.DecodeRuneInString(p.curToken.Literal)
+	if size == 0 || size != len(p.curToken.Literal) {
+		msg := fmt.Sprintf("%s: %q bir karakter değil",
+			p.curToken.Position, p.curToken.Literal)
 		p.errors = append(p.errors, msg)
 		return nil
 	}
-	return &ast.CharLiteral{Token: p.curToken, Value: rune(p.curToken.Literal[0])}
+	return &ast.CharLiteral{Token: p.curToken, Value: value}
 }
 
 // parseBooleanLiteral, bir boolean değişmez değerini ayrıştırır.
@@ -115,6 +187,15 @@ func (p *Parser) parsePrefixExpression() ast.Expression {
 	return expression
 }
 
+// parseReceiveExpression, bir kanaldan alma ifadesini (<-ch) ayrıştırır.
+// '<-' token'ını 'chan' izliyorsa ifade bir <-chan T tipidir.
+func (p *Parser) parseReceiveExpression() ast.Expression {
+	if p.peekTokenIs(token.CHAN) {
+		return p.parseChanTypeExpression()
+	}
+	return p.parsePrefixExpression()
+}
+
 // parseInfixExpression, bir araek ifadesini ayrıştırır.
 func (p *Parser) parseInfixExpression(left ast.Expression) ast.Expression {
 	expression := &ast.InfixExpression{
@@ -134,7 +215,9 @@ func (p *Parser) parseInfixExpression(left ast.Expression) ast.Expression {
 func (p *Parser) parseGroupedExpression() ast.Expression {
 	p.nextToken()
 
+	p.exprLev++
 	exp := p.parseExpression(LOWEST)
+	p.exprLev--
 
 	if !p.expectPeek(token.RPAREN) {
 		return nil
@@ -227,10 +310,58 @@ func (p *Parser) parseHashLiteral() ast.Expression {
 
 // parseIndexExpression, bir dizin ifadesini ayrıştırır.
 func (p *Parser) parseIndexExpression(left ast.Expression) ast.Expression {
-	exp := &ast.IndexExpression{Token: p.curToken, Left: left}
+	tok := p.curToken
 
 	p.nextToken()
-	exp.Index = p.parseExpression(LOWEST)
+	p.exprLev++
+	defer func() { p.exprLev-- }()
+
+	// s[:j] biçimindeki dilimleme
+	if p.curTokenIs(token.COLON) || p.curTokenIs(token.SCOPE_RES) {
+		return p.parseSliceExpression(tok, left, nil)
+	}
+
+	index := p.parseExpression(LOWEST)
+	if p.peekTokenIs(token.COLON) || p.peekTokenIs(token.SCOPE_RES) {
+		p.nextToken()
+		return p.parseSliceExpression(tok, left, index)
+	}
+
+	if !p.expectPeek(token.RBRACKET) {
+		return nil
+	}
+
+	return &ast.IndexExpression{Token: tok, Left: left, Index: index}
+}
+
+// parseSliceExpression, s[i:j] ve s[i:j:k] biçimindeki bir dilimleme
+// ifadesinin ':' sonrasını ayrıştırır. Mevcut token ':' (veya ikinci indeksi
+// eksik bir üç indeksli dilimlemede '::') olmalıdır. Üç indeksli biçimde
+// ikinci ve üçüncü indeks zorunludur.
+func (p *Parser) parseSliceExpression(tok token.Token, left, low ast.Expression) ast.Expression {
+	exp := &ast.SliceExpression{Token: tok, Left: left, Low: low}
+
+	threeIndex := p.curTokenIs(token.SCOPE_RES)
+	if !threeIndex && !p.peekTokenIs(token.RBRACKET) && !p.peekTokenIs(token.COLON) {
+		p.nextToken()
+		exp.High = p.parseExpression(LOWEST)
+	}
+	if !threeIndex && p.peekTokenIs(token.COLON) {
+		p.nextToken()
+		threeIndex = true
+	}
+
+	if threeIndex {
+		if exp.High == nil {
+			p.addErrorf("%s: 3 indeksli dilimlemede ikinci indeks gereklidir", p.curToken.Position)
+		}
+		if p.peekTokenIs(token.RBRACKET) {
+			p.addErrorf("%s: 3 indeksli dilimlemede üçüncü indeks gereklidir", p.curToken.Position)
+		} else {
+			p.nextToken()
+			exp.Max = p.parseExpression(LOWEST)
+		}
+	}
 
 	if !p.expectPeek(token.RBRACKET) {
 		return nil
@@ -246,18 +377,35 @@ func (p *Parser) parseMemberExpression(object ast.Expression) ast.Expression {
 		Object: object,
 	}
 
+	// x.(T) biçimindeki tip iddiası
+	if p.peekTokenIs(token.LPAREN) {
+		return p.parseTypeAssertion(object)
+	}
+
 	if !p.expectPeek(token.IDENT) {
 		return nil
 	}
 
 	exp.Member = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
 
+	// paket.Foo<T>(x) biçimindeki şablon örneklemesi
+	if p.isTemplateInstantiation() {
+		p.nextToken() // '<'
+		return p.parseTemplateInstantiation(exp)
+	}
+
+	// paket.Tip{...} biçimindeki bileşik değişmez
+	if _, qualified := object.(*ast.Identifier); qualified && p.compositeLiteralAllowed() {
+		p.nextToken()
+		return p.parseCompositeLiteral(exp)
+	}
+
 	return exp
 }
 
-// parseAssignExpression, bir atama ifadesini ayrıştırır.
+// parseAssignExpression, bir atama ifadesini (=, +=, -=, ...) ayrıştırır.
 func (p *Parser) parseAssignExpression(left ast.Expression) ast.Expression {
-	exp := &ast.InfixExpression{
+	exp := &ast.AssignExpression{
 		Token:    p.curToken,
 		Operator: p.curToken.Literal,
 		Left:     left,
@@ -265,7 +413,7 @@ func (p *Parser) parseAssignExpression(left ast.Expression) ast.Expression {
 
 	precedence := p.curPrecedence()
 	p.nextToken()
-	exp.Right = p.parseExpression(precedence)
+	exp.Value = p.parseExpression(precedence)
 
 	return exp
 }
@@ -289,6 +437,9 @@ func (p *Parser) parseShortVarDeclExpression(left ast.Expression) ast.Expression
 func (p *Parser) parseExpressionList(end token.TokenType) []ast.Expression {
 	list := []ast.Expression{}
 
+	p.exprLev++
+	defer func() { p.exprLev-- }()
+
 	if p.peekTokenIs(end) {
 		p.nextToken()
 		return list
@@ -299,6 +450,10 @@ func (p *Parser) parseExpressionList(end token.TokenType) []ast.Expression {
 
 	for p.peekTokenIs(token.COMMA) {
 		p.nextToken()
+		// Çok satırlı listelerde sondaki virgüle izin ver
+		if p.peekTokenIs(end) {
+			break
+		}
 		p.nextToken()
 		list = append(list, p.parseExpression(LOWEST))
 	}
@@ -344,37 +499,23 @@ func (p *Parser) parseExpressionUntil(precedence int, until token.TokenType) ast
 
 // isTypedArrayLiteral, mevcut pozisyonun typed array literal olup olmadığını kontrol eder.
 func (p *Parser) isTypedArrayLiteral() bool {
-	// Basit kontrol: şimdilik sadece simple array literal'ları destekleyelim
-	// Typed array literal desteği daha sonra eklenebilir
-	return false
+	// []T
+	if p.peekTokenIs(token.RBRACKET) {
+		return typeStartTokens[p.lookAhead(2).Type]
+	}
+	// [N]T; [1] gibi tek elemanlı dizi değişmezlerinden ']' sonrasındaki
+	// tip ile ayrılır
+	return p.peekTokenIs(token.INT) && p.lookAhead(2).Type == token.RBRACKET &&
+		typeStartTokens[p.lookAhead(3).Type]
 }
 
-// parseTypedArrayLiteral, typed array literal'ı ayrıştırır: [5]int{1,2,3}
+// parseTypedArrayLiteral, []T ve [N]T tiplerini ayrıştırır (ör. []byte(s)
+// dönüşümü); ardından '{' gelirse tipin değişmezi ayrıştırılır: [5]int{1,2,3}
 func (p *Parser) parseTypedArrayLiteral() ast.Expression {
-	tok := p.curToken // LBRACKET token
-
-	// Parse array type first
-	arrayType := p.parseArrayType()
-	if arrayType == nil {
-		return nil
-	}
-
-	// Expect {
-	if !p.expectPeek(token.LBRACE) {
-		return nil
-	}
-
-	// Parse elements
-	elements := p.parseExpressionList(token.RBRACE)
-
-	// Create a typed array literal (we'll use ArrayLiteral with type info)
-	arrayLit := &ast.ArrayLiteral{
-		Token:    tok,
-		Elements: elements,
+	typ := p.parseType()
+	if typ != nil && p.compositeLiteralAllowed() {
+		p.nextToken()
+		return p.parseCompositeLiteral(typ)
 	}
-
-	// Store type information (we might need a new AST node for this)
-	// For now, we'll handle this in semantic analysis
-
-	return arrayLit
+	return typ
 }
@@ -21,36 +21,16 @@ func (p *Parser) parseFunctionLiteral() ast.Expression {
 	}
 
 	lit.Parameters = p.parseFunctionParameters()
+	if lit.Parameters == nil {
+		return nil
+	}
 
 	// Opsiyonel dönüş tipi
-	if p.peekTokenIs(token.IDENT) {
-		p.nextToken()
-		lit.ReturnType = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
-	} else if p.peekTokenIs(token.LBRACKET) {
-		p.nextToken()
-		// Dizi dönüş tipi
-		// TODO: Dizi tipi ayrıştırma eklenecek
-	} else if p.peekTokenIs(token.FUNC) {
-		p.nextToken()
-		// Fonksiyon dönüş tipi
-		// TODO: Fonksiyon tipi ayrıştırma eklenecek
-	} else if p.peekTokenIs(token.MAP) {
-		p.nextToken()
-		// Map dönüş tipi
-		// TODO: Map tipi ayrıştırma eklenecek
-	} else if p.peekTokenIs(token.CHAN) {
-		p.nextToken()
-		// Kanal dönüş tipi
-		// TODO: Kanal tipi ayrıştırma eklenecek
-	} else if p.peekTokenIs(token.INTERFACE) {
-		p.nextToken()
-		// Arayüz dönüş tipi
-		// TODO: Arayüz tipi ayrıştırma eklenecek
-	} else if p.peekTokenIs(token.STRUCT) {
-		p.nextToken()
-		// Struct dönüş tipi
-		// TODO: Struct tipi ayrıştırma eklenecek
+	returnType, ok := p.parseResultType()
+	if !ok {
+		return nil
 	}
+	lit.ReturnType = returnType
 
 	if !p.expectPeek(token.LBRACE) {
 		return nil
@@ -65,6 +45,7 @@ func (p *Parser) parseFunctionLiteral() ast.Expression {
 func (p *Parser) parseFunctionStatement() ast.Statement {
 	funcStmt := &ast.FunctionStatement{
 		Token: p.curToken,
+		Doc:   p.curDoc,
 	}
 
 	// Fonksiyon adı
@@ -80,12 +61,16 @@ func (p *Parser) parseFunctionStatement() ast.Statement {
 	}
 
 	funcStmt.Parameters = p.parseFunctionParameters()
+	if funcStmt.Parameters == nil {
+		return nil
+	}
 
 	// Opsiyonel dönüş tipi
-	if p.peekTokenIs(token.IDENT) {
-		p.nextToken()
-		funcStmt.ReturnType = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+	returnType, ok := p.parseResultType()
+	if !ok {
+		return nil
 	}
+	funcStmt.ReturnType = returnType
 
 	// Fonksiyon gövdesi
 	if !p.expectPeek(token.LBRACE) {
@@ -97,64 +82,166 @@ func (p *Parser) parseFunctionStatement() ast.Statement {
 	return funcStmt
 }
 
-// parseFunctionParameters, bir fonksiyonun parametrelerini ayrıştırır.
-func (p *Parser) parseFunctionParameters() []*ast.Identifier {
-	identifiers := []*ast.Identifier{}
+// parseFunctionParameters, curToken '(' iken bir fonksiyonun parametrelerini
+// ayrıştırır. Aynı tipteki ardışık parametreler gruplanabilir (a, b int).
+// Hiçbir parametrenin tipi yazılmamışsa (func add(x, y)) parametreler tipsiz
+// kabul edilir.
+func (p *Parser) parseFunctionParameters() []*ast.Parameter {
+	params := p.parseParameterList()
+	if params == nil {
+		return nil
+	}
+	return p.groupParameters(params, false)
+}
 
-	if p.peekTokenIs(token.RPAREN) {
+// parseParameterList, curToken '(' iken virgülle ayrılmış bir parametre veya
+// sonuç listesini ayrıştırır ve curToken ')' iken döner. Ardından bir tip
+// gelen tanımlayıcılar parametre adı, diğer elemanlar tip olarak okunur;
+// gruplanmış parametreler groupParameters ile çözülür. Sondaki virgüle izin
+// verilir.
+func (p *Parser) parseParameterList() []*ast.Parameter {
+	params := []*ast.Parameter{}
+
+	for !p.peekTokenIs(token.RPAREN) {
 		p.nextToken()
-		return identifiers
-	}
 
+		param := &ast.Parameter{}
+		if p.curTokenIs(token.IDENT) && (typeStartTokens[p.peekToken.Type] || p.peekTokenIs(token.ELLIPSIS)) {
+			param.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+			p.nextToken()
+		}
+
+		if p.curTokenIs(token.ELLIPSIS) {
+			ellipsis := &ast.Ellipsis{Token: p.curToken}
+			p.nextToken()
+			if ellipsis.Element = p.parseType(); ellipsis.Element == nil {
+				return nil
+			}
+			param.Type = ellipsis
+		} else if param.Type = p.parseType(); param.Type == nil {
+			return nil
+		}
+		params = append(params, param)
+
+		if !p.peekTokenIs(token.RPAREN) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
 	p.nextToken()
 
-	// İlk parametre
-	ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+	return params
+}
 
-	// Parametre tipi (opsiyonel)
-	if p.peekTokenIs(token.IDENT) {
-		p.nextToken()
-		// Parametre tipi için bir alan eklenebilir
-		// ident.Type = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+// groupParameters, parseParameterList ile okunan elemanları çözer. Adlı bir
+// eleman varsa adsız elemanlar, tipini kendilerinden sonraki ilk adlı
+// elemandan alan parametre adlarıdır (a, b int). Hiçbir eleman adlı değilse
+// elemanlar sonuç listelerinde tiptir; parametre listelerinde ise
+// tanımlayıcılar tipsiz parametre adı olarak kabul edilir.
+func (p *Parser) groupParameters(params []*ast.Parameter, results bool) []*ast.Parameter {
+	named := false
+	for _, param := range params {
+		if param.Name != nil {
+			named = true
+			break
+		}
 	}
 
-	identifiers = append(identifiers, ident)
+	if !named {
+		if !results {
+			for _, param := range params {
+				if ident, ok := param.Type.(*ast.Identifier); ok {
+					param.Name, param.Type = ident, nil
+				}
+			}
+		}
+		return p.checkEllipsis(params)
+	}
 
-	// Diğer parametreler
-	for p.peekTokenIs(token.COMMA) {
-		p.nextToken() // ',' token'ını atla
-		p.nextToken() // Parametre adını al
+	var typ ast.Expression
+	for i := len(params) - 1; i >= 0; i-- {
+		param := params[i]
+		if param.Name != nil {
+			typ = param.Type
+			continue
+		}
 
-		ident := &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+		ident, ok := param.Type.(*ast.Identifier)
+		if !ok || typ == nil {
+			p.addErrorf("%s: adlı ve adsız parametreler bir arada kullanılamaz", param.Pos())
+			return nil
+		}
+		param.Name, param.Type = ident, typ
+	}
+	return p.checkEllipsis(params)
+}
 
-		// Parametre tipi (opsiyonel)
-		if p.peekTokenIs(token.IDENT) {
-			p.nextToken()
-			// Parametre tipi için bir alan eklenebilir
-			// ident.Type = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+// checkEllipsis, '...' ile yazılan variadic parametrenin listenin son
+// parametresi olduğunu denetler.
+func (p *Parser) checkEllipsis(params []*ast.Parameter) []*ast.Parameter {
+	for i, param := range params {
+		if ellipsis, ok := param.Type.(*ast.Ellipsis); ok && i < len(params)-1 {
+			p.addErrorf("%s: '...' yalnızca son parametrede kullanılabilir", ellipsis.Pos())
+			return nil
 		}
+	}
+	return params
+}
 
-		identifiers = append(identifiers, ident)
+// parseResultType, parametre listesinden sonra gelen opsiyonel sonuç tipini
+// ayrıştırır. Parantez içindeki bir sonuç listesi tek ve adsız bir tipse
+// tipin kendisi, aksi halde bir *ast.ResultList döner. Sonuç tipi yoksa nil
+// döner; ikinci dönüş değeri ayrıştırmanın başarılı olup olmadığını bildirir.
+func (p *Parser) parseResultType() (ast.Expression, bool) {
+	if typeStartTokens[p.peekToken.Type] {
+		p.nextToken()
+		typ := p.parseType()
+		return typ, typ != nil
+	}
+	if !p.peekTokenIs(token.LPAREN) {
+		return nil, true
 	}
 
-	if !p.expectPeek(token.RPAREN) {
-		return nil
+	p.nextToken()
+	list := &ast.ResultList{Token: p.curToken}
+	results := p.parseParameterList()
+	if results == nil {
+		return nil, false
+	}
+	list.Closing = p.curToken
+	list.Results = p.groupParameters(results, true)
+	for _, result := range list.Results {
+		if ellipsis, ok := result.Type.(*ast.Ellipsis); ok {
+			p.addErrorf("%s: sonuç listesinde '...' kullanılamaz", ellipsis.Pos())
+			return nil, false
+		}
 	}
 
-	return identifiers
+	switch {
+	case list.Results == nil:
+		return nil, false
+	case len(list.Results) == 0:
+		return nil, true
+	case len(list.Results) == 1 && list.Results[0].Name == nil:
+		return list.Results[0].Type, true
+	}
+	return list, true
 }
 
 // parseCallExpression, bir fonksiyon çağrısını ayrıştırır.
 func (p *Parser) parseCallExpression(function ast.Expression) ast.Expression {
 	exp := &ast.CallExpression{Token: p.curToken, Function: function}
-	exp.Arguments = p.parseCallArguments()
+	exp.Arguments = p.parseCallArguments(exp)
 	return exp
 }
 
-// parseCallArguments, fonksiyon çağrısı argümanlarını ayrıştırır.
-func (p *Parser) parseCallArguments() []ast.Expression {
+// parseCallArguments, fonksiyon çağrısı argümanlarını ayrıştırır. Son
+// argümandan sonra gelen '...' (f(xs...)) çağrının Ellipsis alanına yazılır.
+func (p *Parser) parseCallArguments(call *ast.CallExpression) []ast.Expression {
 	args := []ast.Expression{}
 
+	p.exprLev++
+	defer func() { p.exprLev-- }()
+
 	if p.peekTokenIs(token.RPAREN) {
 		p.nextToken()
 		return args
@@ -165,10 +252,23 @@ func (p *Parser) parseCallArguments() []ast.Expression {
 
 	for p.peekTokenIs(token.COMMA) {
 		p.nextToken()
+		// Çok satırlı çağrılarda sondaki virgüle izin ver
+		if p.peekTokenIs(token.RPAREN) {
+			break
+		}
 		p.nextToken()
 		args = append(args, p.parseExpression(LOWEST))
 	}
 
+	if p.peekTokenIs(token.ELLIPSIS) {
+		p.nextToken()
+		call.Ellipsis = p.curToken.Position
+		// f(xs...,) biçiminde sondaki virgüle izin ver
+		if p.peekTokenIs(token.COMMA) {
+			p.nextToken()
+		}
+	}
+
 	if !p.expectPeek(token.RPAREN) {
 		return nil
 	}
@@ -176,20 +276,55 @@ func (p *Parser) parseCallArguments() []ast.Expression {
 	return args
 }
 
-// parseMethodStatement, bir metot tanımını ayrıştırır.
+// parseMethodStatement, bir metot tanımını ayrıştırır. Alıcı (p Person),
+// işaretçi olarak (p *Person) veya yalnızca tip adıyla (Person, *Person)
+// yazılabilir. Şablon sınıf alıcıları tip parametrelerini de belirtir
+// (c *Channel<T>).
 func (p *Parser) parseMethodStatement() *ast.MethodStatement {
-	stmt := &ast.MethodStatement{Token: p.curToken}
+	stmt := &ast.MethodStatement{Token: p.curToken, Doc: p.curDoc}
 
 	if !p.expectPeek(token.LPAREN) {
 		return nil
 	}
 
-	if !p.expectPeek(token.IDENT) {
-		return nil
+	if p.peekTokenIs(token.IDENT) {
+		p.nextToken()
+		if p.peekTokenIs(token.IDENT) || p.peekTokenIs(token.ASTERISK) {
+			stmt.ReceiverName = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+			p.nextToken()
+		}
+	} else {
+		p.nextToken()
 	}
 
+	if p.curTokenIs(token.ASTERISK) {
+		stmt.Pointer = true
+		p.nextToken()
+	}
+	if !p.curTokenIs(token.IDENT) {
+		p.addErrorf("%s: metot alıcısı için tip adı bekleniyordu, %s alındı",
+			p.curToken.Position, p.curToken.Type)
+		return nil
+	}
 	stmt.Receiver = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
 
+	if p.peekTokenIs(token.LT) {
+		p.nextToken()
+		for {
+			if !p.expectPeek(token.IDENT) {
+				return nil
+			}
+			stmt.TypeParams = append(stmt.TypeParams, &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal})
+			if !p.peekTokenIs(token.COMMA) {
+				break
+			}
+			p.nextToken()
+		}
+		if !p.expectPeek(token.GT) {
+			return nil
+		}
+	}
+
 	if !p.expectPeek(token.RPAREN) {
 		return nil
 	}
@@ -205,13 +340,16 @@ func (p *Parser) parseMethodStatement() *ast.MethodStatement {
 	}
 
 	stmt.Parameters = p.parseFunctionParameters()
+	if stmt.Parameters == nil {
+		return nil
+	}
 
 	// Opsiyonel dönüş tipi
-	if p.peekTokenIs(token.IDENT) {
-		p.nextToken()
-		// TODO: Tip ayrıştırma eklenecek
-		// stmt.ReturnType = p.parseType()
+	returnType, ok := p.parseResultType()
+	if !ok {
+		return nil
 	}
+	stmt.ReturnType = returnType
 
 	if !p.expectPeek(token.LBRACE) {
 		return nil
@@ -7,9 +7,41 @@ import (
 )
 
 // nextToken bir sonraki token'ı alır.
+// İleri bakış arabelleğinde token varsa önce onlar kullanılır.
 func (p *Parser) nextToken() {
 	p.curToken = p.peekToken
-	p.peekToken = p.l.NextToken()
+	p.curDoc = p.peekDoc
+	if len(p.lookahead) > 0 {
+		p.peekToken, p.peekDoc = p.lookahead[0].tok, p.lookahead[0].doc
+		p.lookahead = p.lookahead[1:]
+		return
+	}
+	p.peekToken, p.peekDoc = p.scan()
+}
+
+// lookAhead, curToken'dan sonraki n. token'ı token tüketmeden döndürür.
+// lookAhead(1) peekToken'dır; daha uzak token'lar lexer'dan okunup
+// arabelleğe alınır. Böylece parser, örneğin Foo<T>(x) şablon örneklemesini
+// a < b karşılaştırmasından ayırt etmek için istediği kadar ileri bakabilir.
+func (p *Parser) lookAhead(n int) token.Token {
+	if n <= 0 {
+		return p.curToken
+	}
+	if n == 1 {
+		return p.peekToken
+	}
+	for len(p.lookahead) < n-1 {
+		last := p.peekToken
+		if len(p.lookahead) > 0 {
+			last = p.lookahead[len(p.lookahead)-1].tok
+		}
+		if last.Type == token.EOF {
+			return last // Dosya sonundan sonra hep EOF gelir
+		}
+		tok, doc := p.scan()
+		p.lookahead = append(p.lookahead, bufferedToken{tok: tok, doc: doc})
+	}
+	return p.lookahead[n-2].tok
 }
 
 // peekTokenIs, bir sonraki token'ın belirli bir türde olup olmadığını kontrol eder.
@@ -36,8 +68,8 @@ func (p *Parser) expectPeek(t token.TokenType) bool {
 
 // peekError, beklenen token türü ile ilgili bir hata ekler.
 func (p *Parser) peekError(t token.TokenType) {
-	msg := fmt.Sprintf("Satır %d, Sütun %d: %s bekleniyordu, %s alındı",
-		p.peekToken.Line, p.peekToken.Column, t, p.peekToken.Type)
+	msg := fmt.Sprintf("%s: %s bekleniyordu, %s alındı",
+		p.peekToken.Position, t, p.peekToken.Type)
 	p.errors = append(p.errors, msg)
 }
 
@@ -70,8 +102,8 @@ func (p *Parser) expectToken(t token.TokenType) bool {
 		return true
 	}
 
-	msg := fmt.Sprintf("Satır %d, Sütun %d: %s bekleniyordu, %s alındı",
-		p.curToken.Line, p.curToken.Column, t, p.curToken.Type)
+	msg := fmt.Sprintf("%s: %s bekleniyordu, %s alındı",
+		p.curToken.Position, t, p.curToken.Type)
 	p.errors = append(p.errors, msg)
 	return false
 }
@@ -0,0 +1,194 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// numberLiteralKind, bir sayı literalinin önekine göre türünü adlandırır.
+func numberLiteralKind(prefix byte) string {
+	switch prefix {
+	case 'x':
+		return "onaltılık"
+	case 'o', '0':
+		return "sekizlik"
+	case 'b':
+		return "ikili"
+	default:
+		return "onluk"
+	}
+}
+
+// isHexDigit, bir karakterin onaltılık rakam olup olmadığını kontrol eder.
+func isHexDigit(ch byte) bool {
+	return '0' <= ch && ch <= '9' || 'a' <= lower(ch) && lower(ch) <= 'f'
+}
+
+// lower, bir ASCII harfi küçük harfe çevirir.
+func lower(ch byte) byte {
+	return ch | ('a' - 'A')
+}
+
+// scanDigits, lit[i:] içindeki rakamları ve '_' ayırıcılarını tüketir.
+// Onaltılık tabanda a-f harfleri de rakam sayılır. Tabana uymayan ilk
+// onluk rakamın konumu invalid ile döndürülür (yoksa -1).
+func scanDigits(lit string, i, base int) (next, count, invalid int) {
+	invalid = -1
+	for ; i < len(lit); i++ {
+		ch := lit[i]
+		switch {
+		case ch == '_':
+			continue
+		case '0' <= ch && ch <= '9':
+			if int(ch-'0') >= base && invalid < 0 {
+				invalid = i
+			}
+		case base == 16 && isHexDigit(ch):
+		default:
+			return i, count, invalid
+		}
+		count++
+	}
+	return i, count, invalid
+}
+
+// invalidSeparator, '_' ayırıcısının yanlış kullanıldığı ilk konumu döndürür.
+// Ayırıcılar yalnızca ardışık rakamlar arasında veya taban önekinden sonra
+// kullanılabilir. Hata yoksa -1 döner.
+func invalidSeparator(lit string) int {
+	hex := false
+	prev := byte('.') // '_', '0' (rakam) veya '.' (diğer)
+	i := 0
+
+	// Taban öneki bir rakam gibi davranır
+	if len(lit) >= 2 && lit[0] == '0' {
+		switch lower(lit[1]) {
+		case 'x':
+			hex = true
+			fallthrough
+		case 'o', 'b':
+			prev = '0'
+			i = 2
+		}
+	}
+
+	for ; i < len(lit); i++ {
+		p := prev
+		ch := lit[i]
+		switch {
+		case ch == '_':
+			if p != '0' {
+				return i
+			}
+			prev = '_'
+		case '0' <= ch && ch <= '9' || hex && isHexDigit(ch):
+			prev = '0'
+		default:
+			if p == '_' {
+				return i - 1
+			}
+			prev = '.'
+		}
+	}
+
+	if prev == '_' {
+		return len(lit) - 1
+	}
+	return -1
+}
+
+// checkNumberLiteral, bir sayı literalini Go sözdizimine göre doğrular ve
+// literalin ondalık (float) olup olmadığını döndürür. Literal hatalıysa
+// açıklayıcı bir hata mesajı döner.
+func checkNumberLiteral(lit string) (bool, string) {
+	var prefix byte
+	base := 10
+	i := 0
+
+	if len(lit) >= 2 && lit[0] == '0' {
+		switch lower(lit[1]) {
+		case 'x':
+			prefix, base, i = 'x', 16, 2
+		case 'o':
+			prefix, base, i = 'o', 8, 2
+		case 'b':
+			prefix, base, i = 'b', 2, 2
+		default:
+			// Eski stil sekizlik literal (örn. 0755); ondalık sayı da olabilir
+			prefix = '0'
+		}
+	}
+	kind := numberLiteralKind(prefix)
+
+	// Mantisin tam kısmı
+	digitBase := base
+	if prefix == '0' {
+		digitBase = 10 // 09.5 geçerli bir ondalık sayıdır; sekizlik kontrolü aşağıda
+	}
+	i, count, invalid := scanDigits(lit, i, digitBase)
+
+	// Kesir kısmı
+	isFloat := false
+	if i < len(lit) && lit[i] == '.' {
+		if prefix == 'o' || prefix == 'b' {
+			return false, fmt.Sprintf("%s literalde ondalık nokta kullanılamaz", kind)
+		}
+		isFloat = true
+		var fraction, fracInvalid int
+		i, fraction, fracInvalid = scanDigits(lit, i+1, digitBase)
+		count += fraction
+		if invalid < 0 {
+			invalid = fracInvalid
+		}
+	}
+
+	if count == 0 {
+		return false, fmt.Sprintf("%s literal rakam içermiyor", kind)
+	}
+
+	// Üs kısmı
+	if i < len(lit) {
+		if e := lower(lit[i]); e == 'e' || e == 'p' {
+			switch {
+			case e == 'e' && prefix != 0 && prefix != '0':
+				return false, fmt.Sprintf("'%c' üssü onluk mantis gerektirir", lit[i])
+			case e == 'p' && prefix != 'x':
+				return false, fmt.Sprintf("'%c' üssü onaltılık mantis gerektirir", lit[i])
+			}
+			isFloat = true
+			i++
+			if i < len(lit) && (lit[i] == '+' || lit[i] == '-') {
+				i++
+			}
+			var exponent, expInvalid int
+			i, exponent, expInvalid = scanDigits(lit, i, 10)
+			if exponent == 0 || expInvalid >= 0 {
+				return false, "üs rakam içermiyor"
+			}
+		} else if prefix == 'x' && isFloat {
+			return false, "onaltılık ondalık sayıda 'p' üssü gerekli"
+		}
+	} else if prefix == 'x' && isFloat {
+		return false, "onaltılık ondalık sayıda 'p' üssü gerekli"
+	}
+
+	if i < len(lit) {
+		return false, fmt.Sprintf("%s literalde geçersiz karakter %q", kind, lit[i:i+1])
+	}
+
+	// Eski stil sekizlik literaller yalnızca tamsayı olduğunda 8 ve 9 içeremez
+	if prefix == '0' && !isFloat {
+		if pos := strings.IndexAny(lit, "89"); pos >= 0 {
+			invalid = pos
+		}
+	}
+	if invalid >= 0 && (!isFloat || prefix != '0') {
+		return false, fmt.Sprintf("%s literalde geçersiz rakam '%c'", kind, lit[invalid])
+	}
+
+	if invalidSeparator(lit) >= 0 {
+		return false, "'_' yalnızca rakamları ayırmak için kullanılabilir"
+	}
+
+	return isFloat, ""
+}
@@ -18,10 +18,30 @@ type Parser struct {
 	curToken  token.Token
 	peekToken token.Token
 
+	// peekToken'dan sonraki token'lar için ileri bakış arabelleği (bkz. lookAhead)
+	lookahead []bufferedToken
+
+	// Yorumlar (yalnızca lexer.ScanComments modunda doldurulur)
+	curDoc   *ast.CommentGroup   // curToken'ın belge yorumu
+	peekDoc  *ast.CommentGroup   // peekToken'ın belge yorumu
+	comments []*ast.CommentGroup // Şimdiye kadar okunan tüm yorum grupları
+	lastLine int                 // Lexer'dan okunan yorum olmayan son token'ın satırı
+
+	// İfade iç içelik düzeyi: parantez ve köşeli parantezlerde artar, kontrol
+	// deyimi başlıklarında -1 olur. Negatifken '{' bir bileşik değişmez değil,
+	// deyimin gövdesini başlatır.
+	exprLev int
+
 	prefixParseFns map[token.TokenType]prefixParseFn
 	infixParseFns  map[token.TokenType]infixParseFn
 }
 
+// bufferedToken, ileri bakış arabelleğindeki bir token ve belge yorumudur.
+type bufferedToken struct {
+	tok token.Token
+	doc *ast.CommentGroup
+}
+
 // New, yeni bir Parser oluşturur.
 func New(l *lexer.Lexer) *Parser {
 	p := &Parser{
@@ -52,5 +72,6 @@ func (p *Parser) ParseProgram() *ast.Program {
 		}
 		p.nextToken()
 	}
+	program.Comments = p.comments
 	return program
 }
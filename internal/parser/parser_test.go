@@ -219,6 +219,1061 @@ func TestExpressions(t *testing.T) {
 	}
 }
 
+func TestNumberLiterals(t *testing.T) {
+	tests := []struct {
+		input    string
+		intValue int64
+		isFloat  bool
+		float    float64
+	}{
+		{"0x1F;", 31, false, 0},
+		{"0o17;", 15, false, 0},
+		{"0b1010;", 10, false, 0},
+		{"017;", 15, false, 0},
+		{"1_000_000;", 1000000, false, 0},
+		{"1e-9;", 0, true, 1e-9},
+		{".5;", 0, true, 0.5},
+		{"0x1p-2;", 0, true, 0.25},
+		{"0x1.8p1;", 0, true, 3},
+		{"089.5;", 0, true, 89.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+			if !ok {
+				t.Fatalf("expected *ast.ExpressionStatement, got %T", program.Statements[0])
+			}
+
+			if tt.isFloat {
+				lit, ok := stmt.Expression.(*ast.FloatLiteral)
+				if !ok {
+					t.Fatalf("expected *ast.FloatLiteral, got %T", stmt.Expression)
+				}
+				if lit.Value != tt.float {
+					t.Errorf("expected %g, got %g", tt.float, lit.Value)
+				}
+				return
+			}
+
+			lit, ok := stmt.Expression.(*ast.IntegerLiteral)
+			if !ok {
+				t.Fatalf("expected *ast.IntegerLiteral, got %T", stmt.Expression)
+			}
+			if lit.Value != tt.intValue {
+				t.Errorf("expected %d, got %d", tt.intValue, lit.Value)
+			}
+		})
+	}
+}
+
+func TestBigIntegerLiteral(t *testing.T) {
+	program, errors := parseProgram("123456789012345678901234567890;")
+	testutil.AssertNoErrors(t, errors)
+
+	lit := program.Statements[0].(*ast.ExpressionStatement).Expression.(*ast.IntegerLiteral)
+	if lit.Constant == nil || lit.Constant.String() != "123456789012345678901234567890" {
+		t.Errorf("expected exact constant, got %v", lit.Constant)
+	}
+}
+
+func TestImaginaryLiterals(t *testing.T) {
+	tests := []struct {
+		input string
+		value float64
+	}{
+		{"2i;", 2},
+		{"1.5i;", 1.5},
+		{".5i;", 0.5},
+		{"1e2i;", 100},
+		{"0x1p-2i;", 0.25},
+		{"0b101i;", 5},
+		{"0789i;", 789},
+		{"1_000i;", 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+			if !ok {
+				t.Fatalf("expected *ast.ExpressionStatement, got %T", program.Statements[0])
+			}
+			lit, ok := stmt.Expression.(*ast.ImaginaryLiteral)
+			if !ok {
+				t.Fatalf("expected *ast.ImaginaryLiteral, got %T", stmt.Expression)
+			}
+			if lit.Value != tt.value {
+				t.Errorf("expected %g, got %g", tt.value, lit.Value)
+			}
+		})
+	}
+}
+
+func TestMalformedNumberLiterals(t *testing.T) {
+	tests := []testutil.ParserTestCase{
+		{Name: "Binary digit", Input: "0b102;", ErrorMsg: "ikili literalde geçersiz rakam '2'"},
+		{Name: "Octal digit", Input: "0o78;", ErrorMsg: "sekizlik literalde geçersiz rakam '8'"},
+		{Name: "Legacy octal digit", Input: "0129;", ErrorMsg: "sekizlik literalde geçersiz rakam '9'"},
+		{Name: "Empty hex", Input: "0x;", ErrorMsg: "onaltılık literal rakam içermiyor"},
+		{Name: "Hex float without exponent", Input: "0x1.5;", ErrorMsg: "'p' üssü gerekli"},
+		{Name: "Missing exponent digits", Input: "1e+;", ErrorMsg: "üs rakam içermiyor"},
+		{Name: "Trailing separator", Input: "1_000_;", ErrorMsg: "'_' yalnızca rakamları ayırmak için"},
+		{Name: "Double separator", Input: "1__0;", ErrorMsg: "'_' yalnızca rakamları ayırmak için"},
+		{Name: "Invalid suffix", Input: "12abc;", ErrorMsg: "geçersiz karakter \"a\""},
+		{Name: "Imaginary binary digit", Input: "0b102i;", ErrorMsg: "ikili literalde geçersiz rakam '2'"},
+		{Name: "Imaginary without digits", Input: "0xi;", ErrorMsg: "onaltılık literal rakam içermiyor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, errors := parseProgram(tt.Input)
+			testutil.AssertErrorContains(t, errors, tt.ErrorMsg)
+		})
+	}
+}
+
+func TestDocComments(t *testing.T) {
+	input := `// Paket yorumu
+
+// Sayaç, işlem sayısını tutar.
+var sayac = 0 // satır sonu yorumu
+
+/* Kisi bir kişiyi temsil eder. */
+class Kisi {
+}
+
+// Selamla, kişiyi selamlar.
+// İkinci satır.
+func (Kisi) Selamla() {
+}
+
+// Serbest yorum
+
+func main() {
+	// Gövde yorumu
+	return
+}
+`
+
+	l := lexer.NewWithMode(input, lexer.ScanComments)
+	p := New(l)
+	program := p.ParseProgram()
+	testutil.AssertNoErrors(t, p.Errors())
+
+	if len(program.Statements) != 4 {
+		t.Fatalf("expected 4 statements, got %d", len(program.Statements))
+	}
+
+	docs := []struct {
+		doc  *ast.CommentGroup
+		want string
+	}{
+		{program.Statements[0].(*ast.VarStatement).Doc, "Sayaç, işlem sayısını tutar.\n"},
+		{program.Statements[1].(*ast.ClassStatement).Doc, "Kisi bir kişiyi temsil eder.\n"},
+		{program.Statements[2].(*ast.MethodStatement).Doc, "Selamla, kişiyi selamlar.\nİkinci satır.\n"},
+		{program.Statements[3].(*ast.FunctionStatement).Doc, ""},
+	}
+	for i, tt := range docs {
+		if got := tt.doc.Text(); got != tt.want {
+			t.Errorf("statement %d: expected doc %q, got %q", i, tt.want, got)
+		}
+	}
+
+	var texts []string
+	for _, group := range program.Comments {
+		texts = append(texts, group.Text())
+	}
+	expected := []string{
+		"Paket yorumu\n",
+		"Sayaç, işlem sayısını tutar.\n",
+		"satır sonu yorumu\n",
+		"Kisi bir kişiyi temsil eder.\n",
+		"Selamla, kişiyi selamlar.\nİkinci satır.\n",
+		"Serbest yorum\n",
+		"Gövde yorumu\n",
+	}
+	if len(texts) != len(expected) {
+		t.Fatalf("expected %d comment groups, got %d: %q", len(expected), len(texts), texts)
+	}
+	for i := range expected {
+		if texts[i] != expected[i] {
+			t.Errorf("comment group %d: expected %q, got %q", i, expected[i], texts[i])
+		}
+	}
+
+	// Varsayılan modda yorumlar atlanır
+	program, errors := parseProgram(input)
+	testutil.AssertNoErrors(t, errors)
+	if len(program.Comments) != 0 || program.Statements[0].(*ast.VarStatement).Doc != nil {
+		t.Errorf("expected no comments without ScanComments mode")
+	}
+}
+
+func TestTemplateInstantiation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Foo<int>(x);", "Foo<int>(x)"},
+		{"Pair<string, Kisi>(a, b);", "Pair<string, Kisi>(a, b)"},
+		{"Map<string, List<int> >(m);", "Map<string, List<int>>(m)"},
+		{"koleksiyon.Max<T>(a, b);", "koleksiyon.Max<T>(a, b)"},
+		{"a < b;", "(a < b)"},
+		{"a < b > c;", "((a < b) > c)"},
+		{"f(a < b, c > d);", "f((a < b), (c > d))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if len(program.Statements) != 1 {
+				t.Fatalf("expected 1 statement, got %d", len(program.Statements))
+			}
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBitwiseOperatorPrecedence(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"flags & MASK;", "(flags & MASK)"},
+		{"a + b & c;", "(a + (b & c))"},
+		{"a | b ^ c;", "((a | b) ^ c)"},
+		{"a | b & c;", "(a | (b & c))"},
+		{"a &^ b | c;", "((a &^ b) | c)"},
+		{"1 << 2 + 3;", "((1 << 2) + 3)"},
+		{"x >> n * 2;", "((x >> n) * 2)"},
+		{"a | b == c;", "((a | b) == c)"},
+		{"a & b != 0 && c;", "(((a & b) != 0) && c)"},
+		{"^a & b;", "((^a) & b)"},
+		{"~a | b;", "((~a) | b)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if len(program.Statements) != 1 {
+				t.Fatalf("expected 1 statement, got %d", len(program.Statements))
+			}
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAssignExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		operator string
+		expected string
+	}{
+		{"x = y + 1;", "=", "(x = (y + 1))"},
+		{"sum += x;", "+=", "(sum += x)"},
+		{"n -= 2 * k;", "-=", "(n -= (2 * k))"},
+		{"p *= 3;", "*=", "(p *= 3)"},
+		{"q /= 4;", "/=", "(q /= 4)"},
+		{"r %= 5;", "%=", "(r %= 5)"},
+		{"flags &= MASK;", "&=", "(flags &= MASK)"},
+		{"flags |= 1 << 3;", "|=", "(flags |= (1 << 3))"},
+		{"flags ^= bit;", "^=", "(flags ^= bit)"},
+		{"flags &^= bit;", "&^=", "(flags &^= bit)"},
+		{"a[f()] <<= 1;", "<<=", "((a[f()]) <<= 1)"},
+		{"p.x >>= n;", ">>=", "(p.x >>= n)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if len(program.Statements) != 1 {
+				t.Fatalf("expected 1 statement, got %d", len(program.Statements))
+			}
+			stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+			if !ok {
+				t.Fatalf("expected *ast.ExpressionStatement, got %T", program.Statements[0])
+			}
+			assign, ok := stmt.Expression.(*ast.AssignExpression)
+			if !ok {
+				t.Fatalf("expected *ast.AssignExpression, got %T", stmt.Expression)
+			}
+			if assign.Operator != tt.operator {
+				t.Errorf("expected operator %q, got %q", tt.operator, assign.Operator)
+			}
+			if got := assign.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBranchStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"break;", []string{"break"}},
+		{"continue;", []string{"continue"}},
+		{"break dis;", []string{"break dis"}},
+		{"continue dis\n", []string{"continue dis"}},
+		{"goto son;", []string{"goto son"}},
+		{"dis: while x { break dis }", []string{"dis: while x { break dis }"}},
+		{"son: x = 1;", []string{"son: (x = 1)"}},
+		{"goto son\nson:\n", []string{"goto son", "son:"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if len(program.Statements) != len(tt.expected) {
+				t.Fatalf("expected %d statements, got %d", len(tt.expected), len(program.Statements))
+			}
+			for i, expected := range tt.expected {
+				if got := program.Statements[i].String(); got != expected {
+					t.Errorf("statement %d: expected %q, got %q", i, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRangeStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"for i, v := range xs { }", "for i, v := range xs {  }"},
+		{"for k := range m { }", "for k := range m {  }"},
+		{"for _, r := range \"çay\" { }", "for _, r := range \"çay\" {  }"},
+		{"for v := range ch { }", "for v := range ch {  }"},
+		{"for i := range 10 { }", "for i := range 10 {  }"},
+		{"for range xs { }", "for range xs {  }"},
+		{"for i, v = range xs { }", "for i, v = range xs {  }"},
+		{"for a[0] = range xs { }", "for (a[0]) = range xs {  }"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if len(program.Statements) != 1 {
+				t.Fatalf("expected 1 statement, got %d", len(program.Statements))
+			}
+			if _, ok := program.Statements[0].(*ast.RangeStatement); !ok {
+				t.Fatalf("expected *ast.RangeStatement, got %T", program.Statements[0])
+			}
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRangeRequiresAssignment(t *testing.T) {
+	_, errors := parseProgram("for i range xs { }")
+	testutil.AssertErrorContains(t, errors, "range öncesinde := veya = bekleniyordu")
+}
+
+func TestGotoRequiresLabel(t *testing.T) {
+	_, errors := parseProgram("goto;")
+	testutil.AssertErrorContains(t, errors, "IDENT bekleniyordu")
+}
+
+func TestTypeStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"type Point struct { x, y int }", "type Point struct { x int; y int }"},
+		{"type Empty struct {}", "type Empty struct {  }"},
+		{"struct Block {\n Size uint64\n Used uint64\n}", "type Block struct { Size uint64; Used uint64 }"},
+		{"type User struct {\n Name string `json:\"name\"`\n Base\n io.Reader\n}",
+			"type User struct { Name string `json:\"name\"`; Base; io.Reader }"},
+		{"type Grid struct { cells [4]int; rows []Row }", "type Grid struct { cells [4]int; rows []Row }"},
+		{"type Outer struct { inner struct { a int } }", "type Outer struct { inner struct { a int } }"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if len(program.Statements) != 1 {
+				t.Fatalf("expected 1 statement, got %d", len(program.Statements))
+			}
+			stmt, ok := program.Statements[0].(*ast.TypeStatement)
+			if !ok {
+				t.Fatalf("expected *ast.TypeStatement, got %T", program.Statements[0])
+			}
+			if got := stmt.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEmbeddedStructField(t *testing.T) {
+	program, errors := parseProgram("type Admin struct { User; level int }")
+	testutil.AssertNoErrors(t, errors)
+
+	st := program.Statements[0].(*ast.TypeStatement).Type.(*ast.StructType)
+	if len(st.Fields) != 2 {
+		t.Fatalf("expected 2 fields, got %d", len(st.Fields))
+	}
+	if !st.Fields[0].Embedded() || st.Fields[0].FieldName() != "User" {
+		t.Errorf("expected embedded field User, got %q", st.Fields[0].String())
+	}
+	if st.Fields[1].Embedded() || st.Fields[1].FieldName() != "level" {
+		t.Errorf("expected named field level, got %q", st.Fields[1].String())
+	}
+}
+
+func TestEmbeddedPointerField(t *testing.T) {
+	program, errors := parseProgram("type Admin struct { *User; *io.Reader `tag`; level int }")
+	testutil.AssertNoErrors(t, errors)
+
+	st := program.Statements[0].(*ast.TypeStatement).Type.(*ast.StructType)
+	if len(st.Fields) != 3 {
+		t.Fatalf("expected 3 fields, got %d", len(st.Fields))
+	}
+	if !st.Fields[0].Embedded() || st.Fields[0].FieldName() != "User" {
+		t.Errorf("expected embedded field User, got %q", st.Fields[0].String())
+	}
+	if _, ok := st.Fields[0].Type.(*ast.PointerType); !ok {
+		t.Errorf("expected *ast.PointerType, got %T", st.Fields[0].Type)
+	}
+	if !st.Fields[1].Embedded() || st.Fields[1].FieldName() != "Reader" || st.Fields[1].Tag == nil {
+		t.Errorf("expected tagged embedded field Reader, got %q", st.Fields[1].String())
+	}
+
+	_, errors = parseProgram("type Bad struct { *[]int }")
+	testutil.AssertErrorContains(t, errors, "gömülü işaretçi alanı bir tip adını göstermelidir")
+}
+
+func TestNamedTypesAndAliases(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		alias    bool
+	}{
+		{"type Celsius float64", "type Celsius float64", false},
+		{"type IntList []int", "type IntList []int", false},
+		{"type Handler func(int) error", "type Handler func(int) error", false},
+		{"type Visitor func(n Node, depth int)", "type Visitor func(Node, int)", false},
+		{"type Factory func() func(string) bool", "type Factory func() func(string) bool", false},
+		{"type Alias = Other", "type Alias = Other", true},
+		{"type R = io.Reader", "type R = io.Reader", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if len(program.Statements) != 1 {
+				t.Fatalf("expected 1 statement, got %d", len(program.Statements))
+			}
+			stmt, ok := program.Statements[0].(*ast.TypeStatement)
+			if !ok {
+				t.Fatalf("expected *ast.TypeStatement, got %T", program.Statements[0])
+			}
+			if stmt.Alias != tt.alias {
+				t.Errorf("expected Alias=%v, got %v", tt.alias, stmt.Alias)
+			}
+			if got := stmt.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompositeLiterals(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"p := Point{x: 1, y: 2}", "(p := Point{x: 1, y: 2})"},
+		{"p := Point{1, 2}", "(p := Point{1, 2})"},
+		{"p := Point{}", "(p := Point{})"},
+		{"p := Point{\n x: 1,\n y: 2,\n}", "(p := Point{x: 1, y: 2})"},
+		{"r := io.Reader{}", "(r := io.Reader{})"},
+		{"l := Line{a: Point{1, 2}, b: Point{3, 4}}", "(l := Line{a: Point{1, 2}, b: Point{3, 4}})"},
+		{"v := struct { a int }{1}", "(v := struct { a int }{1})"},
+		{"f(Point{1, 2})", "f(Point{1, 2})"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if got := program.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompositeLiteralNotInControlClause(t *testing.T) {
+	// Kontrol deyimi başlıklarında '{' gövdeyi başlatır
+	tests := []string{
+		"if ok { x = 1 }",
+		"while ok { x = 1 }",
+		"switch x { case 1: y = 2 }",
+		"for i := range xs { x = 1 }",
+		"if (p == Point{1, 2}) { x = 1 }",
+		"if ok { p := Point{1, 2} }",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			program, errors := parseProgram(input)
+			testutil.AssertNoErrors(t, errors)
+			if len(program.Statements) != 1 {
+				t.Fatalf("expected 1 statement, got %d: %s", len(program.Statements), program.String())
+			}
+		})
+	}
+}
+
+func TestInterfaceTypes(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"type Shape interface { Area() int; Scale(f int) }", "type Shape interface { Area() int; Scale(f int) }"},
+		{"type Any interface {}", "type Any interface{}"},
+		{"type ReadCloser interface {\n Reader\n io.Closer\n}", "type ReadCloser interface { Reader; io.Closer }"},
+		{"interface Handler {\n func Serve(w Writer) bool\n}", "type Handler interface { Serve(w Writer) bool }"},
+		{"type Box interface { Item() interface{} }", "type Box interface { Item() interface{} }"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if len(program.Statements) != 1 {
+				t.Fatalf("expected 1 statement, got %d", len(program.Statements))
+			}
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTypeAssertions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"p := s.(Point)", "(p := s.(Point))"},
+		{"n := v.(io.Reader)", "(n := v.(io.Reader))"},
+		{"a := s.(Shape).Area()", "(a := s.(Shape).Area())"},
+		{"var e interface{} = x", "var e interface{} = x;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if got := program.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTypeSwitchStatements(t *testing.T) {
+	input := `switch v := s.(type) {
+case Square, Circle:
+	x = 1
+case nil:
+	x = 2
+default:
+	x = 3
+}`
+
+	program, errors := parseProgram(input)
+	testutil.AssertNoErrors(t, errors)
+
+	stmt, ok := program.Statements[0].(*ast.TypeSwitchStatement)
+	if !ok {
+		t.Fatalf("expected *ast.TypeSwitchStatement, got %T", program.Statements[0])
+	}
+	if stmt.Binding == nil || stmt.Binding.Value != "v" {
+		t.Errorf("expected binding v, got %v", stmt.Binding)
+	}
+	if stmt.Subject.String() != "s" {
+		t.Errorf("expected subject s, got %q", stmt.Subject.String())
+	}
+	if len(stmt.Cases) != 3 {
+		t.Fatalf("expected 3 cases, got %d", len(stmt.Cases))
+	}
+	if len(stmt.Cases[0].Values) != 2 {
+		t.Errorf("expected 2 types in first case, got %d", len(stmt.Cases[0].Values))
+	}
+	if _, ok := stmt.Cases[1].Values[0].(*ast.NullLiteral); !ok {
+		t.Errorf("expected nil case, got %T", stmt.Cases[1].Values[0])
+	}
+
+	// Bağlamasız tip switch'i
+	program, errors = parseProgram("switch s.(type) { case int: x = 1 }")
+	testutil.AssertNoErrors(t, errors)
+	if stmt, ok := program.Statements[0].(*ast.TypeSwitchStatement); !ok || stmt.Binding != nil {
+		t.Errorf("expected type switch without binding, got %s", program.String())
+	}
+}
+
+func TestMethodReceivers(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"func (s Square) Area() int { return s.side }", "func (s Square) Area() int { return s.side; }"},
+		{"func (Square) Name() string { return \"kare\" }", "func (Square) Name() string { return \"kare\"; }"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if _, ok := program.Statements[0].(*ast.MethodStatement); !ok {
+				t.Fatalf("expected *ast.MethodStatement, got %T", program.Statements[0])
+			}
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFunctionSignatures(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"func f(a, b int, s string) int { return a }", "func f(a int, b int, s string) int { return a; }"},
+		{"func div(a, b int) (int, error) { return a / b, nil }", "func div(a int, b int) (int, error) { return (a / b), nil; }"},
+		{"func split(n int) (x, y int) { return }", "func split(n int) (x int, y int) { return ; }"},
+		{"func one() (int) { return 1 }", "func one() int { return 1; }"},
+		{"func add(x, y) { return x + y }", "func add(x, y) { return (x + y); }"},
+		{"func apply(f func(int) (int, bool), xs []int) {}", "func apply(f func(int) (int, bool), xs []int) {  }"},
+		{"func (p Point) Scale(f, g float64) (Point, bool) { return p, true }", "func (p Point) Scale(f float64, g float64) (Point, bool) { return p, true; }"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if len(program.Statements) != 1 {
+				t.Fatalf("expected 1 statement, got %d", len(program.Statements))
+			}
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMixedNamedParameters(t *testing.T) {
+	_, errors := parseProgram("func f(a int, b) {}")
+	if len(errors) == 0 {
+		t.Fatal("expected an error for mixed named and unnamed parameters")
+	}
+}
+
+func TestMultiAssignStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		left     int
+		values   int
+	}{
+		{"x, err := f()", "x, err := f()", 2, 1},
+		{"a, b = b, a", "a, b = b, a", 2, 2},
+		{"p.x, xs[0], _ = 1, 2, 3", "p.x, (xs[0]), _ = 1, 2, 3", 3, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			stmt, ok := program.Statements[0].(*ast.MultiAssignStatement)
+			if !ok {
+				t.Fatalf("expected *ast.MultiAssignStatement, got %T", program.Statements[0])
+			}
+			if len(stmt.Left) != tt.left || len(stmt.Values) != tt.values {
+				t.Errorf("expected %d targets and %d values, got %d and %d", tt.left, tt.values, len(stmt.Left), len(stmt.Values))
+			}
+			if got := stmt.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestVariadicFunctions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"func sum(xs ...int) int { return 0 }", "func sum(xs ...int) int { return 0; }"},
+		{"func printf(format string, args ...interface{}) {}", "func printf(format string, args ...interface{}) {  }"},
+		{"type F func(int, ...string)", "type F func(int, ...string)"},
+		{"sum(1, 2, 3)", "sum(1, 2, 3)"},
+		{"sum(nums...)", "sum(nums...)"},
+		{"printf(\"%d\", xs...,)", "printf(\"%d\", xs...)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestInvalidVariadicParameters(t *testing.T) {
+	inputs := []string{
+		"func f(xs ...int, y int) {}",
+		"func f(a, b ...int) {}",
+		"func f() (...int) {}",
+	}
+
+	for _, input := range inputs {
+		if _, errors := parseProgram(input); len(errors) == 0 {
+			t.Errorf("expected an error for %q", input)
+		}
+	}
+}
+
+func TestDeferStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"defer f()", "defer f();"},
+		{"defer fmt.Println(\"x\", i)", "defer fmt.Println(\"x\", i);"},
+		{"defer c.Report(\"tag\")", "defer c.Report(\"tag\");"},
+		{"defer func() { recover() }()", "defer func() { recover() }();"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			stmt, ok := program.Statements[0].(*ast.DeferStatement)
+			if !ok {
+				t.Fatalf("expected *ast.DeferStatement, got %T", program.Statements[0])
+			}
+			if got := stmt.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestInvalidDeferStatements(t *testing.T) {
+	inputs := []string{
+		"defer f",
+		"defer 1 + 2",
+		"defer",
+	}
+
+	for _, input := range inputs {
+		if _, errors := parseProgram(input); len(errors) == 0 {
+			t.Errorf("expected an error for %q", input)
+		}
+	}
+}
+
+func TestGoStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"go worker(jobs)", "go worker(jobs);"},
+		{"go c.Serve(conn)", "go c.Serve(conn);"},
+		{"go func() { runtime.Gosched() }()", "go func() { runtime.Gosched() }();"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			stmt, ok := program.Statements[0].(*ast.GoStatement)
+			if !ok {
+				t.Fatalf("expected *ast.GoStatement, got %T", program.Statements[0])
+			}
+			if got := stmt.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestInvalidGoStatements(t *testing.T) {
+	inputs := []string{
+		"go worker",
+		"go 1 + 2",
+		"go",
+	}
+
+	for _, input := range inputs {
+		if _, errors := parseProgram(input); len(errors) == 0 {
+			t.Errorf("expected an error for %q", input)
+		}
+	}
+}
+
+func TestChannelStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"var ch chan int", "var ch chan int;"},
+		{"var in <-chan string", "var in <-chan string;"},
+		{"var out chan<- bool", "var out chan<- bool;"},
+		{"ch := make(chan int, 4)", "(ch := make(chan int, 4))"},
+		{"ch <- v + 1", "ch <- (v + 1)"},
+		{"v := <-ch", "(v := (<-ch))"},
+		{"v, ok := <-ch", "v, ok := (<-ch)"},
+		{"close(ch)", "close(ch)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSelectStatements(t *testing.T) {
+	input := `select {
+case v := <-in:
+	use(v)
+case v, ok := <-in:
+	use(v, ok)
+case out <- 1:
+case <-done:
+	return
+default:
+}`
+	program, errors := parseProgram(input)
+	testutil.AssertNoErrors(t, errors)
+
+	stmt, ok := program.Statements[0].(*ast.SelectStatement)
+	if !ok {
+		t.Fatalf("expected *ast.SelectStatement, got %T", program.Statements[0])
+	}
+	if len(stmt.Cases) != 5 {
+		t.Fatalf("expected 5 cases, got %d", len(stmt.Cases))
+	}
+	if _, ok := stmt.Cases[2].Comm.(*ast.SendStatement); !ok {
+		t.Errorf("expected a send case, got %T", stmt.Cases[2].Comm)
+	}
+	if stmt.Cases[4].Comm != nil {
+		t.Errorf("expected the last case to be default, got %s", stmt.Cases[4].Comm.String())
+	}
+}
+
+func TestInvalidSelectStatements(t *testing.T) {
+	inputs := []string{
+		"select { case f(): }",
+		"select { default: default: }",
+		"select { case x := 1: }",
+	}
+
+	for _, input := range inputs {
+		if _, errors := parseProgram(input); len(errors) == 0 {
+			t.Errorf("expected an error for %q", input)
+		}
+	}
+}
+
+func TestMapStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"var m map[string]int", "var m map[string]int;"},
+		{"var g map[Point][]string", "var g map[Point][]string;"},
+		{"var n map[string]map[int]bool", "var n map[string]map[int]bool;"},
+		{"m := make(map[string]int, 8)", "(m := make(map[string]int, 8))"},
+		{`m := map[string]int{"a": 1, "b": 2}`, `(m := map[string]int{"a": 1, "b": 2})`},
+		{`m["a"] = 3`, `((m["a"]) = 3)`},
+		{"v, ok := m[k]", "v, ok := (m[k])"},
+		{`delete(m, "a")`, `delete(m, "a")`},
+		{`m := map[string][]int{"a": {1, 2}}`, `(m := map[string][]int{"a": []int{1, 2}})`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSliceExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"s[1:3]", "(s[1:3])"},
+		{"s[:n]", "(s[:n])"},
+		{"s[i:]", "(s[i:])"},
+		{"s[:]", "(s[:])"},
+		{"s[i+1:len(s)-1]", "(s[(i + 1):(len(s) - 1)])"},
+		{"b := []byte(s)", "(b := []byte(s))"},
+		{"xs[:n:n]", "(xs[:n:n])"},
+		{"xs[1:3:5]", "(xs[1:3:5])"},
+		{"grid[i][j:]", "((grid[i])[j:])"},
+		{"n := copy(dst, src[1:])", "(n := copy(dst, (src[1:])))"},
+		{"g := [][]int{{1, 2}, {3}}", "(g := [][]int{[]int{1, 2}, []int{3}})"},
+		{"a := [3]int{4, 5}", "(a := [3]int{4, 5})"},
+		{"a := [1]", "(a := [1])"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestInvalidSliceExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"xs[1::3]", "3 indeksli dilimlemede ikinci indeks gereklidir"},
+		{"xs[::3]", "3 indeksli dilimlemede ikinci indeks gereklidir"},
+		{"xs[1:2:]", "3 indeksli dilimlemede üçüncü indeks gereklidir"},
+	}
+
+	for _, tt := range tests {
+		_, errors := parseProgram(tt.input)
+		testutil.AssertErrorContains(t, errors, tt.expected)
+	}
+}
+
+func TestPointerExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"p := &x", "(p := (&x))"},
+		{"*p = 5", "((*p) = 5)"},
+		{"y := *p + 1", "(y := ((*p) + 1))"},
+		{"a * b", "(a * b)"},
+		{"a & b", "(a & b)"},
+		{"q := &Point{1, 2}", "(q := (&Point{1, 2}))"},
+		{"var p *int", "var p *int;"},
+		{"var pp **Point = nil", "var pp **Point = nil;"},
+		{"func f(p *int) *int { return p }", "func f(p *int) *int { return p; }"},
+		{"func (p *Point) Move(dx int) { p.x += dx }", "func (p *Point) Move(dx int) { (p.x += dx) }"},
+		{"func (*Point) Zero() {}", "func (*Point) Zero() {  }"},
+		{"func (c *Channel<T>) Send(value T) {}", "func (c *Channel<T>) Send(value T) {  }"},
+		{"func (m Map<K, V>) Get(key K) V { return m.zero }", "func (m Map<K, V>) Get(key K) V { return m.zero; }"},
+		{"unsafe { p := alloc(int, 4); free(p) }", "unsafe { (p := alloc(int, 4))free(p) }"},
+		{"unsafe { delete(p) }", "unsafe { delete(p) }"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			program, errors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, errors)
+
+			if got := program.Statements[0].String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestInvalidPointerExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"func (p *) Move() {}", "metot alıcısı için tip adı bekleniyordu"},
+		{"func (c *Channel<T,>) Send() {}", "IDENT bekleniyordu, > alındı"},
+		{"func (c *Channel<T) Send() {}", "> bekleniyordu, ) alındı"},
+		{"var p *", "tip bekleniyordu"},
+		{"unsafe free(p)", "{ bekleniyordu, FREE alındı"},
+	}
+
+	for _, tt := range tests {
+		_, errors := parseProgram(tt.input)
+		testutil.AssertErrorContains(t, errors, tt.expected)
+	}
+}
+
+func TestLookAhead(t *testing.T) {
+	p := New(lexer.New("a b c"))
+
+	if tok := p.lookAhead(2); tok.Literal != "c" {
+		t.Errorf("lookAhead(2): expected c, got %q", tok.Literal)
+	}
+	if tok := p.lookAhead(10); tok.Type != "EOF" {
+		t.Errorf("lookAhead(10): expected EOF, got %q", tok.Type)
+	}
+
+	// Arabelleğe alınan token'lar sırayla tüketilmeli
+	for _, expected := range []string{"b", "c", "\n"} {
+		p.nextToken()
+		if p.curToken.Literal != expected {
+			t.Errorf("expected %q, got %q", expected, p.curToken.Literal)
+		}
+	}
+}
+
 func testVarStatement(t *testing.T, s ast.Statement) bool {
 	t.Helper()
 
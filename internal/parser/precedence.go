@@ -6,13 +6,13 @@ import "github.com/inkbytefo/go-minus/internal/token"
 const (
 	_ int = iota
 	LOWEST
-	ASSIGN      // =
+	ASSIGN      // =, +=, -=, ...
 	LOGICAL_OR  // ||
 	LOGICAL_AND // &&
 	EQUALS      // ==
 	LESSGREATER // > veya <
-	SUM         // +
-	PRODUCT     // *
+	SUM         // +, -, | veya ^
+	PRODUCT     // *, /, %, <<, >>, & veya &^
 	PREFIX      // -X veya !X
 	POSTFIX     // X++ veya X--
 	CALL        // myFunction(X)
@@ -22,25 +22,42 @@ const (
 
 // Operatör öncelik tablosu
 var precedences = map[token.TokenType]int{
-	token.ASSIGN:      ASSIGN,
-	token.DEFINE:      ASSIGN,
-	token.EQ:          EQUALS,
-	token.NOT_EQ:      EQUALS,
-	token.LT:          LESSGREATER,
-	token.GT:          LESSGREATER,
-	token.LTOEQ:       LESSGREATER,
-	token.GTOEQ:       LESSGREATER,
-	token.PLUS:        SUM,
-	token.MINUS:       SUM,
-	token.SLASH:       PRODUCT,
-	token.ASTERISK:    PRODUCT,
-	token.MODULO:      PRODUCT,
-	token.INCREMENT:   POSTFIX,
-	token.DECREMENT:   POSTFIX,
-	token.LPAREN:      CALL,
-	token.LBRACKET:    INDEX,
-	token.DOT:         MEMBER,
-	token.ARROW:       MEMBER,
-	token.LOGICAL_AND: LOGICAL_AND,
-	token.LOGICAL_OR:  LOGICAL_OR,
+	token.ASSIGN:             ASSIGN,
+	token.DEFINE:             ASSIGN,
+	token.PLUS_ASSIGN:        ASSIGN,
+	token.MINUS_ASSIGN:       ASSIGN,
+	token.MUL_ASSIGN:         ASSIGN,
+	token.DIV_ASSIGN:         ASSIGN,
+	token.MOD_ASSIGN:         ASSIGN,
+	token.AND_ASSIGN:         ASSIGN,
+	token.OR_ASSIGN:          ASSIGN,
+	token.XOR_ASSIGN:         ASSIGN,
+	token.AND_NOT_ASSIGN:     ASSIGN,
+	token.LEFT_SHIFT_ASSIGN:  ASSIGN,
+	token.RIGHT_SHIFT_ASSIGN: ASSIGN,
+	token.EQ:                 EQUALS,
+	token.NOT_EQ:             EQUALS,
+	token.LT:                 LESSGREATER,
+	token.GT:                 LESSGREATER,
+	token.LTOEQ:              LESSGREATER,
+	token.GTOEQ:              LESSGREATER,
+	token.PLUS:               SUM,
+	token.MINUS:              SUM,
+	token.SLASH:              PRODUCT,
+	token.ASTERISK:           PRODUCT,
+	token.MODULO:             PRODUCT,
+	token.BIT_OR:             SUM,
+	token.BIT_XOR:            SUM,
+	token.BIT_AND:            PRODUCT,
+	token.AND_NOT:            PRODUCT,
+	token.LEFT_SHIFT:         PRODUCT,
+	token.RIGHT_SHIFT:        PRODUCT,
+	token.INCREMENT:          POSTFIX,
+	token.DECREMENT:          POSTFIX,
+	token.LPAREN:             CALL,
+	token.LBRACKET:           INDEX,
+	token.DOT:                MEMBER,
+	token.ARROW:              MEMBER,
+	token.LOGICAL_AND:        LOGICAL_AND,
+	token.LOGICAL_OR:         LOGICAL_OR,
 }
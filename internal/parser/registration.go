@@ -10,15 +10,24 @@ func (p *Parser) registerPrefixFunctions() {
 	p.registerPrefix(token.IDENT, p.parseIdentifier)
 	p.registerPrefix(token.INT, p.parseIntegerLiteral)
 	p.registerPrefix(token.FLOAT, p.parseFloatLiteral)
+	p.registerPrefix(token.IMAG, p.parseImaginaryLiteral)
 	p.registerPrefix(token.STRING, p.parseStringLiteral)
 	p.registerPrefix(token.CHAR, p.parseCharLiteral)
 	p.registerPrefix(token.TRUE, p.parseBooleanLiteral)
 	p.registerPrefix(token.FALSE, p.parseBooleanLiteral)
 	p.registerPrefix(token.NULL, p.parseNullLiteral)
+	p.registerPrefix(token.DELETE, p.parseBuiltinKeyword) // delete(m, k), unsafe bloklarında delete(p)
+	p.registerPrefix(token.ALLOC, p.parseBuiltinKeyword)  // alloc(T), alloc(T, n)
+	p.registerPrefix(token.FREE, p.parseBuiltinKeyword)   // free(p)
 	
 	// Prefix operators
 	p.registerPrefix(token.BANG, p.parsePrefixExpression)
 	p.registerPrefix(token.MINUS, p.parsePrefixExpression)
+	p.registerPrefix(token.BIT_XOR, p.parsePrefixExpression) // ^x: bit düzeyinde tümleyen
+	p.registerPrefix(token.BIT_NOT, p.parsePrefixExpression) // ~x: bit düzeyinde tümleyen
+	p.registerPrefix(token.ASTERISK, p.parsePrefixExpression) // *p: işaretçinin gösterdiği değer
+	p.registerPrefix(token.BIT_AND, p.parsePrefixExpression)  // &x: değişkenin adresi
+	p.registerPrefix(token.LARROW, p.parseReceiveExpression) // <-ch: kanaldan alma
 	
 	// Grouping and collections
 	p.registerPrefix(token.LPAREN, p.parseGroupedExpression)
@@ -36,6 +45,12 @@ func (p *Parser) registerPrefixFunctions() {
 	
 	// Template system
 	p.registerPrefix(token.TEMPLATE, p.parseTemplateExpression)
+
+	// Type literals
+	p.registerPrefix(token.STRUCT, p.parseStructTypeExpression)
+	p.registerPrefix(token.INTERFACE, p.parseInterfaceTypeExpression)
+	p.registerPrefix(token.CHAN, p.parseChanTypeExpression)
+	p.registerPrefix(token.MAP, p.parseMapTypeExpression)
 }
 
 // registerInfixFunctions, tüm infix ayrıştırma fonksiyonlarını kaydeder.
@@ -49,6 +64,14 @@ func (p *Parser) registerInfixFunctions() {
 	p.registerInfix(token.ASTERISK, p.parseInfixExpression)
 	p.registerInfix(token.MODULO, p.parseInfixExpression)
 	
+	// Bitwise and shift operators
+	p.registerInfix(token.BIT_AND, p.parseInfixExpression)
+	p.registerInfix(token.BIT_OR, p.parseInfixExpression)
+	p.registerInfix(token.BIT_XOR, p.parseInfixExpression)
+	p.registerInfix(token.AND_NOT, p.parseInfixExpression)
+	p.registerInfix(token.LEFT_SHIFT, p.parseInfixExpression)
+	p.registerInfix(token.RIGHT_SHIFT, p.parseInfixExpression)
+	
 	// Comparison operators
 	p.registerInfix(token.EQ, p.parseInfixExpression)
 	p.registerInfix(token.NOT_EQ, p.parseInfixExpression)
@@ -69,6 +92,17 @@ func (p *Parser) registerInfixFunctions() {
 	
 	// Assignment operators
 	p.registerInfix(token.ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.PLUS_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.MINUS_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.MUL_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.DIV_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.MOD_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.AND_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.OR_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.XOR_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.AND_NOT_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.LEFT_SHIFT_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.RIGHT_SHIFT_ASSIGN, p.parseAssignExpression)
 	p.registerInfix(token.DEFINE, p.parseShortVarDeclExpression)
 	
 	// Postfix operators
@@ -10,6 +10,9 @@ func (p *Parser) parseStatement() ast.Statement {
 	var stmt ast.Statement
 
 	switch p.curToken.Type {
+	case token.SEMICOLON:
+		// Boş deyim (örn. otomatik eklenen noktalı virgül)
+		return nil
 	case token.PACKAGE:
 		stmt = p.parsePackageStatement()
 	case token.IMPORT:
@@ -28,8 +31,24 @@ func (p *Parser) parseStatement() ast.Statement {
 		stmt = p.parseWhileStatement()
 	case token.SWITCH:
 		stmt = p.parseSwitchStatement()
+	case token.SELECT:
+		stmt = p.parseSelectStatement()
 	case token.CLASS:
 		stmt = p.parseClassStatement()
+	case token.TYPE:
+		stmt = p.parseTypeStatement()
+	case token.STRUCT:
+		if p.peekTokenIs(token.IDENT) {
+			stmt = p.parseStructDeclaration()
+		} else {
+			stmt = p.parseExpressionStatement()
+		}
+	case token.INTERFACE:
+		if p.peekTokenIs(token.IDENT) {
+			stmt = p.parseInterfaceDeclaration()
+		} else {
+			stmt = p.parseExpressionStatement()
+		}
 	case token.FUNC:
 		if p.peekTokenIs(token.LPAREN) {
 			stmt = p.parseMethodStatement()
@@ -40,10 +59,22 @@ func (p *Parser) parseStatement() ast.Statement {
 		stmt = p.parseTryCatchStatement()
 	case token.THROW:
 		stmt = p.parseThrowStatement()
+	case token.DEFER:
+		stmt = p.parseDeferStatement()
+	case token.GO:
+		stmt = p.parseGoStatement()
 	case token.SCOPE:
 		stmt = p.parseScopeStatement()
+	case token.UNSAFE:
+		stmt = p.parseUnsafeStatement()
+	case token.BREAK, token.CONTINUE, token.GOTO:
+		stmt = p.parseBranchStatement()
 	default:
-		stmt = p.parseExpressionStatement()
+		if p.curTokenIs(token.IDENT) && p.peekTokenIs(token.COLON) {
+			stmt = p.parseLabeledStatement()
+		} else {
+			stmt = p.parseExpressionStatement()
+		}
 	}
 
 	// Hata durumunda senkronize et
@@ -56,7 +87,7 @@ func (p *Parser) parseStatement() ast.Statement {
 
 // parseVarStatement, bir değişken tanımlama ifadesini ayrıştırır.
 func (p *Parser) parseVarStatement() *ast.VarStatement {
-	stmt := &ast.VarStatement{Token: p.curToken}
+	stmt := &ast.VarStatement{Token: p.curToken, Doc: p.curDoc}
 
 	if !p.expectPeek(token.IDENT) {
 		return nil
@@ -65,33 +96,9 @@ func (p *Parser) parseVarStatement() *ast.VarStatement {
 	stmt.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
 
 	// Opsiyonel tip
-	if p.peekTokenIs(token.IDENT) {
-		p.nextToken()
-		stmt.Type = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
-	} else if p.peekTokenIs(token.LBRACKET) {
-		p.nextToken()
-		// Dizi tipi
-		// TODO: Dizi tipi ayrıştırma eklenecek
-	} else if p.peekTokenIs(token.FUNC) {
+	if typeStartTokens[p.peekToken.Type] {
 		p.nextToken()
-		// Fonksiyon tipi
-		// TODO: Fonksiyon tipi ayrıştırma eklenecek
-	} else if p.peekTokenIs(token.MAP) {
-		p.nextToken()
-		// Map tipi
-		// TODO: Map tipi ayrıştırma eklenecek
-	} else if p.peekTokenIs(token.CHAN) {
-		p.nextToken()
-		// Kanal tipi
-		// TODO: Kanal tipi ayrıştırma eklenecek
-	} else if p.peekTokenIs(token.INTERFACE) {
-		p.nextToken()
-		// Arayüz tipi
-		// TODO: Arayüz tipi ayrıştırma eklenecek
-	} else if p.peekTokenIs(token.STRUCT) {
-		p.nextToken()
-		// Struct tipi
-		// TODO: Struct tipi ayrıştırma eklenecek
+		stmt.Type = p.parseType()
 	}
 
 	// Opsiyonel değer
@@ -153,8 +160,9 @@ func (p *Parser) parseConstStatement() *ast.ConstStatement {
 		}
 
 		// Diğer sabitler (şu anda tek bir sabit destekleniyor, çoklu sabit için genişletilebilir)
-		for p.peekTokenIs(token.COMMA) {
-			p.nextToken() // ',' token'ını atla
+		// Sabitler ',' veya (satır sonlarında otomatik eklenen) ';' ile ayrılır
+		for p.peekTokenIs(token.COMMA) || p.peekTokenIs(token.SEMICOLON) {
+			p.nextToken() // ',' veya ';' token'ını atla
 
 			if p.peekTokenIs(token.IDENT) {
 				p.nextToken()
@@ -193,10 +201,16 @@ func (p *Parser) parseConstStatement() *ast.ConstStatement {
 func (p *Parser) parseReturnStatement() *ast.ReturnStatement {
 	stmt := &ast.ReturnStatement{Token: p.curToken}
 
-	p.nextToken()
+	// Dönüş değeri yoksa return'ü ';', '}' veya dosya sonu izler
+	if !p.peekTokenIs(token.SEMICOLON) && !p.peekTokenIs(token.RBRACE) && !p.peekTokenIs(token.EOF) {
+		p.nextToken()
+		stmt.ReturnValues = append(stmt.ReturnValues, p.parseExpression(LOWEST))
 
-	if !p.curTokenIs(token.SEMICOLON) {
-		stmt.ReturnValue = p.parseExpression(LOWEST)
+		for p.peekTokenIs(token.COMMA) {
+			p.nextToken()
+			p.nextToken()
+			stmt.ReturnValues = append(stmt.ReturnValues, p.parseExpression(LOWEST))
+		}
 	}
 
 	if p.peekTokenIs(token.SEMICOLON) {
@@ -206,12 +220,128 @@ func (p *Parser) parseReturnStatement() *ast.ReturnStatement {
 	return stmt
 }
 
+// parseBranchStatement, bir break, continue veya goto ifadesini ayrıştırır.
+// break ve continue için etiket isteğe bağlıdır, goto için zorunludur.
+func (p *Parser) parseBranchStatement() ast.Statement {
+	stmt := &ast.BranchStatement{Token: p.curToken}
+
+	if p.peekTokenIs(token.IDENT) {
+		p.nextToken()
+		stmt.Label = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+	} else if stmt.Token.Type == token.GOTO {
+		p.peekError(token.IDENT)
+		return nil
+	}
+
+	if p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+
+	return stmt
+}
+
+// parseLabeledStatement, etiketli bir ifadeyi ayrıştırır (örn. dis: for { ... }).
+// Etiketten sonra '}' gelirse etiket boş bir ifadeyi işaret eder.
+func (p *Parser) parseLabeledStatement() ast.Statement {
+	stmt := &ast.LabeledStatement{
+		Token: p.curToken,
+		Label: &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal},
+	}
+
+	p.nextToken() // ':'
+
+	if p.peekTokenIs(token.RBRACE) || p.peekTokenIs(token.EOF) {
+		return stmt
+	}
+
+	p.nextToken()
+	stmt.Statement = p.parseStatement()
+
+	return stmt
+}
+
 // parseExpressionStatement, bir ifade cümlesini ayrıştırır.
-func (p *Parser) parseExpressionStatement() *ast.ExpressionStatement {
+func (p *Parser) parseExpressionStatement() ast.Statement {
 	stmt := &ast.ExpressionStatement{Token: p.curToken}
 
 	stmt.Expression = p.parseExpression(LOWEST)
 
+	// '<-' bir kanala gönderme deyimini başlatır (ch <- v)
+	if p.peekTokenIs(token.LARROW) {
+		return p.parseSendStatement(stmt.Expression)
+	}
+
+	// Virgül, birden çok hedefe atamanın başladığını gösterir (x, err := f())
+	if p.peekTokenIs(token.COMMA) {
+		return p.parseMultiAssignStatement(stmt.Expression)
+	}
+
+	if p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+
+	return stmt
+}
+
+// parseSendStatement, kanal ifadesi ayrıştırılmış bir gönderme deyimini
+// ayrıştırır.
+func (p *Parser) parseSendStatement(channel ast.Expression) ast.Statement {
+	p.nextToken()
+	stmt := &ast.SendStatement{Token: p.curToken, Channel: channel}
+
+	p.nextToken()
+	stmt.Value = p.parseExpression(LOWEST)
+	if stmt.Channel == nil || stmt.Value == nil {
+		return nil
+	}
+
+	if p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+
+	return stmt
+}
+
+// parseMultiAssignStatement, ilk hedefi ayrıştırılmış, birden çok hedefe
+// atama yapan bir ifadeyi ayrıştırır (x, err := f() veya a, b = b, a).
+// Hedefler atama önceliğinden yüksek öncelikle ayrıştırılır ki ":=" ve "="
+// hedef listesine dahil olmasın.
+func (p *Parser) parseMultiAssignStatement(first ast.Expression) ast.Statement {
+	stmt := &ast.MultiAssignStatement{Left: []ast.Expression{first}}
+
+	for p.peekTokenIs(token.COMMA) {
+		p.nextToken()
+		p.nextToken()
+		target := p.parseExpression(ASSIGN)
+		if target == nil {
+			return nil
+		}
+		stmt.Left = append(stmt.Left, target)
+	}
+
+	if !p.peekTokenIs(token.DEFINE) && !p.peekTokenIs(token.ASSIGN) {
+		p.addErrorf("%s: çoklu atamada ':=' veya '=' bekleniyordu, %s alındı",
+			p.peekToken.Position, p.peekToken.Type)
+		return nil
+	}
+	p.nextToken()
+	stmt.Token = p.curToken
+	stmt.Operator = p.curToken.Literal
+
+	p.nextToken()
+	stmt.Values = append(stmt.Values, p.parseExpression(LOWEST))
+	for p.peekTokenIs(token.COMMA) {
+		p.nextToken()
+		p.nextToken()
+		stmt.Values = append(stmt.Values, p.parseExpression(LOWEST))
+	}
+
+	for _, value := range stmt.Values {
+		if value == nil {
+			return nil
+		}
+	}
+
 	if p.peekTokenIs(token.SEMICOLON) {
 		p.nextToken()
 	}
@@ -255,8 +385,9 @@ func (p *Parser) parseImportStatement() ast.Statement {
 		}
 
 		// Diğer importlar (şu anda tek bir import destekleniyor, çoklu import için genişletilebilir)
-		for p.peekTokenIs(token.COMMA) {
-			p.nextToken() // ',' token'ını atla
+		// Importlar ',' veya (satır sonlarında otomatik eklenen) ';' ile ayrılır
+		for p.peekTokenIs(token.COMMA) || p.peekTokenIs(token.SEMICOLON) {
+			p.nextToken() // ',' veya ';' token'ını atla
 
 			if p.peekTokenIs(token.STRING) {
 				p.nextToken()
@@ -284,6 +415,11 @@ func (p *Parser) parseBlockStatement() *ast.BlockStatement {
 	block := &ast.BlockStatement{Token: p.curToken}
 	block.Statements = []ast.Statement{}
 
+	// Blok içindeki deyimler, çevreleyen kontrol başlığından etkilenmez
+	prevLev := p.exprLev
+	p.exprLev = 0
+	defer func() { p.exprLev = prevLev }()
+
 	p.nextToken()
 
 	for !p.curTokenIs(token.RBRACE) && !p.curTokenIs(token.EOF) {
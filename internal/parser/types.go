@@ -0,0 +1,561 @@
+package parser
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// parseType, curToken'dan başlayan bir tip ifadesini ayrıştırır: T, paket.T,
+// *T, [N]T, []T, map[K]V, chan T, struct { ... }, interface { ... } veya
+// func(...) T.
+func (p *Parser) parseType() ast.Expression {
+	switch p.curToken.Type {
+	case token.IDENT:
+		var typ ast.Expression = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+		if p.peekTokenIs(token.DOT) {
+			p.nextToken()
+			member := &ast.MemberExpression{Token: p.curToken, Object: typ}
+			if !p.expectPeek(token.IDENT) {
+				return nil
+			}
+			member.Member = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+			typ = member
+		}
+		return typ
+	case token.ASTERISK:
+		pointerType := &ast.PointerType{Token: p.curToken}
+		p.nextToken()
+		pointerType.Elem = p.parseType()
+		if pointerType.Elem == nil {
+			return nil
+		}
+		return pointerType
+	case token.LBRACKET:
+		arrayType := &ast.ArrayType{Token: p.curToken}
+		if !p.peekTokenIs(token.RBRACKET) {
+			p.nextToken()
+			arrayType.Size = p.parseExpression(LOWEST)
+		}
+		if !p.expectPeek(token.RBRACKET) {
+			return nil
+		}
+		p.nextToken()
+		arrayType.ElementType = p.parseType()
+		if arrayType.ElementType == nil {
+			return nil
+		}
+		return arrayType
+	case token.STRUCT:
+		return p.parseStructType()
+	case token.INTERFACE:
+		return p.parseInterfaceType()
+	case token.FUNC:
+		return p.parseFuncType()
+	case token.MAP:
+		return p.parseMapType()
+	case token.CHAN, token.LARROW:
+		return p.parseChanType()
+	default:
+		p.addErrorf("%s: tip bekleniyordu, %s alındı", p.curToken.Position, p.curToken.Type)
+		return nil
+	}
+}
+
+// parseStructType, curToken 'struct' iken bir struct tipini ayrıştırır.
+func (p *Parser) parseStructType() *ast.StructType {
+	return p.parseStructBody(&ast.StructType{Token: p.curToken})
+}
+
+// parseStructBody, peekToken '{' iken struct gövdesini ayrıştırır. Alanlar ';'
+// (veya satır sonu) ile ayrılır; aynı tipteki alanlar virgülle gruplanabilir
+// ve her alan grubu opsiyonel bir string etiket alabilir.
+func (p *Parser) parseStructBody(st *ast.StructType) *ast.StructType {
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+	p.nextToken()
+
+	for !p.curTokenIs(token.RBRACE) {
+		if p.curTokenIs(token.EOF) {
+			p.peekError(token.RBRACE)
+			return nil
+		}
+		if p.curTokenIs(token.SEMICOLON) {
+			p.nextToken()
+			continue
+		}
+
+		fields := p.parseStructFields()
+		if fields == nil {
+			return nil
+		}
+		st.Fields = append(st.Fields, fields...)
+
+		if !p.peekTokenIs(token.SEMICOLON) && !p.peekTokenIs(token.RBRACE) {
+			p.addErrorf("%s: struct alanından sonra ';' veya '}' bekleniyordu, %s alındı",
+				p.peekToken.Position, p.peekToken.Type)
+			return nil
+		}
+		p.nextToken()
+	}
+
+	st.Closing = p.curToken
+	return st
+}
+
+// parseStructFields, tek bir alan bildirimini (a, b T `etiket` veya gömülü
+// bir T ya da *T) ayrıştırır. Gruplanmış alanların her biri ayrı bir
+// StructField olur.
+func (p *Parser) parseStructFields() []*ast.StructField {
+	// Gömülü işaretçi alanı: *T veya *paket.T ve opsiyonel etiket
+	if p.curTokenIs(token.ASTERISK) {
+		star := p.curToken
+		field := &ast.StructField{Type: p.parseType()}
+		if field.Type == nil {
+			return nil
+		}
+		if field.FieldName() == "" {
+			p.addErrorf("%s: gömülü işaretçi alanı bir tip adını göstermelidir: %s",
+				star.Position, field.Type.String())
+			return nil
+		}
+		field.Tag = p.parseFieldTag()
+		return []*ast.StructField{field}
+	}
+
+	if !p.curTokenIs(token.IDENT) {
+		p.addErrorf("%s: struct alanı için tanımlayıcı bekleniyordu, %s alındı",
+			p.curToken.Position, p.curToken.Type)
+		return nil
+	}
+
+	// Gömülü alan: yalnızca bir tip adı (T veya paket.T) ve opsiyonel etiket
+	if p.peekTokenIs(token.SEMICOLON) || p.peekTokenIs(token.RBRACE) ||
+		p.peekTokenIs(token.STRING) || p.peekTokenIs(token.DOT) {
+		field := &ast.StructField{Type: p.parseType()}
+		if field.Type == nil {
+			return nil
+		}
+		field.Tag = p.parseFieldTag()
+		return []*ast.StructField{field}
+	}
+
+	names := []*ast.Identifier{{Token: p.curToken, Value: p.curToken.Literal}}
+	for p.peekTokenIs(token.COMMA) {
+		p.nextToken()
+		if !p.expectPeek(token.IDENT) {
+			return nil
+		}
+		names = append(names, &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal})
+	}
+
+	p.nextToken()
+	typ := p.parseType()
+	if typ == nil {
+		return nil
+	}
+	tag := p.parseFieldTag()
+
+	fields := make([]*ast.StructField, len(names))
+	for i, name := range names {
+		fields[i] = &ast.StructField{Name: name, Type: typ, Tag: tag}
+	}
+	return fields
+}
+
+// parseFieldTag, peekToken bir string ise alan etiketini ayrıştırır.
+func (p *Parser) parseFieldTag() *ast.StringLiteral {
+	if !p.peekTokenIs(token.STRING) {
+		return nil
+	}
+	p.nextToken()
+	return &ast.StringLiteral{Token: p.curToken, Value: p.curToken.Literal}
+}
+
+// typeStartTokens, bir tip ifadesini başlatabilen token'lardır.
+var typeStartTokens = map[token.TokenType]bool{
+	token.IDENT:     true,
+	token.ASTERISK:  true,
+	token.LBRACKET:  true,
+	token.STRUCT:    true,
+	token.INTERFACE: true,
+	token.FUNC:      true,
+	token.MAP:       true,
+	token.CHAN:      true,
+	token.LARROW:    true,
+}
+
+// parseMapType, curToken 'map' iken bir map tipini ayrıştırır: map[K]V.
+func (p *Parser) parseMapType() *ast.MapType {
+	mt := &ast.MapType{Token: p.curToken}
+
+	if !p.expectPeek(token.LBRACKET) {
+		return nil
+	}
+	p.nextToken()
+	mt.Key = p.parseType()
+	if mt.Key == nil || !p.expectPeek(token.RBRACKET) {
+		return nil
+	}
+
+	p.nextToken()
+	mt.Value = p.parseType()
+	if mt.Value == nil {
+		return nil
+	}
+	return mt
+}
+
+// parseMapTypeExpression, ifade konumundaki bir map tipini ayrıştırır;
+// ardından '{' gelirse bir map değişmezi olarak devam eder
+// (ör. map[string]int{"a": 1}).
+func (p *Parser) parseMapTypeExpression() ast.Expression {
+	mt := p.parseMapType()
+	if mt == nil {
+		return nil
+	}
+	if p.compositeLiteralAllowed() {
+		p.nextToken()
+		return p.parseCompositeLiteral(mt)
+	}
+	return mt
+}
+
+// parseChanType, curToken 'chan' veya '<-' iken bir kanal tipini ayrıştırır:
+// chan T, chan<- T veya <-chan T.
+func (p *Parser) parseChanType() *ast.ChanType {
+	ct := &ast.ChanType{Token: p.curToken, Dir: ast.SEND | ast.RECV}
+
+	if p.curTokenIs(token.LARROW) {
+		if !p.expectPeek(token.CHAN) {
+			return nil
+		}
+		ct.Dir = ast.RECV
+	} else if p.peekTokenIs(token.LARROW) {
+		p.nextToken()
+		ct.Dir = ast.SEND
+	}
+
+	p.nextToken()
+	ct.Value = p.parseType()
+	if ct.Value == nil {
+		return nil
+	}
+	return ct
+}
+
+// parseChanTypeExpression, ifade konumundaki bir kanal tipini ayrıştırır
+// (ör. make(chan int, 4)).
+func (p *Parser) parseChanTypeExpression() ast.Expression {
+	ct := p.parseChanType()
+	if ct == nil {
+		return nil
+	}
+	return ct
+}
+
+// parseFuncType, curToken 'func' iken bir fonksiyon tipini ayrıştırır.
+// Parametreler tip olarak yazılır; parametre adları da yazılabilir (func(x
+// int) gibi), bu adlar atılır. Parametre listesinden sonra bir tip veya
+// parantezli bir sonuç listesi gelirse dönüş tipi olarak ayrıştırılır.
+func (p *Parser) parseFuncType() *ast.FuncType {
+	ft := &ast.FuncType{Token: p.curToken}
+
+	if !p.expectPeek(token.LPAREN) {
+		return nil
+	}
+
+	params := p.parseParameterList()
+	if params == nil {
+		return nil
+	}
+	ft.Closing = p.curToken
+
+	params = p.groupParameters(params, true)
+	if params == nil {
+		return nil
+	}
+	for _, param := range params {
+		ft.Parameters = append(ft.Parameters, param.Type)
+	}
+
+	returnType, ok := p.parseResultType()
+	if !ok {
+		return nil
+	}
+	ft.ReturnType = returnType
+
+	return ft
+}
+
+// parseTypeStatement, bir tip bildirimini (type Ad Tip) veya tip takma adını
+// (type Ad = Tip) ayrıştırır.
+func (p *Parser) parseTypeStatement() ast.Statement {
+	stmt := &ast.TypeStatement{Token: p.curToken, Doc: p.curDoc}
+
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+	stmt.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+
+	if p.peekTokenIs(token.ASSIGN) {
+		p.nextToken()
+		stmt.Alias = true
+	}
+
+	p.nextToken()
+	stmt.Type = p.parseType()
+	if stmt.Type == nil {
+		return nil
+	}
+
+	return stmt
+}
+
+// parseStructDeclaration, standart kütüphanede kullanılan struct Ad { ... }
+// kısa yazımını ayrıştırır; bu, type Ad struct { ... } ile eşdeğerdir.
+func (p *Parser) parseStructDeclaration() ast.Statement {
+	stmt := &ast.TypeStatement{Token: p.curToken, Doc: p.curDoc}
+
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+	stmt.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+
+	// Ad ile '{' arasında ayrı bir 'struct' token'ı yoktur
+	structType := p.parseStructBody(&ast.StructType{Token: stmt.Token})
+	if structType == nil {
+		return nil
+	}
+	stmt.Type = structType
+
+	return stmt
+}
+
+// parseStructTypeExpression, ifade konumundaki anonim bir struct tipini
+// ayrıştırır; ardından '{' gelirse bir bileşik değişmez olarak devam eder.
+func (p *Parser) parseStructTypeExpression() ast.Expression {
+	st := p.parseStructType()
+	if st == nil {
+		return nil
+	}
+	if p.peekTokenIs(token.LBRACE) {
+		p.nextToken()
+		return p.parseCompositeLiteral(st)
+	}
+	return st
+}
+
+// compositeLiteralAllowed, peekToken '{' iken bunun bir bileşik değişmezi
+// başlatıp başlatamayacağını söyler. if, for, while ve switch başlıklarında
+// '{' deyimin gövdesini başlattığından bileşik değişmezler parantez içinde
+// yazılmalıdır (Go'daki gibi).
+func (p *Parser) compositeLiteralAllowed() bool {
+	return p.peekTokenIs(token.LBRACE) && p.exprLev >= 0
+}
+
+// elidedTypes, bir dizi, slice veya map değişmezinde tipi yazılmadan verilen
+// ({1, 2}) anahtar ve elemanların tiplerini döndürür; tip çıkarılamıyorsa
+// nil döner.
+// Örnek: [][]int{{1, 2}, {3}}
+func elidedTypes(typ ast.Expression) (key, elem ast.Expression) {
+	switch t := typ.(type) {
+	case *ast.ArrayType:
+		return nil, t.ElementType
+	case *ast.MapType:
+		return t.Key, t.Value
+	}
+	return nil, nil
+}
+
+// parseCompositeLiteral, curToken '{' iken verilen tipin bileşik değişmezini
+// ayrıştırır. Elemanlar ya konumsaldır ya da alan: değer biçimindedir.
+func (p *Parser) parseCompositeLiteral(typ ast.Expression) ast.Expression {
+	lit := &ast.CompositeLiteral{Token: p.curToken, Type: typ}
+
+	prevLev := p.exprLev
+	p.exprLev = 0
+	defer func() { p.exprLev = prevLev }()
+
+	for !p.peekTokenIs(token.RBRACE) {
+		p.nextToken()
+		// Çok satırlı değişmezlerde satır sonları atlanır
+		if p.curTokenIs(token.SEMICOLON) {
+			continue
+		}
+		if p.curTokenIs(token.EOF) {
+			p.peekError(token.RBRACE)
+			return nil
+		}
+
+		keyType, elemType := elidedTypes(typ)
+		if _, isMap := typ.(*ast.MapType); !isMap {
+			keyType = elemType
+		}
+		element := p.parseCompositeElement(keyType)
+		if p.peekTokenIs(token.COLON) {
+			p.nextToken()
+			kv := &ast.KeyValueExpression{Token: p.curToken, Key: element}
+			p.nextToken()
+			kv.Value = p.parseCompositeElement(elemType)
+			element = kv
+		}
+		lit.Elements = append(lit.Elements, element)
+
+		if p.peekTokenIs(token.SEMICOLON) {
+			p.nextToken()
+		}
+		if !p.peekTokenIs(token.RBRACE) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+
+	p.nextToken()
+	lit.Closing = p.curToken
+	return lit
+}
+
+// parseCompositeElement, bir bileşik değişmezin elemanını ayrıştırır. '{'
+// ile başlayan elemanlar tipi yazılmamış iç içe değişmezlerdir ve dış
+// değişmezden çıkarılan elemType tipini alır.
+func (p *Parser) parseCompositeElement(elemType ast.Expression) ast.Expression {
+	if !p.curTokenIs(token.LBRACE) {
+		return p.parseExpression(LOWEST)
+	}
+	if elemType == nil {
+		p.addErrorf("%s: tipi yazılmamış değişmez yalnızca dizi, slice ve map elemanlarında kullanılabilir",
+			p.curToken.Position)
+		return nil
+	}
+	return p.parseCompositeLiteral(elemType)
+}
+
+// parseInterfaceType, curToken 'interface' iken bir arayüz tipini ayrıştırır.
+func (p *Parser) parseInterfaceType() *ast.InterfaceType {
+	return p.parseInterfaceBody(&ast.InterfaceType{Token: p.curToken})
+}
+
+// parseInterfaceBody, peekToken '{' iken arayüz gövdesini ayrıştırır. Her
+// eleman ya bir metot imzası (opsiyonel 'func' ile) ya da gömülü bir arayüz
+// adıdır; elemanlar ';' (veya satır sonu) ile ayrılır.
+func (p *Parser) parseInterfaceBody(it *ast.InterfaceType) *ast.InterfaceType {
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+	p.nextToken()
+
+	for !p.curTokenIs(token.RBRACE) {
+		if p.curTokenIs(token.EOF) {
+			p.peekError(token.RBRACE)
+			return nil
+		}
+		if p.curTokenIs(token.SEMICOLON) {
+			p.nextToken()
+			continue
+		}
+
+		// Standart kütüphanedeki 'func Ad(...)' yazımı
+		if p.curTokenIs(token.FUNC) {
+			if !p.expectPeek(token.IDENT) {
+				return nil
+			}
+		}
+
+		if p.curTokenIs(token.IDENT) && p.peekTokenIs(token.LPAREN) {
+			method := p.parseInterfaceMethod()
+			if method == nil {
+				return nil
+			}
+			it.Methods = append(it.Methods, method)
+		} else {
+			embed := p.parseType()
+			if embed == nil {
+				return nil
+			}
+			it.Embeds = append(it.Embeds, embed)
+		}
+
+		if !p.peekTokenIs(token.SEMICOLON) && !p.peekTokenIs(token.RBRACE) {
+			p.addErrorf("%s: arayüz elemanından sonra ';' veya '}' bekleniyordu, %s alındı",
+				p.peekToken.Position, p.peekToken.Type)
+			return nil
+		}
+		p.nextToken()
+	}
+
+	it.Closing = p.curToken
+	return it
+}
+
+// parseInterfaceMethod, curToken metot adı iken bir arayüz metodunun
+// imzasını ayrıştırır.
+func (p *Parser) parseInterfaceMethod() *ast.InterfaceMethod {
+	method := &ast.InterfaceMethod{Name: &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}}
+
+	p.nextToken()
+	method.Parameters = p.parseFunctionParameters()
+	if method.Parameters == nil {
+		return nil
+	}
+
+	// Opsiyonel dönüş tipi
+	returnType, ok := p.parseResultType()
+	if !ok {
+		return nil
+	}
+	method.ReturnType = returnType
+
+	return method
+}
+
+// parseInterfaceDeclaration, standart kütüphanede kullanılan interface Ad { ... }
+// kısa yazımını ayrıştırır; bu, type Ad interface { ... } ile eşdeğerdir.
+func (p *Parser) parseInterfaceDeclaration() ast.Statement {
+	stmt := &ast.TypeStatement{Token: p.curToken, Doc: p.curDoc}
+
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+	stmt.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
+
+	// Ad ile '{' arasında ayrı bir 'interface' token'ı yoktur
+	interfaceType := p.parseInterfaceBody(&ast.InterfaceType{Token: stmt.Token})
+	if interfaceType == nil {
+		return nil
+	}
+	stmt.Type = interfaceType
+
+	return stmt
+}
+
+// parseInterfaceTypeExpression, ifade konumundaki anonim bir arayüz tipini
+// ayrıştırır (ör. tip iddialarında veya dönüşümlerde interface{}).
+func (p *Parser) parseInterfaceTypeExpression() ast.Expression {
+	it := p.parseInterfaceType()
+	if it == nil {
+		return nil
+	}
+	return it
+}
+
+// parseTypeAssertion, curToken '.' ve peekToken '(' iken bir tip iddiasını
+// (x.(T)) veya tip switch'lerindeki x.(type) biçimini ayrıştırır.
+func (p *Parser) parseTypeAssertion(object ast.Expression) ast.Expression {
+	exp := &ast.TypeAssertExpression{Token: p.curToken, Expression: object}
+
+	p.nextToken() // '('
+	p.nextToken()
+	if !p.curTokenIs(token.TYPE) {
+		exp.Type = p.parseType()
+		if exp.Type == nil {
+			return nil
+		}
+	}
+
+	if !p.expectPeek(token.RPAREN) {
+		return nil
+	}
+	exp.Closing = p.curToken
+
+	return exp
+}
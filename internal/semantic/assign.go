@@ -0,0 +1,35 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// checkAssignable, bir atama hedefinin atanabilir olup olmadığını kontrol eder.
+// Değişkenler, indeks ifadeleri (a[i]), üye erişimleri (p.x) ve işaretçinin
+// gösterdiği değer (*p) atanabilir; sabitler ve değişmez olan string'lerin
+// baytları (s[i]) atanamaz. Hedef bu fonksiyondan önce analiz edilmiş olmalıdır.
+func (a *Analyzer) checkAssignable(tok token.Token, target ast.Expression) bool {
+	switch left := target.(type) {
+	case *ast.Identifier:
+		if symbol := a.currentScope.Resolve(left.Value); symbol != nil && symbol.IsConst {
+			a.reportError(tok, "Sabite atama yapılamaz: %s", left.Value)
+			return false
+		}
+	case *ast.IndexExpression:
+		if a.stringIndexes[left] {
+			a.reportError(tok, "String elemanlarına atama yapılamaz: %s", left.Left.String())
+			return false
+		}
+	case *ast.MemberExpression:
+	case *ast.PrefixExpression:
+		if left.Operator != "*" {
+			a.reportError(tok, "Atama operatörünün sol tarafı bir değişken olmalıdır")
+			return false
+		}
+	default:
+		a.reportError(tok, "Atama operatörünün sol tarafı bir değişken olmalıdır")
+		return false
+	}
+	return true
+}
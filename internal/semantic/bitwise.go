@@ -0,0 +1,62 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// isIntegerType, bir tipin (dayanak tipine göre) tamsayı tipi olup olmadığını kontrol eder.
+func isIntegerType(t Type) bool {
+	basicType, ok := underlyingType(t).(*BasicType)
+	return ok && basicType.Kind == INTEGER_TYPE
+}
+
+// constantIntValue, bir ifade tamsayı sabiti ise (örn. 3 veya -3) değerini döndürür.
+func constantIntValue(expr ast.Expression) (int64, bool) {
+	switch e := expr.(type) {
+	case *ast.IntegerLiteral:
+		return e.Value, true
+	case *ast.PrefixExpression:
+		if e.Operator == "-" {
+			if value, ok := constantIntValue(e.Right); ok {
+				return -value, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// checkBitwiseOperands, &, |, ^ ve &^ operatörlerinin işlenenlerini kontrol eder.
+// Her iki taraf da tamsayı olmalıdır; sonuç sol tarafın tipindedir.
+func (a *Analyzer) checkBitwiseOperands(tok token.Token, operator string, leftType, rightType Type) Type {
+	if !isIntegerType(leftType) {
+		a.reportError(tok, "%s operatörünün sol tarafı tamsayı tipinde olmalıdır", operator)
+	}
+	if !isIntegerType(rightType) {
+		a.reportError(tok, "%s operatörünün sağ tarafı tamsayı tipinde olmalıdır", operator)
+	}
+	return leftType
+}
+
+// checkShiftOperands, << ve >> operatörlerinin işlenenlerini kontrol eder.
+// Kaydırılan değer ve kaydırma sayısı tamsayı olmalıdır; kaydırma sayısı
+// sabit ise negatif olamaz. Sonuç sol tarafın tipindedir.
+func (a *Analyzer) checkShiftOperands(tok token.Token, operator string, leftType, rightType Type, count ast.Expression) Type {
+	if !isIntegerType(leftType) {
+		a.reportError(tok, "%s operatörünün sol tarafı tamsayı tipinde olmalıdır", operator)
+	}
+	if !isIntegerType(rightType) {
+		a.reportError(tok, "Kaydırma sayısı tamsayı tipinde olmalıdır")
+	} else if value, ok := constantIntValue(count); ok && value < 0 {
+		a.reportError(tok, "Kaydırma sayısı negatif olamaz: %d", value)
+	}
+	return leftType
+}
+
+// checkComplementOperand, ^x ve ~x önek operatörlerinin işlenenini kontrol eder.
+func (a *Analyzer) checkComplementOperand(tok token.Token, operator string, rightType Type) Type {
+	if !isIntegerType(rightType) {
+		a.reportError(tok, "%s operatörü tamsayı tipinde olmalıdır", operator)
+	}
+	return rightType
+}
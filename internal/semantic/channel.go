@@ -0,0 +1,149 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// chanAssignable, çift yönlü bir kanalın aynı eleman tipindeki yönlü bir
+// kanala atanıp atanamayacağını kontrol eder (chan T -> <-chan T).
+func chanAssignable(value, target Type) bool {
+	valueChan, ok := value.(*ChanType)
+	if !ok || valueChan.Dir != ast.SEND|ast.RECV {
+		return false
+	}
+	targetChan, ok := underlyingType(target).(*ChanType)
+	return ok && valueChan.ElementType.Equals(targetChan.ElementType)
+}
+
+// channelOperand, bir kanal işleminin işlenenini denetler. İşlenen dir
+// yönündeki işleme izin veren bir kanal değilse hata raporlanır ve nil döner.
+func (a *Analyzer) channelOperand(tok token.Token, t Type, dir ast.ChanDir, op string) *ChanType {
+	if isUnknownType(t) {
+		return nil
+	}
+	ch, ok := underlyingType(t).(*ChanType)
+	if !ok {
+		a.reportError(tok, "%s işlemi bir kanal gerektirir, %s alındı", op, t.String())
+		return nil
+	}
+	if ch.Dir&dir == 0 {
+		a.reportError(tok, "%s işlemi %s tipindeki kanalda kullanılamaz", op, t.String())
+		return nil
+	}
+	return ch
+}
+
+// analyzeReceiveExpression, bir kanaldan alma ifadesini (<-ch) analiz eder.
+// Sonuç kanalın eleman tipindedir.
+func (a *Analyzer) analyzeReceiveExpression(expr *ast.PrefixExpression, chanType Type) Type {
+	ch := a.channelOperand(expr.Token, chanType, ast.RECV, "Alma")
+	if ch == nil {
+		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	}
+	return ch.ElementType
+}
+
+// receiveOkTypes, v, ok := <-ch biçimindeki bir alma işleminin değer ve
+// başarı tiplerini döndürür; ifade bir alma işlemi değilse nil döner.
+func (a *Analyzer) receiveOkTypes(expr ast.Expression) []Type {
+	prefix, ok := expr.(*ast.PrefixExpression)
+	if !ok || prefix.Operator != "<-" {
+		return nil
+	}
+	return []Type{a.analyzeExpression(prefix), &BasicType{Name: "bool", Kind: BOOLEAN_TYPE}}
+}
+
+// analyzeSendStatement, bir kanala gönderme deyimini analiz eder. Değer
+// kanalın eleman tipine atanabilir olmalıdır.
+func (a *Analyzer) analyzeSendStatement(stmt *ast.SendStatement) Type {
+	chanType := a.analyzeExpression(stmt.Channel)
+	valueType := a.analyzeExpression(stmt.Value)
+
+	if ch := a.channelOperand(stmt.Token, chanType, ast.SEND, "Gönderme"); ch != nil {
+		if !a.isAssignableType(valueType, ch.ElementType) {
+			a.reportError(stmt.Token, "%s tipindeki değer %s tipindeki kanala gönderilemez",
+				valueType.String(), chanType.String())
+		}
+	}
+
+	return &BasicType{Name: "void", Kind: VOID_TYPE}
+}
+
+// analyzeSelectStatement, bir select deyimini analiz eder. Her case kendi
+// kapsamına sahiptir; alma işleminde := ile tanımlanan değişkenler yalnızca
+// case gövdesinde görünür.
+func (a *Analyzer) analyzeSelectStatement(stmt *ast.SelectStatement) Type {
+	for _, clause := range stmt.Cases {
+		prevScope := a.currentScope
+		a.currentScope = NewScope(prevScope)
+
+		if clause.Comm != nil {
+			a.analyzeStatement(clause.Comm)
+		}
+		for _, bodyStmt := range clause.Body {
+			a.analyzeStatement(bodyStmt)
+		}
+
+		a.currentScope = prevScope
+	}
+
+	return &BasicType{Name: "void", Kind: VOID_TYPE}
+}
+
+// isBuiltin, bir tanımlayıcının gölgelenmemiş yerleşik bir fonksiyonu
+// gösterip göstermediğini belirler.
+func (a *Analyzer) isBuiltin(expr ast.Expression, name string) bool {
+	ident, ok := expr.(*ast.Identifier)
+	if !ok || ident.Value != name {
+		return false
+	}
+	symbol := a.currentScope.Resolve(name)
+	return symbol != nil && symbol == a.globalScope.Symbols[name]
+}
+
+// analyzeMakeCall, make(T, boyut...) çağrısını analiz eder. Sonuç ilk
+// argümanda verilen tiptir; kanallar opsiyonel bir kapasite, slice'lar bir
+// uzunluk ve opsiyonel bir kapasite, map'ler opsiyonel bir boyut alır.
+func (a *Analyzer) analyzeMakeCall(expr *ast.CallExpression) Type {
+	if len(expr.Arguments) == 0 {
+		a.reportError(expr.Token, "make fonksiyonu en az 1 argüman alır")
+		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	}
+
+	t := a.resolveType(expr.Arguments[0])
+	minArgs, maxArgs := 1, 2
+	switch underlyingType(t).(type) {
+	case *ChanType, *MapType:
+	case *ArrayType:
+		minArgs, maxArgs = 2, 3
+	default:
+		if !isUnknownType(t) {
+			a.reportError(expr.Token, "make fonksiyonu %s tipi ile kullanılamaz", t.String())
+		}
+	}
+
+	if n := len(expr.Arguments); n < minArgs || n > maxArgs {
+		a.reportError(expr.Token, "make(%s) için yanlış argüman sayısı: %d alındı", t.String(), n)
+	}
+	for _, arg := range expr.Arguments[1:] {
+		if sizeType := a.analyzeExpression(arg); !isUnknownType(sizeType) && !isIntegerType(sizeType) {
+			a.reportError(expr.Token, "make boyutu tamsayı olmalıdır, %s alındı", sizeType.String())
+		}
+	}
+
+	return t
+}
+
+// analyzeCloseCall, close(ch) çağrısını analiz eder. Yalnızca alınabilen
+// kanallar kapatılamaz.
+func (a *Analyzer) analyzeCloseCall(expr *ast.CallExpression) Type {
+	if len(expr.Arguments) != 1 {
+		a.reportError(expr.Token, "close fonksiyonu 1 argüman alır, %d verildi", len(expr.Arguments))
+	} else {
+		chanType := a.analyzeExpression(expr.Arguments[0])
+		a.channelOperand(expr.Token, chanType, ast.SEND, "Kapatma")
+	}
+
+	return &BasicType{Name: "void", Kind: VOID_TYPE}
+}
@@ -52,12 +52,21 @@ type SemanticError struct {
 	Hints   []string
 }
 
+// Position, hatanın kaynak konumunu (dosya adı, satır, sütun) döndürür.
+func (se *SemanticError) Position() token.Position {
+	pos := se.Token.Position
+	if !pos.IsValid() {
+		pos.Line, pos.Column = se.Token.Line, se.Token.Column
+	}
+	return pos
+}
+
 // String, hatanın string temsilini döndürür.
 func (se *SemanticError) String() string {
 	var builder strings.Builder
 
 	// Dosya ve konum bilgisi
-	builder.WriteString(fmt.Sprintf("Satır %d, Sütun %d: ", se.Token.Line, se.Token.Column))
+	builder.WriteString(se.Position().String() + ": ")
 
 	// Hata seviyesi
 	builder.WriteString(fmt.Sprintf("%s%s\033[0m: ", se.Level.Color(), se.Level.String()))
@@ -0,0 +1,309 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// functionContext, gövdesi analiz edilen fonksiyonun sonuçlarını tutar;
+// return ifadeleri bu bilgiyle denetlenir.
+type functionContext struct {
+	results []Type // Sonuç tipleri; void fonksiyonlarda boş
+	named   bool   // Sonuçlar adlandırılmış mı? (yalın return'e izin verir)
+	checked bool   // Dönüş tipi yazılmamış eski fonksiyonlarda return'ler denetlenmez
+}
+
+// resultTypes, bir fonksiyonun dönüş tipini sonuç tiplerine ayırır.
+func resultTypes(t Type) []Type {
+	switch rt := t.(type) {
+	case *TupleType:
+		return rt.Types
+	case *BasicType:
+		if rt.Kind == VOID_TYPE {
+			return nil
+		}
+	}
+	return []Type{t}
+}
+
+// collectFunctions, global fonksiyonları imzalarıyla birlikte tanımlar;
+// böylece fonksiyonlar bildirimlerinden önce çağrılabilir. Dönüş tipi
+// yazılmamış fonksiyonlar eski sözdiziminde değer döndürebildiğinden sonuç
+// tipleri bilinmeyen kabul edilir.
+func (a *Analyzer) collectFunctions(stmts []ast.Statement) {
+	declared := make(map[string]bool)
+	for _, stmt := range stmts {
+		fn, ok := stmt.(*ast.FunctionStatement)
+		if !ok {
+			continue
+		}
+
+		name := fn.Name.Value
+		if declared[name] {
+			a.reportError(fn.Name.Token, "Fonksiyon zaten tanımlı: %s", name)
+			continue
+		}
+		declared[name] = true
+
+		symbol := a.currentScope.Define(name, FUNCTION_TYPE, fn.Name.Token)
+		symbol.DataType = a.functionSignature(fn)
+	}
+}
+
+// functionSignature, bir fonksiyon bildiriminin tipini oluşturur.
+func (a *Analyzer) functionSignature(fn *ast.FunctionStatement) *FunctionType {
+	funcType := a.methodSignature(fn.Parameters, fn.ReturnType)
+	if fn.ReturnType == nil {
+		funcType.ReturnType = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	}
+	return funcType
+}
+
+// analyzeFunctionStatement, bir fonksiyon bildiriminin gövdesini analiz eder.
+func (a *Analyzer) analyzeFunctionStatement(stmt *ast.FunctionStatement) Type {
+	// Global fonksiyonların imzaları collectFunctions sırasında çözümlenmiştir;
+	// hatalar tekrar raporlanmasın diye burada yalnızca aranır
+	var funcType *FunctionType
+	if symbol := a.currentScope.Resolve(stmt.Name.Value); symbol != nil && a.currentScope == a.globalScope {
+		funcType, _ = symbol.DataType.(*FunctionType)
+	}
+	if funcType == nil {
+		funcType = a.functionSignature(stmt)
+	}
+
+	a.analyzeFunctionBody(nil, nil, stmt.Parameters, stmt.ReturnType, funcType, stmt.Body)
+	return funcType
+}
+
+// analyzeFunctionBody, bir fonksiyonun, metodun veya fonksiyon değişmezinin
+// gövdesini yeni bir kapsamda analiz eder. Alıcı (varsa), parametreler ve
+// adlandırılmış sonuçlar bu kapsamda değişken olarak tanımlanır.
+func (a *Analyzer) analyzeFunctionBody(receiver *ast.Identifier, recvType Type, params []*ast.Parameter, returnType ast.Expression, funcType *FunctionType, body *ast.BlockStatement) {
+	funcScope := NewScope(a.currentScope)
+	prevScope := a.currentScope
+	a.currentScope = funcScope
+
+	if receiver != nil {
+		a.currentScope.DefineVariable(receiver.Value, recvType, receiver.Token)
+	}
+	for i, param := range params {
+		if param.Name != nil && param.Name.Value != "_" {
+			a.currentScope.DefineVariable(param.Name.Value, funcType.ParameterTypes[i], param.Name.Token)
+		}
+	}
+
+	context := &functionContext{
+		results: resultTypes(funcType.ReturnType),
+		checked: !isUnknownType(funcType.ReturnType),
+	}
+	if list, ok := returnType.(*ast.ResultList); ok && list.Named() {
+		context.named = true
+		for i, result := range list.Results {
+			if result.Name.Value != "_" {
+				a.currentScope.DefineVariable(result.Name.Value, context.results[i], result.Name.Token)
+			}
+		}
+	}
+
+	prevFunction := a.function
+	a.function = context
+
+	if body != nil {
+		for _, bodyStmt := range body.Statements {
+			a.analyzeStatement(bodyStmt)
+		}
+	}
+
+	a.function = prevFunction
+	a.currentScope = prevScope
+}
+
+// checkCallArguments, bir çağrının argümanlarını fonksiyonun parametreleriyle
+// karşılaştırır. Variadic bir fonksiyonun fazladan argümanları son
+// parametrenin eleman tipine atanabilir olmalıdır; f(xs...) ile yayılan son
+// argüman ise slice'ın kendisine. builtinVariadic, fazladan argümanları
+// denetlenmeyen yerleşik fonksiyonları (println, fmt.Printf gibi) belirtir.
+func (a *Analyzer) checkCallArguments(expr *ast.CallExpression, ft *FunctionType, builtinVariadic bool) {
+	argTypes := make([]Type, len(expr.Arguments))
+	for i, arg := range expr.Arguments {
+		argTypes[i] = a.analyzeExpression(arg)
+	}
+
+	params := ft.ParameterTypes
+	spread := expr.Ellipsis.IsValid()
+	switch {
+	case spread && !ft.IsVariadic:
+		a.reportError(expr.Token, "Variadic olmayan fonksiyonun çağrısında '...' kullanılamaz")
+		return
+	case ft.IsVariadic && !spread:
+		fixed := len(params) - 1
+		if len(argTypes) < fixed {
+			a.reportError(expr.Token, "Fonksiyon çağrısı için yetersiz argüman sayısı: en az %d bekleniyor, %d alındı", fixed, len(argTypes))
+			return
+		}
+		// Fazladan argümanlar slice'ın eleman tipindedir
+		var element Type = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+		if slice, ok := params[fixed].(*ArrayType); ok {
+			element = slice.ElementType
+		}
+		expanded := append([]Type{}, params[:fixed]...)
+		for len(expanded) < len(argTypes) {
+			expanded = append(expanded, element)
+		}
+		params = expanded
+	case builtinVariadic:
+		if len(argTypes) < len(params) {
+			a.reportError(expr.Token, "Fonksiyon çağrısı için yetersiz argüman sayısı: en az %d bekleniyor, %d alındı", len(params), len(argTypes))
+		}
+	default:
+		if len(argTypes) != len(params) {
+			a.reportError(expr.Token, "Fonksiyon çağrısı için yanlış argüman sayısı: %d bekleniyor, %d alındı", len(params), len(argTypes))
+		}
+	}
+
+	for i, argType := range argTypes {
+		if i >= len(params) {
+			break
+		}
+		if !a.isAssignableType(argType, params[i]) {
+			a.reportError(expr.Token, "Fonksiyon çağrısı için yanlış argüman tipi: %s bekleniyor, %s alındı", params[i].String(), argType.String())
+		}
+	}
+}
+
+// checkReturnValues, bir return ifadesinin değerlerini analiz edilen
+// fonksiyonun sonuçlarıyla karşılaştırır. Çok sonuçlu bir çağrı, sonuçları
+// aynı olan bir fonksiyondan doğrudan döndürülebilir (return f()).
+func (a *Analyzer) checkReturnValues(tok token.Token, values []Type) {
+	results := a.function.results
+	if len(values) == 1 && len(results) > 1 {
+		if tuple, ok := values[0].(*TupleType); ok {
+			values = tuple.Types
+		}
+	}
+
+	switch {
+	case len(values) == 0 && (len(results) == 0 || a.function.named):
+		return
+	case len(results) == 0:
+		a.reportError(tok, "Sonuç döndürmeyen fonksiyonda return değer alamaz")
+		return
+	case len(values) != len(results):
+		a.reportError(tok, "Yanlış sayıda dönüş değeri: %d bekleniyor, %d verildi", len(results), len(values))
+		return
+	}
+
+	for i, value := range values {
+		if !a.isAssignableType(value, results[i]) {
+			a.reportError(tok, "Dönüş değeri tipi uyuşmuyor: %s tipindeki değer %s olarak döndürülemez", value.String(), results[i].String())
+		}
+	}
+}
+
+// multiValueTypes, count hedefe atanan değerlerin tiplerini döndürür. Değerler
+// ya hedef sayısı kadar ifadeden, çok sonuçlu tek bir çağrıdan ya da iki
+// hedefe atanan bir kanaldan alma işleminden, map okumasından veya tip
+// iddiasından oluşur.
+// Sayılar uyuşmazsa hata raporlanır ve bilinmeyen tipler döndürülür.
+func (a *Analyzer) multiValueTypes(tok token.Token, count int, values []ast.Expression) []Type {
+	// v, ok := <-ch alınan değeri ve kanalın açık olup olmadığını verir
+	if len(values) == 1 && count == 2 {
+		if types := a.receiveOkTypes(values[0]); types != nil {
+			return types
+		}
+		// v, ok := m[k] değeri ve anahtarın bulunup bulunmadığını verir
+		if types := a.mapIndexOkTypes(values[0]); types != nil {
+			return types
+		}
+		// v, ok := x.(T) iddia edilen değeri ve iddianın başarısını verir
+		if types := a.typeAssertOkTypes(values[0]); types != nil {
+			return types
+		}
+	}
+
+	valueTypes := make([]Type, 0, count)
+	for _, value := range values {
+		valueTypes = append(valueTypes, a.analyzeExpression(value))
+	}
+
+	if len(values) == 1 {
+		if tuple, ok := valueTypes[0].(*TupleType); ok {
+			valueTypes = tuple.Types
+		}
+	} else {
+		for i, valueType := range valueTypes {
+			a.checkSingleValue(nodeToken(values[i]), valueType)
+		}
+	}
+
+	if len(valueTypes) != count {
+		a.reportError(tok, "Atama uyuşmazlığı: %d hedef ancak %d değer var", count, len(valueTypes))
+		valueTypes = make([]Type, count)
+		for i := range valueTypes {
+			valueTypes[i] = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+		}
+	}
+	return valueTypes
+}
+
+// checkSingleValue, tek bir değer beklenen yerde çok sonuçlu bir çağrının
+// kullanılmasını hata olarak raporlar.
+func (a *Analyzer) checkSingleValue(tok token.Token, t Type) bool {
+	if tuple, ok := t.(*TupleType); ok {
+		a.reportError(tok, "Çok değerli %s tek değer bağlamında kullanıldı", tuple.String())
+		return false
+	}
+	return true
+}
+
+// analyzeMultiAssignStatement, birden çok hedefe yapılan bir atamayı analiz
+// eder. ":=" ile atamada aynı kapsamda tanımlı olmayan hedefler yeni değişken
+// olarak tanımlanır; en az bir hedef yeni olmalıdır. "_" hedefine atanan
+// değerler atılır.
+func (a *Analyzer) analyzeMultiAssignStatement(stmt *ast.MultiAssignStatement) Type {
+	valueTypes := a.multiValueTypes(stmt.Token, len(stmt.Left), stmt.Values)
+
+	if stmt.Operator == ":=" {
+		declared := false
+		for i, target := range stmt.Left {
+			ident, ok := target.(*ast.Identifier)
+			if !ok {
+				a.reportError(stmt.Token, "Kısa değişken tanımlama operatörünün sol tarafı bir tanımlayıcı olmalıdır")
+				continue
+			}
+			if ident.Value == "_" {
+				continue
+			}
+			if _, exists := a.currentScope.Symbols[ident.Value]; exists {
+				a.checkMultiAssignTarget(stmt.Token, target, valueTypes[i])
+				continue
+			}
+			declared = true
+			a.currentScope.DefineVariable(ident.Value, valueTypes[i], ident.Token)
+		}
+		if !declared {
+			a.reportError(stmt.Token, "':=' operatörünün sol tarafında yeni değişken yok")
+		}
+	} else {
+		for i, target := range stmt.Left {
+			if ident, ok := target.(*ast.Identifier); ok && ident.Value == "_" {
+				continue
+			}
+			a.checkMultiAssignTarget(stmt.Token, target, valueTypes[i])
+		}
+	}
+
+	return &BasicType{Name: "void", Kind: VOID_TYPE}
+}
+
+// checkMultiAssignTarget, çoklu atamadaki bir hedefin atanabilir olduğunu ve
+// değerin hedefin tipine uyduğunu denetler.
+func (a *Analyzer) checkMultiAssignTarget(tok token.Token, target ast.Expression, valueType Type) {
+	targetType := a.analyzeExpression(target)
+	if !a.checkAssignable(tok, target) {
+		return
+	}
+	if !a.isAssignableType(valueType, targetType) {
+		a.reportError(tok, "Tip uyuşmazlığı: %s tipindeki değer %s tipindeki hedefe atanamaz", valueType.String(), targetType.String())
+	}
+}
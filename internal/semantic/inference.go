@@ -2,6 +2,7 @@ package semantic
 
 import (
 	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
 )
 
 // TypeInference, tip çıkarımı işlemlerini gerçekleştirir.
@@ -22,9 +23,11 @@ func (ti *TypeInference) InferType(expr ast.Expression) Type {
 	case *ast.Identifier:
 		return ti.inferIdentifierType(e)
 	case *ast.IntegerLiteral:
-		return &BasicType{Name: "int", Kind: INTEGER_TYPE}
+		return ti.inferIntegerLiteralType(e)
 	case *ast.FloatLiteral:
 		return &BasicType{Name: "float", Kind: FLOAT_TYPE}
+	case *ast.ImaginaryLiteral:
+		return ti.analyzer.analyzeImaginaryLiteral(e)
 	case *ast.StringLiteral:
 		return &BasicType{Name: "string", Kind: STRING_TYPE}
 	case *ast.CharLiteral:
@@ -37,6 +40,8 @@ func (ti *TypeInference) InferType(expr ast.Expression) Type {
 		return ti.inferPrefixExpressionType(e)
 	case *ast.InfixExpression:
 		return ti.inferInfixExpressionType(e)
+	case *ast.AssignExpression:
+		return ti.inferAssignExpressionType(e)
 	case *ast.IfExpression:
 		return ti.inferIfExpressionType(e)
 	case *ast.FunctionLiteral:
@@ -47,6 +52,8 @@ func (ti *TypeInference) InferType(expr ast.Expression) Type {
 		return ti.inferArrayLiteralType(e)
 	case *ast.IndexExpression:
 		return ti.inferIndexExpressionType(e)
+	case *ast.SliceExpression:
+		return ti.analyzer.analyzeSliceExpression(e)
 	case *ast.HashLiteral:
 		return ti.inferHashLiteralType(e)
 	case *ast.MemberExpression:
@@ -55,11 +62,35 @@ func (ti *TypeInference) InferType(expr ast.Expression) Type {
 		return ti.inferNewExpressionType(e)
 	case *ast.TemplateExpression:
 		return ti.inferTemplateExpressionType(e)
+	case *ast.CompositeLiteral:
+		return ti.analyzer.analyzeCompositeLiteral(e)
+	case *ast.TypeAssertExpression:
+		return ti.analyzer.analyzeTypeAssertion(e)
+	case *ast.ChanType, *ast.MapType:
+		return ti.analyzer.resolveType(e)
 	default:
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 	}
 }
 
+// inferIntegerLiteralType, bir tamsayı literalinin tipini çıkarır.
+// Tipsiz sabit keyfi hassasiyetle tutulur, ancak int tipine dönüştüğünde
+// int64 sınırlarına sığmalıdır.
+func (ti *TypeInference) inferIntegerLiteralType(expr *ast.IntegerLiteral) Type {
+	if expr.Constant != nil && !expr.Constant.IsInt64() {
+		ti.analyzer.reportError(expr.Token, "Tamsayı sabiti %s int tipine sığmıyor (taşma)", expr.Constant.String())
+	}
+	return &BasicType{Name: "int", Kind: INTEGER_TYPE}
+}
+
+// analyzeImaginaryLiteral, bir sanal sayı literalini reddeder. Dilde
+// karmaşık sayı tipleri olmadığından literal ayrıştırılır ancak
+// kullanılamaz.
+func (a *Analyzer) analyzeImaginaryLiteral(expr *ast.ImaginaryLiteral) Type {
+	a.reportError(expr.Token, "Sanal sayı literalleri desteklenmiyor: %s", expr.Token.Literal)
+	return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+}
+
 // inferIdentifierType, bir tanımlayıcının tipini çıkarır.
 func (ti *TypeInference) inferIdentifierType(expr *ast.Identifier) Type {
 	// Tanımlayıcıyı çözümle
@@ -69,6 +100,11 @@ func (ti *TypeInference) inferIdentifierType(expr *ast.Identifier) Type {
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 	}
 
+	// Bileşik tipli değişkenler tam tiplerini taşır
+	if symbol.DataType != nil {
+		return symbol.DataType
+	}
+
 	// Sembol tipini döndür
 	switch symbol.Type {
 	case INTEGER_TYPE:
@@ -102,13 +138,7 @@ func (ti *TypeInference) inferIdentifierType(expr *ast.Identifier) Type {
 
 		return funcType
 	case CLASS_TYPE:
-		// Sınıf tipini oluştur
-		return &ClassType{
-			Name:       symbol.Name,
-			Fields:     make(map[string]Type),
-			Methods:    make(map[string]*FunctionType),
-			Implements: []*InterfaceType{},
-		}
+		return classTypeOf(symbol)
 	case PACKAGE_TYPE:
 		// Package tipini oluştur
 		return &BasicType{Name: "package", Kind: PACKAGE_TYPE}
@@ -126,16 +156,25 @@ func (ti *TypeInference) inferPrefixExpressionType(expr *ast.PrefixExpression) T
 	switch expr.Operator {
 	case "!":
 		// ! operatörü boolean tipinde olmalıdır
-		if basicType, ok := rightType.(*BasicType); !ok || basicType.Kind != BOOLEAN_TYPE {
+		if basicType, ok := underlyingType(rightType).(*BasicType); !ok || basicType.Kind != BOOLEAN_TYPE {
 			ti.analyzer.reportError(expr.Token, "! operatörü boolean tipinde olmalıdır")
 		}
 		return &BasicType{Name: "bool", Kind: BOOLEAN_TYPE}
 	case "-":
 		// - operatörü sayısal tipte olmalıdır
-		if basicType, ok := rightType.(*BasicType); !ok || (basicType.Kind != INTEGER_TYPE && basicType.Kind != FLOAT_TYPE) {
+		if basicType, ok := underlyingType(rightType).(*BasicType); !ok || (basicType.Kind != INTEGER_TYPE && basicType.Kind != FLOAT_TYPE) {
 			ti.analyzer.reportError(expr.Token, "- operatörü sayısal tipte olmalıdır")
 		}
 		return rightType
+	case "^", "~":
+		// Bit düzeyinde tümleyen tamsayı tipinde olmalıdır
+		return ti.analyzer.checkComplementOperand(expr.Token, expr.Operator, rightType)
+	case "<-":
+		return ti.analyzer.analyzeReceiveExpression(expr, rightType)
+	case "&":
+		return ti.analyzer.analyzeAddressOf(expr, rightType)
+	case "*":
+		return ti.analyzer.analyzeDereference(expr, rightType)
 	default:
 		ti.analyzer.reportError(expr.Token, "Bilinmeyen önek operatörü: %s", expr.Operator)
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
@@ -144,19 +183,92 @@ func (ti *TypeInference) inferPrefixExpressionType(expr *ast.PrefixExpression) T
 
 // inferInfixExpressionType, bir araek ifadesinin tipini çıkarır.
 func (ti *TypeInference) inferInfixExpressionType(expr *ast.InfixExpression) Type {
+	if expr.Operator == ":=" {
+		// Kısa değişken tanımlama operatörü
+		rightType := ti.InferType(expr.Right)
+		ti.analyzer.checkSingleValue(expr.Token, rightType)
+		// Sol taraf bir tanımlayıcı olmalıdır
+		if ident, ok := expr.Left.(*ast.Identifier); ok {
+			// Tanımlayıcıyı tanımla
+			ti.analyzer.currentScope.DefineVariable(ident.Value, rightType, ident.Token)
+		} else {
+			ti.analyzer.reportError(expr.Token, "Kısa değişken tanımlama operatörünün sol tarafı bir tanımlayıcı olmalıdır")
+		}
+		return rightType
+	}
+
 	// Sol ve sağ tarafı analiz et
 	leftType := ti.InferType(expr.Left)
 	rightType := ti.InferType(expr.Right)
 
+	return ti.inferBinaryOperationType(expr.Token, expr.Operator, leftType, rightType, expr.Right)
+}
+
+// inferAssignExpressionType, bir atama ifadesinin tipini çıkarır.
+// Bileşik atama (x op= y), x = x op y gibi denetlenir.
+func (ti *TypeInference) inferAssignExpressionType(expr *ast.AssignExpression) Type {
+	leftType := ti.InferType(expr.Left)
+	valueType := ti.InferType(expr.Value)
+
+	ti.analyzer.checkAssignable(expr.Token, expr.Left)
+	if operator := expr.BinaryOperator(); operator != "" {
+		valueType = ti.inferBinaryOperationType(expr.Token, operator, leftType, valueType, expr.Value)
+	}
+
+	// Sağ taraf sol tarafla aynı tipte olmalı, sol taraftaki arayüzü uygulamalı,
+	// sol taraftaki adlandırılmış tiple aynı dayanak tipte olmalı veya null
+	// alabilen bir hedefe atanan null olmalıdır
+	if !leftType.Equals(valueType) && !ti.analyzer.satisfiesInterface(valueType, leftType) && !namedAssignable(valueType, leftType) &&
+		!isNullComparison(leftType, valueType) {
+		ti.analyzer.reportError(expr.Token, "Atama operatörünün sağ tarafı sol tarafla aynı tipte olmalıdır")
+	}
+	return leftType
+}
+
+// inferBinaryOperationType, işlenen tipleri bilinen bir ikili işlemin sonuç tipini çıkarır.
+// Bileşik atamalar (örn. +=) da işlemlerini bu fonksiyonla denetler.
+func (ti *TypeInference) inferBinaryOperationType(tok token.Token, operator string, leftType, rightType Type, right ast.Expression) Type {
+	// Adlandırılmış tipler dayanak tiplerinin işlemlerini devralır; aritmetik
+	// işlemlerin sonucu adlandırılmış tipte kalır. Kaydırma sayısının tipi
+	// sonucu etkilemediğinden kaydırmalar ayrıca denetlenir.
+	if operator != "<<" && operator != ">>" {
+		if named := ti.analyzer.namedOperandType(tok, leftType, rightType); named != nil {
+			switch operator {
+			case "+", "-", "*", "/", "%":
+				ti.inferBinaryOperationType(tok, operator, underlyingType(leftType), underlyingType(rightType), right)
+				return named
+			case "&", "|", "^", "&^":
+				// Bit düzeyindeki denetimler adlandırılmış tamsayıları destekler
+			default:
+				return ti.inferBinaryOperationType(tok, operator, underlyingType(leftType), underlyingType(rightType), right)
+			}
+		}
+	}
+
+	if result, ok := ti.analyzer.checkPointerArithmetic(tok, operator, leftType, rightType); ok {
+		return result
+	}
+
+	// Bilinmeyen tipteki işlenenler (ör. tipi yazılmamış parametreler) hata
+	// zincirine yol açmasın diye denetlenmez
+	if isUnknownType(leftType) || isUnknownType(rightType) {
+		switch operator {
+		case "<", ">", "<=", ">=", "==", "!=", "&&", "||":
+			return &BasicType{Name: "bool", Kind: BOOLEAN_TYPE}
+		}
+		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	}
+
 	// Operatöre göre tip kontrolü yap
-	switch expr.Operator {
+	switch operator {
 	case "-", "*", "/", "%":
 		// Aritmetik operatörler sayısal tipte olmalıdır
-		if basicLeftType, ok := leftType.(*BasicType); !ok || (basicLeftType.Kind != INTEGER_TYPE && basicLeftType.Kind != FLOAT_TYPE) {
-			ti.analyzer.reportError(expr.Token, "Aritmetik operatörün sol tarafı sayısal tipte olmalıdır")
+		// (rune değerleri tamsayıdır)
+		if !isNumericType(leftType) {
+			ti.analyzer.reportError(tok, "Aritmetik operatörün sol tarafı sayısal tipte olmalıdır")
 		}
-		if basicRightType, ok := rightType.(*BasicType); !ok || (basicRightType.Kind != INTEGER_TYPE && basicRightType.Kind != FLOAT_TYPE) {
-			ti.analyzer.reportError(expr.Token, "Aritmetik operatörün sağ tarafı sayısal tipte olmalıdır")
+		if !isNumericType(rightType) {
+			ti.analyzer.reportError(tok, "Aritmetik operatörün sağ tarafı sayısal tipte olmalıdır")
 		}
 
 		// Eğer herhangi bir taraf FLOAT_TYPE ise, sonuç FLOAT_TYPE olur
@@ -181,13 +293,13 @@ func (ti *TypeInference) inferInfixExpressionType(expr *ast.InfixExpression) Typ
 		var ok3, ok4 bool
 
 		basicLeftType2, ok3 = leftType.(*BasicType)
-		if !ok3 || (basicLeftType2.Kind != INTEGER_TYPE && basicLeftType2.Kind != FLOAT_TYPE) {
-			ti.analyzer.reportError(expr.Token, "Aritmetik operatörün sol tarafı sayısal tipte olmalıdır")
+		if !isNumericType(leftType) {
+			ti.analyzer.reportError(tok, "Aritmetik operatörün sol tarafı sayısal tipte olmalıdır")
 		}
 
 		basicRightType2, ok4 = rightType.(*BasicType)
-		if !ok4 || (basicRightType2.Kind != INTEGER_TYPE && basicRightType2.Kind != FLOAT_TYPE) {
-			ti.analyzer.reportError(expr.Token, "Aritmetik operatörün sağ tarafı sayısal tipte olmalıdır")
+		if !isNumericType(rightType) {
+			ti.analyzer.reportError(tok, "Aritmetik operatörün sağ tarafı sayısal tipte olmalıdır")
 		}
 
 		// Eğer herhangi bir taraf FLOAT_TYPE ise, sonuç FLOAT_TYPE olur
@@ -197,41 +309,29 @@ func (ti *TypeInference) inferInfixExpressionType(expr *ast.InfixExpression) Typ
 		return &BasicType{Name: "int", Kind: INTEGER_TYPE}
 	case "<", ">", "<=", ">=", "==", "!=":
 		// Karşılaştırma operatörleri aynı tipte olmalıdır
-		if !leftType.Equals(rightType) {
-			ti.analyzer.reportError(expr.Token, "Karşılaştırma operatörünün sol ve sağ tarafı aynı tipte olmalıdır")
+		if !leftType.Equals(rightType) && !isNullComparison(leftType, rightType) {
+			ti.analyzer.reportError(tok, "Karşılaştırma operatörünün sol ve sağ tarafı aynı tipte olmalıdır")
+		} else {
+			ti.analyzer.checkStructComparison(tok, operator, leftType)
 		}
 		return &BasicType{Name: "bool", Kind: BOOLEAN_TYPE}
 	case "&&", "||":
 		// Mantıksal operatörler boolean tipinde olmalıdır
 		if basicLeftType, ok := leftType.(*BasicType); !ok || basicLeftType.Kind != BOOLEAN_TYPE {
-			ti.analyzer.reportError(expr.Token, "Mantıksal operatörün sol tarafı boolean tipinde olmalıdır")
+			ti.analyzer.reportError(tok, "Mantıksal operatörün sol tarafı boolean tipinde olmalıdır")
 		}
 		if basicRightType, ok := rightType.(*BasicType); !ok || basicRightType.Kind != BOOLEAN_TYPE {
-			ti.analyzer.reportError(expr.Token, "Mantıksal operatörün sağ tarafı boolean tipinde olmalıdır")
+			ti.analyzer.reportError(tok, "Mantıksal operatörün sağ tarafı boolean tipinde olmalıdır")
 		}
 		return &BasicType{Name: "bool", Kind: BOOLEAN_TYPE}
-	case "=", "+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=", "<<=", ">>=":
-		// Atama operatörleri için sol taraf bir değişken olmalıdır
-		if _, ok := expr.Left.(*ast.Identifier); !ok {
-			ti.analyzer.reportError(expr.Token, "Atama operatörünün sol tarafı bir değişken olmalıdır")
-		}
-		// Sağ taraf sol tarafla aynı tipte olmalıdır
-		if !leftType.Equals(rightType) {
-			ti.analyzer.reportError(expr.Token, "Atama operatörünün sağ tarafı sol tarafla aynı tipte olmalıdır")
-		}
-		return leftType
-	case ":=":
-		// Kısa değişken tanımlama operatörü
-		// Sol taraf bir tanımlayıcı olmalıdır
-		if ident, ok := expr.Left.(*ast.Identifier); ok {
-			// Tanımlayıcıyı tanımla
-			ti.analyzer.currentScope.Define(ident.Value, symbolTypeFromType(rightType), ident.Token)
-		} else {
-			ti.analyzer.reportError(expr.Token, "Kısa değişken tanımlama operatörünün sol tarafı bir tanımlayıcı olmalıdır")
-		}
-		return rightType
+	case "&", "|", "^", "&^":
+		// Bit düzeyinde operatörler tamsayı tipinde olmalıdır
+		return ti.analyzer.checkBitwiseOperands(tok, operator, leftType, rightType)
+	case "<<", ">>":
+		// Kaydırma operatörleri
+		return ti.analyzer.checkShiftOperands(tok, operator, leftType, rightType, right)
 	default:
-		ti.analyzer.reportError(expr.Token, "Bilinmeyen araek operatörü: %s", expr.Operator)
+		ti.analyzer.reportError(tok, "Bilinmeyen araek operatörü: %s", operator)
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 	}
 }
@@ -28,27 +28,21 @@ func (ti *TypeInference) inferIfExpressionType(expr *ast.IfExpression) Type {
 	return consequenceType
 }
 
-// inferBlockStatementType, bir blok ifadesinin tipini çıkarır.
+// inferBlockStatementType, bir blok ifadesinin tipini çıkarır. Bloğun
+// deyimleri kendi kapsamında analiz edilir; tip, son deyim bir return veya
+// ifade deyimiyse onun tipidir, aksi takdirde void'dir.
 func (ti *TypeInference) inferBlockStatementType(block *ast.BlockStatement) Type {
+	lastType := ti.analyzer.analyzeBlockStatement(block)
+
 	// Blok boşsa, void tipini döndür
 	if len(block.Statements) == 0 {
 		return &BasicType{Name: "void", Kind: VOID_TYPE}
 	}
 
-	// Bloktaki son ifadenin tipini döndür
-	lastStmt := block.Statements[len(block.Statements)-1]
-
-	// Son ifade bir return ifadesi ise, döndürülen değerin tipini döndür
-	if returnStmt, ok := lastStmt.(*ast.ReturnStatement); ok {
-		if returnStmt.ReturnValue != nil {
-			return ti.InferType(returnStmt.ReturnValue)
-		}
-		return &BasicType{Name: "void", Kind: VOID_TYPE}
-	}
-
-	// Son ifade bir expression ifadesi ise, ifadenin tipini döndür
-	if exprStmt, ok := lastStmt.(*ast.ExpressionStatement); ok {
-		return ti.InferType(exprStmt.Expression)
+	// Son ifade bir return veya expression ifadesi ise, onun tipini döndür
+	switch block.Statements[len(block.Statements)-1].(type) {
+	case *ast.ReturnStatement, *ast.ExpressionStatement:
+		return lastType
 	}
 
 	// Diğer durumlarda void tipini döndür
@@ -56,64 +50,49 @@ func (ti *TypeInference) inferBlockStatementType(block *ast.BlockStatement) Type
 }
 
 // inferFunctionLiteralType, bir fonksiyon değişmez değerinin tipini çıkarır.
+// Tip, parametre ve dönüş tipi bildirimlerinden oluşturulur; gövde kendi
+// kapsamında analiz edilir.
 func (ti *TypeInference) inferFunctionLiteralType(expr *ast.FunctionLiteral) Type {
-	// Fonksiyon tipini oluştur
-	funcType := &FunctionType{
-		ParameterTypes: make([]Type, 0),
-		ReturnType:     &BasicType{Name: "void", Kind: VOID_TYPE},
-	}
-
-	// Parametrelerin tiplerini ekle
-	for _, param := range expr.Parameters {
-		// Parametre tipini belirle (varsayılan olarak int)
-		paramType := &BasicType{Name: "int", Kind: INTEGER_TYPE}
-
-		// Parametreyi sembol tablosuna ekle
-		ti.analyzer.currentScope.Define(param.Value, INTEGER_TYPE, param.Token)
+	return ti.analyzer.analyzeFunctionLiteral(expr)
+}
 
-		// Parametre tipini fonksiyon tipine ekle
-		funcType.ParameterTypes = append(funcType.ParameterTypes, paramType)
+// inferCallExpressionType, bir fonksiyon çağrısının tipini çıkarır.
+func (ti *TypeInference) inferCallExpressionType(expr *ast.CallExpression) Type {
+	// T(x) biçimindeki çağrılar tip dönüşümüdür
+	if target := ti.analyzer.conversionType(expr.Function); target != nil {
+		return ti.analyzer.analyzeConversion(expr, target)
 	}
 
-	// Dönüş tipini belirle
-	if expr.ReturnType != nil {
-		// Dönüş tipi belirtilmişse, bu tipi kullan
-		if typeIdent, ok := expr.ReturnType.(*ast.Identifier); ok {
-			switch typeIdent.Value {
-			case "int":
-				funcType.ReturnType = &BasicType{Name: "int", Kind: INTEGER_TYPE}
-			case "float":
-				funcType.ReturnType = &BasicType{Name: "float", Kind: FLOAT_TYPE}
-			case "string":
-				funcType.ReturnType = &BasicType{Name: "string", Kind: STRING_TYPE}
-			case "bool":
-				funcType.ReturnType = &BasicType{Name: "bool", Kind: BOOLEAN_TYPE}
-			case "char":
-				funcType.ReturnType = &BasicType{Name: "char", Kind: CHAR_TYPE}
-			case "void":
-				funcType.ReturnType = &BasicType{Name: "void", Kind: VOID_TYPE}
-			default:
-				// Bilinmeyen tip
-				ti.analyzer.reportError(typeIdent.Token, "Bilinmeyen dönüş tipi: %s", typeIdent.Value)
-			}
-		}
-	} else if expr.Body != nil {
-		// Dönüş tipi belirtilmemişse, gövdeden çıkar
-		funcType.ReturnType = ti.inferBlockStatementType(expr.Body)
+	// İlk argümanı tip, kanal, map, slice veya işaretçi olan yerleşik fonksiyonlar
+	switch {
+	case ti.analyzer.isBuiltin(expr.Function, "make"):
+		return ti.analyzer.analyzeMakeCall(expr)
+	case ti.analyzer.isBuiltin(expr.Function, "close"):
+		return ti.analyzer.analyzeCloseCall(expr)
+	case ti.analyzer.isBuiltin(expr.Function, "delete"):
+		return ti.analyzer.analyzeDeleteCall(expr)
+	case ti.analyzer.isBuiltin(expr.Function, "append"):
+		return ti.analyzer.analyzeAppendCall(expr)
+	case ti.analyzer.isBuiltin(expr.Function, "copy"):
+		return ti.analyzer.analyzeCopyCall(expr)
+	case ti.analyzer.isBuiltin(expr.Function, "alloc"):
+		return ti.analyzer.analyzeAllocCall(expr)
+	case ti.analyzer.isBuiltin(expr.Function, "free"):
+		return ti.analyzer.analyzeFreeCall(expr, "free")
 	}
 
-	return funcType
-}
-
-// inferCallExpressionType, bir fonksiyon çağrısının tipini çıkarır.
-func (ti *TypeInference) inferCallExpressionType(expr *ast.CallExpression) Type {
 	// Fonksiyonun tipini çıkar
 	funcType := ti.InferType(expr.Function)
 
-	// Fonksiyon tipi kontrolü
-	if ft, ok := funcType.(*FunctionType); ok {
-		// Variadic function kontrolü için member expression'ı kontrol et
+	// Fonksiyon tipi kontrolü (adlandırılmış fonksiyon tipleri dahil)
+	if ft, ok := underlyingType(funcType).(*FunctionType); ok {
+		// Yerleşik variadic fonksiyonlar imzalarında işaretlenir
 		isVariadic := false
+		if ident, ok := expr.Function.(*ast.Identifier); ok {
+			if symbol := ti.analyzer.currentScope.Resolve(ident.Value); symbol != nil && symbol.Signature != nil {
+				isVariadic = symbol.Signature.IsVariadic
+			}
+		}
 		if memberExpr, ok := expr.Function.(*ast.MemberExpression); ok {
 			if objectIdent, ok := memberExpr.Object.(*ast.Identifier); ok {
 				if packageSymbol := ti.analyzer.currentScope.Resolve(objectIdent.Value); packageSymbol != nil && packageSymbol.Type == PACKAGE_TYPE {
@@ -128,31 +107,8 @@ func (ti *TypeInference) inferCallExpressionType(expr *ast.CallExpression) Type
 			}
 		}
 
-		// Argüman sayısı kontrolü (variadic functions için farklı)
-		if isVariadic {
-			// Variadic function: minimum parametre sayısını kontrol et
-			if len(expr.Arguments) < len(ft.ParameterTypes) {
-				ti.analyzer.reportError(expr.Token, "Fonksiyon çağrısı için yetersiz argüman sayısı: en az %d bekleniyor, %d alındı", len(ft.ParameterTypes), len(expr.Arguments))
-			}
-		} else {
-			// Normal function: tam parametre sayısını kontrol et
-			if len(expr.Arguments) != len(ft.ParameterTypes) {
-				ti.analyzer.reportError(expr.Token, "Fonksiyon çağrısı için yanlış argüman sayısı: %d bekleniyor, %d alındı", len(ft.ParameterTypes), len(expr.Arguments))
-			}
-		}
-
-		// Argüman tiplerini kontrol et
-		for i, arg := range expr.Arguments {
-			if i < len(ft.ParameterTypes) {
-				argType := ti.InferType(arg)
-				if !argType.Equals(ft.ParameterTypes[i]) {
-					// UNKNOWN_TYPE parametreler için tip kontrolü yapmayalım (variadic için)
-					if basicType, ok := ft.ParameterTypes[i].(*BasicType); !ok || basicType.Kind != UNKNOWN_TYPE {
-						ti.analyzer.reportError(expr.Token, "Fonksiyon çağrısı için yanlış argüman tipi: %s bekleniyor, %s alındı", ft.ParameterTypes[i].String(), argType.String())
-					}
-				}
-			}
-		}
+		// Argümanları parametrelerle karşılaştır
+		ti.analyzer.checkCallArguments(expr, ft, isVariadic)
 
 		// Fonksiyonun dönüş tipini döndür
 		return ft.ReturnType
@@ -35,8 +35,13 @@ func (ti *TypeInference) inferArrayLiteralType(expr *ast.ArrayLiteral) Type {
 
 // inferIndexExpressionType, bir indeks ifadesinin tipini çıkarır.
 func (ti *TypeInference) inferIndexExpressionType(expr *ast.IndexExpression) Type {
-	// Sol tarafın tipini çıkar
-	leftType := ti.InferType(expr.Left)
+	// Sol tarafın tipini çıkar; adlandırılmış tipler dayanak tipleri gibi indekslenir
+	leftType := underlyingType(ti.InferType(expr.Left))
+
+	// Map'ler anahtar tipleriyle indekslenir
+	if mapType, ok := leftType.(*MapType); ok {
+		return ti.analyzer.analyzeMapIndex(expr, mapType)
+	}
 
 	// İndeks ifadesinin tipini çıkar
 	indexType := ti.InferType(expr.Index)
@@ -51,9 +56,10 @@ func (ti *TypeInference) inferIndexExpressionType(expr *ast.IndexExpression) Typ
 		return arrayType.ElementType
 	}
 
-	// Sol taraf bir string ise, char tipini döndür
+	// Sol taraf bir string ise, bayt tipini döndür
 	if basicType, ok := leftType.(*BasicType); ok && basicType.Kind == STRING_TYPE {
-		return &BasicType{Name: "char", Kind: CHAR_TYPE}
+		ti.analyzer.stringIndexes[expr] = true
+		return &BasicType{Name: "byte", Kind: INTEGER_TYPE}
 	}
 
 	// Diğer durumlarda hata ver
@@ -129,8 +135,12 @@ func (ti *TypeInference) inferMemberExpressionType(expr *ast.MemberExpression) T
 		}
 	}
 
-	// Nesnenin tipini çıkar
+	// Nesnenin tipini çıkar; işaretçiler üzerinden alan ve metotlara doğrudan
+	// erişilir (p.x)
 	objectType := ti.InferType(expr.Object)
+	if pt, ok := objectType.(*PointerType); ok {
+		objectType = pt.ElementType
+	}
 
 	// Nesne bir sınıf ise, üye tipini döndür
 	if classType, ok := objectType.(*ClassType); ok {
@@ -152,7 +162,7 @@ func (ti *TypeInference) inferMemberExpressionType(expr *ast.MemberExpression) T
 	// Nesne bir arayüz ise, üye tipini döndür
 	if interfaceType, ok := objectType.(*InterfaceType); ok {
 		// Üye bir metot ise
-		if methodType, ok := interfaceType.Methods[memberName]; ok {
+		if methodType, ok := interfaceType.MethodSet()[memberName]; ok {
 			return methodType
 		}
 
@@ -161,6 +171,27 @@ func (ti *TypeInference) inferMemberExpressionType(expr *ast.MemberExpression) T
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 	}
 
+	// Nesne adlandırılmış bir tip ise, metodunun tipini döndür
+	if namedType, ok := objectType.(*NamedType); ok {
+		if methodType, ok := namedType.Methods[memberName]; ok {
+			return methodType
+		}
+
+		// Üye bulunamadı
+		ti.analyzer.reportError(expr.Token, "%s tipinde '%s' adında bir metot yok", namedType.Name, memberName)
+		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	}
+
+	// Nesne bir struct ise, alanın (gömülü struct'lardan yükseltilmiş olabilir) tipini döndür
+	if structType, ok := objectType.(*StructType); ok {
+		return ti.analyzer.structMemberType(expr.Member.(*ast.Identifier).Token, structType, memberName)
+	}
+
+	// Nesnenin tipi bilinmiyorsa hata zaten raporlanmıştır
+	if isUnknownType(objectType) {
+		return objectType
+	}
+
 	// Diğer durumlarda hata ver
 	ti.analyzer.reportError(expr.Token, "Üye erişimi için nesne bir sınıf, arayüz veya package olmalıdır")
 	return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
@@ -184,13 +215,7 @@ func (ti *TypeInference) inferNewExpressionType(expr *ast.NewExpression) Type {
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 	}
 
-	// Sınıf tipini döndür
-	return &ClassType{
-		Name:       className,
-		Fields:     make(map[string]Type),
-		Methods:    make(map[string]*FunctionType),
-		Implements: []*InterfaceType{},
-	}
+	return classTypeOf(symbol)
 }
 
 // inferTemplateExpressionType, bir şablon ifadesinin tipini çıkarır.
@@ -0,0 +1,421 @@
+package semantic
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// resolveInterfaceType, bir arayüz tipinin metotlarını ve gömülü arayüzlerini
+// çözümleyip target'a ekler. Gömülü arayüzlerin metotları MethodSet ile
+// tembel olarak toplandığından ileriye dönük başvurular desteklenir.
+func (a *Analyzer) resolveInterfaceType(it *ast.InterfaceType, target *InterfaceType) {
+	for _, embed := range it.Embeds {
+		embedType := a.resolveType(embed)
+		if embedded, ok := embedType.(*InterfaceType); ok {
+			target.Embeds = append(target.Embeds, embedded)
+		} else if !isUnknownType(embedType) {
+			a.reportError(nodeToken(embed), "Arayüze yalnızca arayüz tipleri gömülebilir: %s", embedType.String())
+		}
+	}
+
+	for _, method := range it.Methods {
+		name := method.Name.Value
+		if _, exists := target.Methods[name]; exists {
+			a.reportError(method.Name.Token, "Metot zaten tanımlı: %s", name)
+			continue
+		}
+		target.Methods[name] = a.methodSignature(method.Parameters, method.ReturnType)
+	}
+}
+
+// embedsInterface, it arayüzünün target'ı (doğrudan veya dolaylı olarak)
+// gömüp gömmediğini kontrol eder.
+func embedsInterface(it, target *InterfaceType, visited map[*InterfaceType]bool) bool {
+	if visited[it] {
+		return false
+	}
+	visited[it] = true
+
+	for _, embed := range it.Embeds {
+		if embed == target || embedsInterface(embed, target, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// methodSignature, bir metot veya fonksiyon imzasının fonksiyon tipini
+// oluşturur. Tipi yazılmamış parametreler bilinmeyen tipte kabul edilir; dönüş
+// tipi yoksa sonuç void'dir.
+func (a *Analyzer) methodSignature(params []*ast.Parameter, returnType ast.Expression) *FunctionType {
+	funcType := &FunctionType{
+		ParameterTypes: make([]Type, len(params)),
+		ReturnType:     &BasicType{Name: "void", Kind: VOID_TYPE},
+		IsVariadic:     ast.IsVariadic(params),
+	}
+	for i, param := range params {
+		funcType.ParameterTypes[i] = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+		if param.Type != nil {
+			funcType.ParameterTypes[i] = a.resolveType(param.Type)
+		}
+	}
+	if returnType != nil {
+		funcType.ReturnType = a.resolveType(returnType)
+	}
+	return funcType
+}
+
+// sameSignature, iki metot imzasının uyumlu olup olmadığını kontrol eder.
+// Bilinmeyen tipler her tiple uyumlu sayılır.
+func sameSignature(have, want *FunctionType) bool {
+	if len(have.ParameterTypes) != len(want.ParameterTypes) || have.IsVariadic != want.IsVariadic {
+		return false
+	}
+	for i, param := range have.ParameterTypes {
+		if !compatibleTypes(param, want.ParameterTypes[i]) {
+			return false
+		}
+	}
+	return compatibleTypes(have.ReturnType, want.ReturnType)
+}
+
+func compatibleTypes(t, other Type) bool {
+	if t == nil || other == nil || isUnknownType(t) || isUnknownType(other) {
+		return true
+	}
+	return t.Equals(other)
+}
+
+// sortedMethodNames, bir metot kümesindeki adları sıralı olarak döndürür.
+func sortedMethodNames(methods map[string]*FunctionType) []string {
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// collectMethods, alıcılı metot bildirimlerini ve sınıf gövdelerindeki
+// metotları tiplerin metot kümelerine ekler, ardından sınıfların implements
+// listelerini denetler. Metot imzaları tiplere başvurabildiğinden bu adım tip
+// bildirimlerinden sonra yapılır.
+func (a *Analyzer) collectMethods(stmts []ast.Statement) {
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *ast.MethodStatement:
+			a.collectMethodDeclaration(s)
+		case *ast.ClassStatement:
+			a.collectClassMethods(s)
+		}
+	}
+
+	for _, stmt := range stmts {
+		if class, ok := stmt.(*ast.ClassStatement); ok {
+			a.checkImplements(class)
+		}
+	}
+}
+
+// collectMethodDeclaration, func (r T) Ad(...) biçimindeki bir metodu T'nin
+// metot kümesine ekler.
+func (a *Analyzer) collectMethodDeclaration(stmt *ast.MethodStatement) {
+	name := stmt.Name.Value
+	recvType := a.resolveType(stmt.Receiver)
+
+	var methods map[string]*FunctionType
+	switch r := recvType.(type) {
+	case *StructType:
+		if r.Field(name) != nil {
+			a.reportError(stmt.Name.Token, "Alan ve metot aynı ada sahip: %s.%s", r.Name, name)
+			return
+		}
+		if r.Methods == nil {
+			r.Methods = make(map[string]*FunctionType)
+		}
+		methods = r.Methods
+	case *ClassType:
+		methods = r.Methods
+	case *NamedType:
+		methods = r.Methods
+	default:
+		if !isUnknownType(recvType) {
+			a.reportError(stmt.Receiver.Token, "Metot alıcısı bir struct, sınıf veya adlandırılmış tip olmalıdır: %s", recvType.String())
+		}
+		return
+	}
+
+	if _, exists := methods[name]; exists {
+		a.reportError(stmt.Name.Token, "Metot zaten tanımlı: %s.%s", recvType.String(), name)
+		return
+	}
+	methods[name] = a.methodSignature(stmt.Parameters, stmt.ReturnType)
+}
+
+// collectClassMethods, bir sınıf gövdesindeki metotları sınıfın metot
+// kümesine ekler.
+func (a *Analyzer) collectClassMethods(class *ast.ClassStatement) {
+	symbol := a.currentScope.Resolve(class.Name.Value)
+	if symbol == nil || class.Body == nil {
+		return
+	}
+	classType := classTypeOf(symbol)
+
+	for _, stmt := range class.Body.Statements {
+		fn, ok := stmt.(*ast.FunctionStatement)
+		if !ok {
+			continue
+		}
+		name := fn.Name.Value
+		if _, exists := classType.Methods[name]; exists {
+			a.reportError(fn.Name.Token, "Metot zaten tanımlı: %s.%s", classType.Name, name)
+			continue
+		}
+		classType.Methods[name] = a.methodSignature(fn.Parameters, fn.ReturnType)
+	}
+}
+
+// checkImplements, bir sınıfın implements listesindeki arayüzleri gerçekten
+// uygulayıp uygulamadığını denetler. Arayüzler yapısal olarak sağlandığından
+// liste yalnızca bir belgeleme ve derleme zamanı güvencesidir.
+func (a *Analyzer) checkImplements(class *ast.ClassStatement) {
+	symbol := a.currentScope.Resolve(class.Name.Value)
+	if symbol == nil {
+		return
+	}
+	classType := classTypeOf(symbol)
+
+	for _, impl := range class.Implements {
+		implType := a.resolveType(impl)
+		iface, ok := implType.(*InterfaceType)
+		if !ok {
+			if !isUnknownType(implType) {
+				a.reportError(impl.Token, "%s bir arayüz değil", impl.Value)
+			}
+			continue
+		}
+
+		classType.Implements = append(classType.Implements, iface)
+		if msg := a.notImplemented(classType, iface); msg != "" {
+			a.reportError(impl.Token, "%s", msg)
+		}
+	}
+}
+
+// classTypeOf, bir sınıf sembolünün tipini döndürür. Metot kümesi toplanan
+// sınıfların tipi sembolde saklanır.
+func classTypeOf(symbol *Symbol) *ClassType {
+	if classType, ok := symbol.DataType.(*ClassType); ok {
+		return classType
+	}
+	return &ClassType{
+		Name:       symbol.Name,
+		Fields:     make(map[string]Type),
+		Methods:    make(map[string]*FunctionType),
+		Implements: []*InterfaceType{},
+	}
+}
+
+// methodSet, bir tipin metot kümesini döndürür. Struct'larda gömülü
+// struct'ların metotları dış struct'a yükseltilir; dış struct'ın kendi
+// metotları önceliklidir.
+func (a *Analyzer) methodSet(t Type) map[string]*FunctionType {
+	switch tt := t.(type) {
+	case *StructType:
+		methods := make(map[string]*FunctionType)
+		collectStructMethods(tt, methods, map[*StructType]bool{})
+		return methods
+	case *ClassType:
+		// new ifadeleri gibi yerlerde oluşturulan tipler metotları taşımayabilir
+		if symbol := a.currentScope.Resolve(tt.Name); symbol != nil && symbol.Type == CLASS_TYPE {
+			return classTypeOf(symbol).Methods
+		}
+		return tt.Methods
+	case *InterfaceType:
+		return tt.MethodSet()
+	case *NamedType:
+		return tt.Methods
+	case *PointerType:
+		// *T, T'nin ve işaretçi alıcılı metotlarının tümüne sahiptir
+		return a.methodSet(tt.ElementType)
+	}
+	return nil
+}
+
+func collectStructMethods(st *StructType, methods map[string]*FunctionType, visited map[*StructType]bool) {
+	if visited[st] {
+		return
+	}
+	visited[st] = true
+
+	for name, method := range st.Methods {
+		methods[name] = method
+	}
+	for _, field := range st.Fields {
+		inner := embeddedStruct(field)
+		if inner == nil {
+			continue
+		}
+		promoted := make(map[string]*FunctionType)
+		collectStructMethods(inner, promoted, visited)
+		for name, method := range promoted {
+			if _, exists := methods[name]; !exists {
+				methods[name] = method
+			}
+		}
+	}
+}
+
+// missingMethod, t tipinde bulunmayan veya imzası farklı olan ilk arayüz
+// metodunu döndürür. t arayüzü uyguluyorsa boş string döner.
+func (a *Analyzer) missingMethod(t Type, iface *InterfaceType) (name string, wrongSignature bool) {
+	have := a.methodSet(t)
+	want := iface.MethodSet()
+
+	for _, name := range sortedMethodNames(want) {
+		method, exists := have[name]
+		if !exists {
+			return name, false
+		}
+		if !sameSignature(method, want[name]) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// notImplemented, t tipi arayüzü uygulamıyorsa nedenini açıklayan bir mesaj,
+// uyguluyorsa boş string döndürür.
+func (a *Analyzer) notImplemented(t Type, iface *InterfaceType) string {
+	name, wrongSignature := a.missingMethod(t, iface)
+	switch {
+	case name == "":
+		return ""
+	case wrongSignature:
+		return fmt.Sprintf("%s tipi %s arayüzünü uygulamıyor: %s metodunun imzası farklı", t.String(), iface.String(), name)
+	default:
+		return fmt.Sprintf("%s tipi %s arayüzünü uygulamıyor: %s metodu eksik", t.String(), iface.String(), name)
+	}
+}
+
+// satisfiesInterface, value tipindeki bir değerin target arayüzüne
+// atanabilir olup olmadığını kontrol eder. nil her arayüze atanabilir.
+func (a *Analyzer) satisfiesInterface(value, target Type) bool {
+	iface, ok := target.(*InterfaceType)
+	if !ok {
+		return false
+	}
+	if isNullType(value) {
+		return true
+	}
+	name, _ := a.missingMethod(value, iface)
+	return name == ""
+}
+
+// isNullType, t'nin nil değerinin tipi olup olmadığını kontrol eder.
+func isNullType(t Type) bool {
+	basic, ok := t.(*BasicType)
+	return ok && basic.Kind == NULL_TYPE
+}
+
+// analyzeTypeAssertion, bir x.(T) tip iddiasını analiz eder. x bir arayüz
+// olmalıdır; T arayüz değilse arayüzü uygulamalıdır, aksi halde iddia hiçbir
+// zaman başarılı olamaz.
+func (a *Analyzer) analyzeTypeAssertion(expr *ast.TypeAssertExpression) Type {
+	operandType := a.analyzeExpression(expr.Expression)
+	if expr.Type == nil {
+		a.reportError(expr.Token, "%s yalnızca tip switch'inde kullanılabilir", expr.String())
+		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	}
+
+	assertedType := a.resolveType(expr.Type)
+	iface, ok := operandType.(*InterfaceType)
+	if !ok {
+		if !isUnknownType(operandType) {
+			a.reportError(expr.Token, "Tip iddiası yalnızca arayüz değerlerinde kullanılabilir: %s tipi bir arayüz değil", operandType.String())
+		}
+		return assertedType
+	}
+
+	a.checkAssertable(expr.Token, iface, assertedType)
+	return assertedType
+}
+
+// typeAssertOkTypes, v, ok := x.(T) biçimindeki atamalar için iddia edilen
+// tipi ve bool tipini döndürür. İfade bir tip iddiası değilse nil döner.
+func (a *Analyzer) typeAssertOkTypes(expr ast.Expression) []Type {
+	assertion, ok := expr.(*ast.TypeAssertExpression)
+	if !ok {
+		return nil
+	}
+	return []Type{a.analyzeTypeAssertion(assertion), &BasicType{Name: "bool", Kind: BOOLEAN_TYPE}}
+}
+
+// checkAssertable, iface tipindeki bir değerin t tipinde olabileceğini
+// denetler. Arayüz hedefleri çalışma zamanında denetlenir.
+func (a *Analyzer) checkAssertable(tok token.Token, iface *InterfaceType, t Type) {
+	if _, isInterface := t.(*InterfaceType); isInterface || isUnknownType(t) {
+		return
+	}
+	if msg := a.notImplemented(t, iface); msg != "" {
+		a.reportError(tok, "İmkansız tip iddiası: %s", msg)
+	}
+}
+
+// analyzeTypeSwitchStatement, bir tip switch'ini analiz eder. Her case kendi
+// kapsamına sahiptir; bağlanan değişken tek tipli case'lerde o tipte, diğer
+// case'lerde incelenen değerin tipindedir.
+func (a *Analyzer) analyzeTypeSwitchStatement(stmt *ast.TypeSwitchStatement) Type {
+	subjectType := a.analyzeExpression(stmt.Subject)
+	iface, isInterface := subjectType.(*InterfaceType)
+	if !isInterface && !isUnknownType(subjectType) {
+		a.reportError(stmt.Token, "Tip switch'i yalnızca arayüz değerlerinde kullanılabilir: %s tipi bir arayüz değil", subjectType.String())
+	}
+
+	seen := map[string]bool{}
+	for _, clause := range stmt.Cases {
+		var caseType Type
+		for _, value := range clause.Values {
+			tok := nodeToken(value)
+			if _, isNil := value.(*ast.NullLiteral); isNil {
+				caseType = &BasicType{Name: "nil", Kind: NULL_TYPE}
+			} else {
+				caseType = a.resolveType(value)
+				if isInterface {
+					a.checkAssertable(tok, iface, caseType)
+				}
+			}
+
+			if isUnknownType(caseType) {
+				continue
+			}
+			if seen[caseType.String()] {
+				a.reportError(tok, "Tip switch'inde tekrarlanan case: %s", caseType.String())
+			}
+			seen[caseType.String()] = true
+		}
+
+		clauseScope := NewScope(a.currentScope)
+		prevScope := a.currentScope
+		a.currentScope = clauseScope
+
+		if stmt.Binding != nil {
+			bindingType := subjectType
+			if len(clause.Values) == 1 && !isNullType(caseType) {
+				bindingType = caseType
+			}
+			a.currentScope.DefineVariable(stmt.Binding.Value, bindingType, stmt.Binding.Token)
+		}
+
+		for _, bodyStmt := range clause.Body {
+			a.analyzeStatement(bodyStmt)
+		}
+
+		a.currentScope = prevScope
+	}
+
+	return &BasicType{Name: "void", Kind: VOID_TYPE}
+}
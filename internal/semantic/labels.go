@@ -0,0 +1,335 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// branchTarget, break/continue ifadelerinin hedefleyebileceği, içinde
+// bulunulan bir döngü, switch veya select ifadesini temsil eder.
+type branchTarget struct {
+	label  string // Hedefin etiketi (etiketsizse boş)
+	isLoop bool   // continue yalnızca döngüleri hedefleyebilir
+}
+
+// labelInfo, bir fonksiyon gövdesinde tanımlanan bir etiketin bilgisini tutar.
+type labelInfo struct {
+	stmt  *ast.LabeledStatement
+	used  bool
+	block *ast.Statement // Etiketin bulunduğu ifade listesinin ilk elemanı
+	index int            // Etiketin listedeki sırası
+}
+
+// blockPos, denetlenen bir ifade listesini ve listede sıradaki ifadeyi tutar.
+type blockPos struct {
+	stmts []ast.Statement
+	index int
+}
+
+// branchChecker, tek bir fonksiyon gövdesindeki break, continue, goto ve
+// etiket kullanımlarını denetler. Etiketler fonksiyon kapsamlıdır; iç içe
+// fonksiyonlar kendi denetleyicileriyle ayrıca denetlenir.
+type branchChecker struct {
+	analyzer *Analyzer
+	labels   map[string]*labelInfo
+	order    []string // Etiketlerin tanımlanma sırası (hata sırası için)
+	targets  []branchTarget
+	pos      blockPos   // Etiketleri toplanan ifadenin konumu
+	path     []blockPos // Denetlenen ifadeyi içeren listeler, dıştan içe
+}
+
+// checkBranches, verilen fonksiyon gövdesindeki dallanma ifadelerini denetler.
+func (a *Analyzer) checkBranches(body []ast.Statement) {
+	bc := &branchChecker{
+		analyzer: a,
+		labels:   make(map[string]*labelInfo),
+	}
+
+	// goto ileriye atlayabildiği için önce tüm etiketler toplanır
+	bc.collectLabels(body)
+	bc.checkStatements(body)
+
+	for _, name := range bc.order {
+		if info := bc.labels[name]; !info.used {
+			a.reportError(info.stmt.Token, "Etiket tanımlandı ancak kullanılmadı: %s", name)
+		}
+	}
+}
+
+// collectLabels, iç içe fonksiyonlara girmeden gövdedeki tüm etiketleri toplar.
+func (bc *branchChecker) collectLabels(stmts []ast.Statement) {
+	for i, stmt := range stmts {
+		bc.pos = blockPos{stmts: stmts, index: i}
+		bc.collectLabelsIn(stmt)
+	}
+}
+
+func (bc *branchChecker) collectLabelsIn(stmt ast.Statement) {
+	switch s := stmt.(type) {
+	case *ast.LabeledStatement:
+		name := s.Label.Value
+		if _, exists := bc.labels[name]; exists {
+			bc.analyzer.reportError(s.Token, "Etiket zaten tanımlı: %s", name)
+		} else {
+			bc.labels[name] = &labelInfo{stmt: s, block: &bc.pos.stmts[0], index: bc.pos.index}
+			bc.order = append(bc.order, name)
+		}
+		bc.collectLabelsIn(s.Statement)
+	case *ast.BlockStatement:
+		if s != nil {
+			bc.collectLabels(s.Statements)
+		}
+	case *ast.ExpressionStatement:
+		if ifExpr, ok := s.Expression.(*ast.IfExpression); ok {
+			bc.collectLabelsIn(ifExpr.Consequence)
+			bc.collectLabelsIn(ifExpr.Alternative)
+		}
+	case *ast.ForStatement:
+		bc.collectLabelsIn(s.Body)
+	case *ast.RangeStatement:
+		bc.collectLabelsIn(s.Body)
+	case *ast.WhileStatement:
+		bc.collectLabelsIn(s.Body)
+	case *ast.SwitchStatement:
+		for _, c := range s.Cases {
+			bc.collectLabels(c.Body)
+		}
+	case *ast.TypeSwitchStatement:
+		for _, c := range s.Cases {
+			bc.collectLabels(c.Body)
+		}
+	case *ast.SelectStatement:
+		for _, c := range s.Cases {
+			bc.collectLabels(c.Body)
+		}
+	case *ast.TryCatchStatement:
+		bc.collectLabelsIn(s.Try)
+		for _, c := range s.Catches {
+			bc.collectLabelsIn(c.Body)
+		}
+		bc.collectLabelsIn(s.Finally)
+	case *ast.ScopeStatement:
+		bc.collectLabelsIn(s.Body)
+	case *ast.UnsafeStatement:
+		bc.collectLabelsIn(s.Body)
+	}
+}
+
+func (bc *branchChecker) checkStatements(stmts []ast.Statement) {
+	bc.path = append(bc.path, blockPos{stmts: stmts})
+	for i, stmt := range stmts {
+		bc.path[len(bc.path)-1].index = i
+		bc.checkStatement(stmt, "")
+	}
+	bc.path = bc.path[:len(bc.path)-1]
+}
+
+// checkStatement, bir ifadeyi denetler. label, ifade etiketliyse etiketin adıdır.
+func (bc *branchChecker) checkStatement(stmt ast.Statement, label string) {
+	switch s := stmt.(type) {
+	case *ast.BranchStatement:
+		bc.checkBranch(s)
+	case *ast.LabeledStatement:
+		bc.checkStatement(s.Statement, s.Label.Value)
+	case *ast.BlockStatement:
+		if s != nil {
+			bc.checkStatements(s.Statements)
+		}
+	case *ast.ExpressionStatement:
+		bc.checkExpression(s.Expression)
+	case *ast.VarStatement:
+		bc.checkExpression(s.Value)
+	case *ast.ReturnStatement:
+		for _, value := range s.ReturnValues {
+			bc.checkExpression(value)
+		}
+	case *ast.MultiAssignStatement:
+		for _, value := range s.Values {
+			bc.checkExpression(value)
+		}
+	case *ast.ForStatement:
+		bc.push(label, true)
+		bc.checkStatement(s.Body, "")
+		bc.pop()
+	case *ast.RangeStatement:
+		bc.push(label, true)
+		bc.checkStatement(s.Body, "")
+		bc.pop()
+	case *ast.WhileStatement:
+		bc.push(label, true)
+		bc.checkStatement(s.Body, "")
+		bc.pop()
+	case *ast.SwitchStatement:
+		bc.push(label, false)
+		for _, c := range s.Cases {
+			bc.checkStatements(c.Body)
+		}
+		bc.pop()
+	case *ast.TypeSwitchStatement:
+		bc.push(label, false)
+		for _, c := range s.Cases {
+			bc.checkStatements(c.Body)
+		}
+		bc.pop()
+	case *ast.SelectStatement:
+		bc.push(label, false)
+		for _, c := range s.Cases {
+			bc.checkStatements(c.Body)
+		}
+		bc.pop()
+	case *ast.TryCatchStatement:
+		bc.checkStatement(s.Try, "")
+		for _, c := range s.Catches {
+			bc.checkStatement(c.Body, "")
+		}
+		bc.checkStatement(s.Finally, "")
+	case *ast.ScopeStatement:
+		bc.checkStatement(s.Body, "")
+	case *ast.UnsafeStatement:
+		bc.checkStatement(s.Body, "")
+	case *ast.FunctionStatement:
+		bc.analyzer.checkBranches(s.Body.Statements)
+	case *ast.MethodStatement:
+		bc.analyzer.checkBranches(s.Body.Statements)
+	case *ast.ClassStatement:
+		bc.checkStatement(s.Body, "")
+	}
+}
+
+// checkExpression, ifade içindeki if bloklarını ve fonksiyon değişmezlerini denetler.
+func (bc *branchChecker) checkExpression(expr ast.Expression) {
+	switch e := expr.(type) {
+	case *ast.IfExpression:
+		bc.checkStatement(e.Consequence, "")
+		bc.checkStatement(e.Alternative, "")
+	case *ast.FunctionLiteral:
+		bc.analyzer.checkBranches(e.Body.Statements)
+	case *ast.CallExpression:
+		bc.checkExpression(e.Function)
+		for _, arg := range e.Arguments {
+			bc.checkExpression(arg)
+		}
+	case *ast.AssignExpression:
+		bc.checkExpression(e.Value)
+	case *ast.InfixExpression:
+		bc.checkExpression(e.Left)
+		bc.checkExpression(e.Right)
+	case *ast.PrefixExpression:
+		bc.checkExpression(e.Right)
+	case *ast.CompositeLiteral:
+		for _, element := range e.Elements {
+			bc.checkExpression(element)
+		}
+	case *ast.KeyValueExpression:
+		bc.checkExpression(e.Value)
+	}
+}
+
+// checkBranch, bir break, continue veya goto ifadesinin hedefini denetler.
+func (bc *branchChecker) checkBranch(stmt *ast.BranchStatement) {
+	if stmt.Label != nil {
+		info, exists := bc.labels[stmt.Label.Value]
+		if !exists {
+			bc.analyzer.reportError(stmt.Label.Token, "Tanımlanmamış etiket: %s", stmt.Label.Value)
+			return
+		}
+		info.used = true
+	}
+
+	switch stmt.Token.Type {
+	case token.GOTO:
+		bc.checkGotoScope(stmt)
+	case token.BREAK:
+		if stmt.Label != nil {
+			if bc.find(stmt.Label.Value, false) == nil {
+				bc.analyzer.reportError(stmt.Label.Token, "Geçersiz break etiketi: %s içinde bulunulan bir döngü veya switch değil", stmt.Label.Value)
+			}
+		} else if len(bc.targets) == 0 {
+			bc.analyzer.reportError(stmt.Token, "break ifadesi döngü veya switch dışında kullanılamaz")
+		}
+	case token.CONTINUE:
+		if stmt.Label != nil {
+			if bc.find(stmt.Label.Value, true) == nil {
+				bc.analyzer.reportError(stmt.Label.Token, "Geçersiz continue etiketi: %s içinde bulunulan bir döngü değil", stmt.Label.Value)
+			}
+		} else if bc.find("", true) == nil {
+			bc.analyzer.reportError(stmt.Token, "continue ifadesi döngü dışında kullanılamaz")
+		}
+	}
+}
+
+// checkGotoScope, ileriye atlayan bir goto'nun bir değişken tanımının
+// üzerinden atlayarak değişkeni kapsama sokmadığını denetler. Etiket goto'yu
+// içeren listelerden birindeyse, goto'yu içeren ifade ile etiket arasındaki
+// tanımlar denetlenir.
+func (bc *branchChecker) checkGotoScope(stmt *ast.BranchStatement) {
+	if stmt.Label == nil {
+		return
+	}
+	info := bc.labels[stmt.Label.Value]
+	for i := len(bc.path) - 1; i >= 0; i-- {
+		pos := bc.path[i]
+		if &pos.stmts[0] != info.block {
+			continue
+		}
+		if info.index <= pos.index {
+			// Geriye atlayan goto yeni bir değişkeni kapsama sokmaz
+			return
+		}
+		for _, skipped := range pos.stmts[pos.index+1 : info.index] {
+			if name := declaredVariable(skipped); name != "" {
+				bc.analyzer.reportError(stmt.Label.Token, "goto %s, %s değişkeninin tanımının üzerinden atlıyor", stmt.Label.Value, name)
+				return
+			}
+		}
+		return
+	}
+}
+
+// declaredVariable, bir ifadenin tanımladığı ilk değişkenin adını döndürür;
+// ifade bir değişken tanımlamıyorsa boş döner.
+func declaredVariable(stmt ast.Statement) string {
+	switch s := stmt.(type) {
+	case *ast.VarStatement:
+		return s.Name.Value
+	case *ast.LabeledStatement:
+		return declaredVariable(s.Statement)
+	case *ast.ExpressionStatement:
+		if infix, ok := s.Expression.(*ast.InfixExpression); ok && infix.Operator == ":=" {
+			if ident, ok := infix.Left.(*ast.Identifier); ok {
+				return ident.Value
+			}
+		}
+	case *ast.MultiAssignStatement:
+		if s.Operator == ":=" {
+			for _, left := range s.Left {
+				if ident, ok := left.(*ast.Identifier); ok && ident.Value != "_" {
+					return ident.Value
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// find, içten dışa doğru verilen etikete (boşsa herhangi birine) uyan hedefi arar.
+func (bc *branchChecker) find(label string, loopOnly bool) *branchTarget {
+	for i := len(bc.targets) - 1; i >= 0; i-- {
+		target := &bc.targets[i]
+		if loopOnly && !target.isLoop {
+			continue
+		}
+		if label == "" || target.label == label {
+			return target
+		}
+	}
+	return nil
+}
+
+func (bc *branchChecker) push(label string, isLoop bool) {
+	bc.targets = append(bc.targets, branchTarget{label: label, isLoop: isLoop})
+}
+
+func (bc *branchChecker) pop() {
+	bc.targets = bc.targets[:len(bc.targets)-1]
+}
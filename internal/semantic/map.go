@@ -0,0 +1,137 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// resolveMapType, map[K]V tip ifadesini çözümler. Anahtar tipi == ile
+// karşılaştırılabilir olmalıdır; slice, map ve fonksiyonlar anahtar olamaz.
+func (a *Analyzer) resolveMapType(expr *ast.MapType) Type {
+	keyType := a.resolveType(expr.Key)
+	valueType := a.resolveType(expr.Value)
+
+	if !isUnknownType(keyType) && !isComparableKey(keyType) {
+		a.reportError(expr.Token, "Geçersiz map anahtar tipi: %s", keyType.String())
+	}
+
+	return &MapType{KeyType: keyType, ValueType: valueType}
+}
+
+// isComparableKey, bir tipin map anahtarı olarak kullanılıp
+// kullanılamayacağını belirler. Temel tipler, sınıflar, kanallar ve alanları
+// anahtar olabilen struct'lar anahtar olabilir.
+func isComparableKey(t Type) bool {
+	switch t := underlyingType(t).(type) {
+	case *BasicType:
+		return t.Kind != VOID_TYPE && t.Kind != NULL_TYPE
+	case *ClassType, *ChanType:
+		return true
+	case *StructType:
+		for _, field := range t.Fields {
+			if !isComparableKey(field.Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// analyzeMapLiteral, bir map değişmezinin anahtar: değer çiftlerini denetler.
+// Sabit anahtarların tekrarlanması hatadır.
+func (a *Analyzer) analyzeMapLiteral(expr *ast.CompositeLiteral, mt *MapType) {
+	seen := map[string]bool{}
+	for _, element := range expr.Elements {
+		kv, ok := element.(*ast.KeyValueExpression)
+		if !ok {
+			a.reportError(expr.Token, "Map değişmezinde anahtar eksik")
+			a.analyzeExpression(element)
+			continue
+		}
+
+		a.checkMapKey(kv.Token, kv.Key, mt)
+		if key := constantKey(kv.Key); key != "" {
+			if seen[key] {
+				a.reportError(kv.Token, "Map değişmezinde tekrarlanan anahtar: %s", key)
+			}
+			seen[key] = true
+		}
+
+		valueType := a.analyzeExpression(kv.Value)
+		if !a.isAssignableType(valueType, mt.ValueType) {
+			a.reportError(kv.Token, "%s tipindeki değer %s tipindeki map'e eklenemez",
+				valueType.String(), mt.String())
+		}
+	}
+}
+
+// constantKey, bir map değişmezindeki literal anahtarın karşılaştırma için
+// kullanılacak temsilini döndürür; literal olmayan anahtarlar için boştur.
+func constantKey(expr ast.Expression) string {
+	switch expr.(type) {
+	case *ast.IntegerLiteral, *ast.StringLiteral, *ast.CharLiteral, *ast.BooleanLiteral:
+		return expr.String()
+	}
+	return ""
+}
+
+// checkMapKey, bir ifadenin map'in anahtar tipine atanabilirliğini kontrol eder.
+func (a *Analyzer) checkMapKey(tok token.Token, key ast.Expression, mt *MapType) {
+	keyType := a.analyzeExpression(key)
+	if !a.isAssignableType(keyType, mt.KeyType) {
+		a.reportError(tok, "%s tipindeki anahtar %s tipindeki map'te kullanılamaz",
+			keyType.String(), mt.String())
+	}
+}
+
+// analyzeMapIndex, m[k] ifadesini analiz eder. Sonuç map'in değer tipidir;
+// bulunmayan anahtarlar için değer tipinin sıfır değeri okunur.
+func (a *Analyzer) analyzeMapIndex(expr *ast.IndexExpression, mt *MapType) Type {
+	a.checkMapKey(expr.Token, expr.Index, mt)
+	return mt.ValueType
+}
+
+// mapIndexOkTypes, v, ok := m[k] biçimindeki bir okumanın değer ve varlık
+// tiplerini döndürür; ifade bir map okuması değilse nil döner.
+func (a *Analyzer) mapIndexOkTypes(expr ast.Expression) []Type {
+	index, ok := expr.(*ast.IndexExpression)
+	if !ok {
+		return nil
+	}
+	mt, ok := underlyingType(a.analyzeExpression(index.Left)).(*MapType)
+	if !ok {
+		return nil
+	}
+	return []Type{a.analyzeMapIndex(index, mt), &BasicType{Name: "bool", Kind: BOOLEAN_TYPE}}
+}
+
+// analyzeDeleteCall, delete(m, k) çağrısını analiz eder. Anahtar map'in
+// anahtar tipine atanabilir olmalıdır. Tek argümanlı delete(p), unsafe
+// bloklarında alloc ile ayrılan belleği serbest bırakır.
+func (a *Analyzer) analyzeDeleteCall(expr *ast.CallExpression) Type {
+	voidType := &BasicType{Name: "void", Kind: VOID_TYPE}
+	if len(expr.Arguments) == 1 {
+		return a.analyzeFreeCall(expr, "delete")
+	}
+	if len(expr.Arguments) != 2 {
+		a.reportError(expr.Token, "delete fonksiyonu 2 argüman alır, %d verildi", len(expr.Arguments))
+		for _, arg := range expr.Arguments {
+			a.analyzeExpression(arg)
+		}
+		return voidType
+	}
+
+	mapType := a.analyzeExpression(expr.Arguments[0])
+	mt, ok := underlyingType(mapType).(*MapType)
+	if !ok {
+		if !isUnknownType(mapType) {
+			a.reportError(expr.Token, "delete işlemi bir map gerektirir, %s alındı", mapType.String())
+		}
+		a.analyzeExpression(expr.Arguments[1])
+		return voidType
+	}
+
+	a.checkMapKey(expr.Token, expr.Arguments[1], mt)
+	return voidType
+}
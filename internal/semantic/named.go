@@ -0,0 +1,170 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// underlyingType, bir tipin dayanak tipini döndürür. Adlandırılmış olmayan
+// tipler kendi dayanak tipleridir.
+func underlyingType(t Type) Type {
+	named, ok := t.(*NamedType)
+	if !ok {
+		return t
+	}
+	if named.Underlying == nil {
+		// Tanımı tamamlanmamış (ör. özyinelemeli) tip; hata zaten raporlanmıştır
+		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	}
+	return named.Underlying
+}
+
+// typeSymbolKind, bir tipin adını taşıyan sembolün türünü belirler.
+func typeSymbolKind(t Type) SymbolType {
+	switch t.(type) {
+	case *StructType:
+		return STRUCT_TYPE
+	case *InterfaceType:
+		return INTERFACE_TYPE
+	case *ClassType:
+		return CLASS_TYPE
+	}
+	return symbolTypeFromType(underlyingType(t))
+}
+
+// isNumericType, bir tipin (dayanak tipine göre) sayısal olup olmadığını kontrol eder.
+func isNumericType(t Type) bool {
+	basicType, ok := underlyingType(t).(*BasicType)
+	return ok && (basicType.Kind == INTEGER_TYPE || basicType.Kind == FLOAT_TYPE || basicType.Kind == CHAR_TYPE)
+}
+
+// namedOperandType, ikili bir işlemin adlandırılmış tipteki işleneninin
+// tipini döndürür; işlenenlerin hiçbiri adlandırılmış değilse nil döner. İki
+// farklı adlandırılmış tip aynı işlemde kullanılamaz. Tipsiz sabitler ayrıca
+// izlenmediğinden adlandırılmış bir tip, kendisine atanabilen adsız bir
+// tiple birlikte kullanılabilir (c * 2 gibi); diğer işlenenler reddedilir.
+func (a *Analyzer) namedOperandType(tok token.Token, leftType, rightType Type) *NamedType {
+	leftNamed, leftOk := leftType.(*NamedType)
+	rightNamed, rightOk := rightType.(*NamedType)
+	switch {
+	case leftOk && rightOk:
+		if leftNamed != rightNamed {
+			a.reportError(tok, "Uyumsuz tipler: %s ve %s", leftNamed.Name, rightNamed.Name)
+		}
+		return leftNamed
+	case leftOk:
+		if !a.isAssignableType(rightType, leftNamed) && !isNullComparison(leftType, rightType) {
+			a.reportError(tok, "Uyumsuz tipler: %s ve %s", leftNamed.Name, rightType.String())
+		}
+		return leftNamed
+	case rightOk:
+		if !a.isAssignableType(leftType, rightNamed) && !isNullComparison(leftType, rightType) {
+			a.reportError(tok, "Uyumsuz tipler: %s ve %s", leftType.String(), rightNamed.Name)
+		}
+		return rightNamed
+	}
+	return nil
+}
+
+// namedAssignable, taraflardan yalnızca biri adlandırılmış tip iken değerin
+// hedefe atanıp atanamayacağını kontrol eder: dayanak tipler özdeş olmalıdır.
+// Adlandırılmış bir değer yalnızca tip literali olan bir hedefe ([]int,
+// func(int) int gibi) atanabilir; int gibi önceden tanımlı veya
+// adlandırılmış bir hedefe ancak dönüşümle (int(c)) atanabilir. İki
+// adlandırılmış tip yalnızca aynı tipse birbirine atanabilir; bu durum Equals
+// ile denetlenir.
+func namedAssignable(value, target Type) bool {
+	_, valueNamed := value.(*NamedType)
+	_, targetNamed := target.(*NamedType)
+	if valueNamed == targetNamed || valueNamed && isDefinedType(target) {
+		return false
+	}
+	return underlyingType(value).Equals(underlyingType(target))
+}
+
+// isDefinedType, bir tipin adı olan bir tip olup olmadığını kontrol eder:
+// önceden tanımlı temel tipler, sınıflar ve adlandırılmış struct, arayüz ve
+// diğer tipler. Tip literalleri adsızdır.
+func isDefinedType(t Type) bool {
+	switch t := t.(type) {
+	case *BasicType, *ClassType, *NamedType:
+		return true
+	case *StructType:
+		return t.Name != ""
+	case *InterfaceType:
+		return t.Name != ""
+	}
+	return false
+}
+
+// conversionType, bir çağrının fonksiyon ifadesi bir tipse (T(x) dönüşümü)
+// o tipi döndürür; aksi halde nil döner.
+func (a *Analyzer) conversionType(expr ast.Expression) Type {
+	switch e := expr.(type) {
+	case *ast.Identifier:
+		symbol := a.currentScope.Resolve(e.Value)
+		if symbol != nil && symbol.IsType {
+			return symbol.DataType
+		}
+		if kind, ok := builtinTypeKinds[e.Value]; ok && symbol == nil {
+			return &BasicType{Name: e.Value, Kind: kind}
+		}
+	case *ast.ArrayType, *ast.FuncType, *ast.InterfaceType, *ast.StructType:
+		return a.resolveType(expr)
+	}
+	return nil
+}
+
+// analyzeConversion, T(x) biçimindeki bir tip dönüşümünü analiz eder.
+// Değer hedef tipe atanabiliyorsa, dayanak tipler özdeşse veya iki taraf da
+// sayısal ise dönüşüm geçerlidir. Sonuç hedef tiptedir.
+func (a *Analyzer) analyzeConversion(expr *ast.CallExpression, target Type) Type {
+	if len(expr.Arguments) != 1 {
+		a.reportError(expr.Token, "%s dönüşümü tek bir argüman alır, %d verildi", target.String(), len(expr.Arguments))
+		for _, arg := range expr.Arguments {
+			a.analyzeExpression(arg)
+		}
+		return target
+	}
+
+	valueType := a.analyzeExpression(expr.Arguments[0])
+	if !a.isConvertible(valueType, target) {
+		a.reportError(expr.Token, "%s tipindeki değer %s tipine dönüştürülemez", valueType.String(), target.String())
+	}
+	return target
+}
+
+// isConvertible, value tipindeki bir değerin target tipine açıkça
+// dönüştürülüp dönüştürülemeyeceğini kontrol eder.
+func (a *Analyzer) isConvertible(value, target Type) bool {
+	if a.isAssignableType(value, target) || isNumericType(value) && isNumericType(target) {
+		return true
+	}
+	if isStringConversion(value, target) {
+		return true
+	}
+
+	valueUnderlying, targetUnderlying := underlyingType(value), underlyingType(target)
+	valueStruct, valueIsStruct := valueUnderlying.(*StructType)
+	targetStruct, targetIsStruct := targetUnderlying.(*StructType)
+	if valueIsStruct && targetIsStruct {
+		// Alanları özdeş struct'lar (ör. type MyPoint Point) birbirine dönüşür
+		return sameFields(valueStruct, targetStruct)
+	}
+	return valueUnderlying.Equals(targetUnderlying)
+}
+
+// sameFields, iki struct'ın alanlarının adları ve tipleriyle özdeş olup
+// olmadığını kontrol eder. Dönüşümlerde etiketler yok sayılır.
+func sameFields(st, other *StructType) bool {
+	if len(st.Fields) != len(other.Fields) {
+		return false
+	}
+	for i, field := range st.Fields {
+		otherField := other.Fields[i]
+		if field.Name != otherField.Name || field.Embedded != otherField.Embedded || !field.Type.Equals(otherField.Type) {
+			return false
+		}
+	}
+	return true
+}
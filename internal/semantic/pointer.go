@@ -0,0 +1,138 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// analyzeAddressOf, &x ifadesini analiz eder. Adresi alınabilen ifadeler
+// değişkenler, indeks ifadeleri, üye erişimleri, *p ve bileşik değişmezlerdir
+// (&Point{1, 2}).
+func (a *Analyzer) analyzeAddressOf(expr *ast.PrefixExpression, operandType Type) Type {
+	switch operand := expr.Right.(type) {
+	case *ast.Identifier:
+		if symbol := a.currentScope.Resolve(operand.Value); symbol != nil && symbol.IsConst {
+			a.reportError(expr.Token, "Sabitin adresi alınamaz: %s", operand.Value)
+		}
+	case *ast.IndexExpression, *ast.MemberExpression, *ast.CompositeLiteral:
+	case *ast.PrefixExpression:
+		if operand.Operator != "*" {
+			a.reportError(expr.Token, "%s ifadesinin adresi alınamaz", operand.String())
+		}
+	default:
+		a.reportError(expr.Token, "%s ifadesinin adresi alınamaz", expr.Right.String())
+	}
+
+	if isUnknownType(operandType) {
+		return operandType
+	}
+	return &PointerType{ElementType: operandType}
+}
+
+// analyzeDereference, *p ifadesini analiz eder ve işaretçinin gösterdiği
+// tipi döndürür.
+func (a *Analyzer) analyzeDereference(expr *ast.PrefixExpression, operandType Type) Type {
+	if isUnknownType(operandType) {
+		return operandType
+	}
+	pt, ok := underlyingType(operandType).(*PointerType)
+	if !ok {
+		a.reportError(expr.Token, "* işleci bir işaretçi gerektirir, %s alındı", operandType.String())
+		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	}
+	return pt.ElementType
+}
+
+// checkPointerArithmetic, işlenenlerinden biri işaretçi olan + ve -
+// işlemlerini denetler: p + n, n + p ve p - n işaretçi, aynı tipteki iki
+// işaretçinin farkı (p - q) eleman sayısı olarak int döndürür. İşaretçi
+// aritmetiği yalnızca unsafe bloklarında kullanılabilir. İşlem işaretçi
+// aritmetiği değilse ikinci dönüş değeri false olur.
+func (a *Analyzer) checkPointerArithmetic(tok token.Token, operator string, leftType, rightType Type) (Type, bool) {
+	if operator != "+" && operator != "-" {
+		return nil, false
+	}
+	leftPointer, leftOk := underlyingType(leftType).(*PointerType)
+	rightPointer, rightOk := underlyingType(rightType).(*PointerType)
+	if !leftOk && !rightOk {
+		return nil, false
+	}
+
+	a.checkUnsafe(tok, "İşaretçi aritmetiği")
+
+	switch {
+	case leftOk && isIntegerType(rightType):
+		return leftType, true
+	case operator == "+" && rightOk && isIntegerType(leftType):
+		return rightType, true
+	case operator == "-" && leftOk && rightOk && leftPointer.Equals(rightPointer):
+		return &BasicType{Name: "int", Kind: INTEGER_TYPE}, true
+	}
+	a.reportError(tok, "Geçersiz işaretçi aritmetiği: %s %s %s", leftType.String(), operator, rightType.String())
+	return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}, true
+}
+
+// checkUnsafe, bir işlemin unsafe bloğu içinde kullanılıp kullanılmadığını
+// denetler.
+func (a *Analyzer) checkUnsafe(tok token.Token, operation string) {
+	if a.unsafeDepth == 0 {
+		a.reportError(tok, "%s yalnızca unsafe bloklarında kullanılabilir", operation)
+	}
+}
+
+// analyzeUnsafeStatement, bir unsafe bloğunu analiz eder. Blok içindeki
+// fonksiyon değişmezleri de bloğun içinde sayılır.
+func (a *Analyzer) analyzeUnsafeStatement(stmt *ast.UnsafeStatement) Type {
+	a.unsafeDepth++
+	defer func() { a.unsafeDepth-- }()
+
+	if stmt.Body != nil {
+		return a.analyzeBlockStatement(stmt.Body)
+	}
+	return &BasicType{Name: "void", Kind: VOID_TYPE}
+}
+
+// analyzeAllocCall, alloc(T) ve alloc(T, n) çağrılarını analiz eder. Sonuç,
+// sıfırlanmış n elemanlık belleğin ilk elemanını gösteren *T'dir.
+func (a *Analyzer) analyzeAllocCall(expr *ast.CallExpression) Type {
+	a.checkUnsafe(expr.Token, "alloc")
+
+	if n := len(expr.Arguments); n == 0 || n > 2 {
+		a.reportError(expr.Token, "alloc fonksiyonu 1 veya 2 argüman alır, %d verildi", n)
+		if n == 0 {
+			return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+		}
+	}
+
+	elemType := a.resolveType(expr.Arguments[0])
+	for _, arg := range expr.Arguments[1:] {
+		if countType := a.analyzeExpression(arg); !isUnknownType(countType) && !isIntegerType(countType) {
+			a.reportError(expr.Token, "alloc boyutu tamsayı olmalıdır, %s alındı", countType.String())
+		}
+	}
+	if isUnknownType(elemType) {
+		return elemType
+	}
+	return &PointerType{ElementType: elemType}
+}
+
+// analyzeFreeCall, alloc ile ayrılan belleği serbest bırakan free(p) ve
+// delete(p) çağrılarını analiz eder.
+func (a *Analyzer) analyzeFreeCall(expr *ast.CallExpression, name string) Type {
+	voidType := &BasicType{Name: "void", Kind: VOID_TYPE}
+	a.checkUnsafe(expr.Token, name+"(p)")
+
+	if len(expr.Arguments) != 1 {
+		a.reportError(expr.Token, "%s fonksiyonu 1 argüman alır, %d verildi", name, len(expr.Arguments))
+		for _, arg := range expr.Arguments {
+			a.analyzeExpression(arg)
+		}
+		return voidType
+	}
+
+	ptrType := a.analyzeExpression(expr.Arguments[0])
+	if _, ok := underlyingType(ptrType).(*PointerType); !ok && !isUnknownType(ptrType) {
+		a.reportError(expr.Token, "%s işlemi bir işaretçi gerektirir, %s alındı", name, ptrType.String())
+	}
+	return voidType
+}
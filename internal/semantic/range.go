@@ -0,0 +1,112 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// rangeTypes, range ile dolaşılan bir tipin her adımda ürettiği indeks/anahtar
+// ve değer tiplerini döndürür. Tamsayı ve kanal üzerinde dolaşmada değer tipi
+// nil'dir.
+func (a *Analyzer) rangeTypes(tok token.Token, iterType Type) (Type, Type) {
+	intType := &BasicType{Name: "int", Kind: INTEGER_TYPE}
+	unknownType := &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+
+	switch t := underlyingType(iterType).(type) {
+	case *ArrayType:
+		return intType, t.ElementType
+	case *MapType:
+		return t.KeyType, t.ValueType
+	case *HashType:
+		return t.KeyType, t.ValueType
+	case *ChanType:
+		// Kanal kapanana kadar alınan değerler dolaşılır
+		if a.channelOperand(tok, iterType, ast.RECV, "Range") == nil {
+			return unknownType, nil
+		}
+		return t.ElementType, nil
+	case *BasicType:
+		switch t.Kind {
+		case STRING_TYPE:
+			// String'ler UTF-8 olarak çözülür: bayt indeksi ve rune
+			return intType, &BasicType{Name: "char", Kind: CHAR_TYPE}
+		case INTEGER_TYPE:
+			// for i := range n, 0'dan n-1'e kadar dolaşır
+			return intType, nil
+		case UNKNOWN_TYPE:
+			return unknownType, unknownType
+		}
+	}
+
+	a.reportError(tok, "range ifadesi dizi, slice, string, map, kanal veya tamsayı olmalıdır, %s alındı", iterType.String())
+	return unknownType, unknownType
+}
+
+// analyzeRangeStatement, bir for-range döngüsünü analiz eder. := ile tanımlanan
+// değişkenler döngü kapsamındadır ve her adımda yeni bir kopya olarak bağlanır.
+func (a *Analyzer) analyzeRangeStatement(stmt *ast.RangeStatement) Type {
+	iterType := a.analyzeExpression(stmt.Iterable)
+	keyType, valueType := a.rangeTypes(stmt.Token, iterType)
+
+	if stmt.Value != nil && valueType == nil {
+		if _, isChan := underlyingType(iterType).(*ChanType); isChan {
+			a.reportError(stmt.Token, "Kanal üzerinde range en fazla bir değişken alabilir")
+		} else {
+			a.reportError(stmt.Token, "Tamsayı üzerinde range en fazla bir değişken alabilir")
+		}
+		valueType = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	}
+
+	rangeScope := NewScope(a.currentScope)
+	prevScope := a.currentScope
+	a.currentScope = rangeScope
+
+	a.bindRangeVariable(stmt, stmt.Key, keyType)
+	a.bindRangeVariable(stmt, stmt.Value, valueType)
+
+	a.analyzeBlockStatement(stmt.Body)
+
+	a.currentScope = prevScope
+
+	return &BasicType{Name: "void", Kind: VOID_TYPE}
+}
+
+// bindRangeVariable, bir range değişkenini tanımlar (:=) veya atamanın
+// hedefini denetler (=). "_" tanımlayıcısı değeri yok sayar.
+func (a *Analyzer) bindRangeVariable(stmt *ast.RangeStatement, expr ast.Expression, varType Type) {
+	if expr == nil {
+		return
+	}
+
+	ident, isIdent := expr.(*ast.Identifier)
+	if isIdent && ident.Value == "_" {
+		return
+	}
+
+	if stmt.Define {
+		if !isIdent {
+			a.reportError(stmt.Token, "range değişkeni bir tanımlayıcı olmalıdır")
+			return
+		}
+		a.currentScope.DefineVariable(ident.Value, varType, ident.Token)
+		return
+	}
+
+	targetType := a.analyzeExpression(expr)
+	if !a.checkAssignable(stmt.Token, expr) {
+		return
+	}
+	if isUnknownType(targetType) || isUnknownType(varType) {
+		return
+	}
+	if !targetType.Equals(varType) {
+		a.reportError(stmt.Token, "range değeri %s tipinde, %s tipindeki %s değişkenine atanamaz",
+			varType.String(), targetType.String(), expr.String())
+	}
+}
+
+// isUnknownType, bir tipin henüz bilinmediğini kontrol eder.
+func isUnknownType(t Type) bool {
+	basicType, ok := t.(*BasicType)
+	return ok && basicType.Kind == UNKNOWN_TYPE
+}
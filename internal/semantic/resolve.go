@@ -0,0 +1,182 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// builtinTypeKinds, yerleşik tip adlarını temel tip türlerine eşler.
+var builtinTypeKinds = map[string]SymbolType{
+	"int":     INTEGER_TYPE,
+	"int8":    INTEGER_TYPE,
+	"int16":   INTEGER_TYPE,
+	"int32":   INTEGER_TYPE,
+	"int64":   INTEGER_TYPE,
+	"uint":    INTEGER_TYPE,
+	"uint8":   INTEGER_TYPE,
+	"uint16":  INTEGER_TYPE,
+	"uint32":  INTEGER_TYPE,
+	"uint64":  INTEGER_TYPE,
+	"byte":    INTEGER_TYPE,
+	"float":   FLOAT_TYPE,
+	"float32": FLOAT_TYPE,
+	"float64": FLOAT_TYPE,
+	"string":  STRING_TYPE,
+	"bool":    BOOLEAN_TYPE,
+	"char":    CHAR_TYPE,
+	"rune":    CHAR_TYPE,
+}
+
+// resolveType, bir tip ifadesini (int, Point, *T, [4]int, ...int, chan T, map[K]V, struct{...}, interface{...}, func(...), (int, error)) semantik
+// bir tipe çözümler. Çözümlenemeyen tipler için hata raporlanır ve bilinmeyen
+// tip döndürülür.
+func (a *Analyzer) resolveType(expr ast.Expression) Type {
+	unknownType := &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+
+	switch e := expr.(type) {
+	case nil:
+		return unknownType
+	case *ast.Identifier:
+		if kind, ok := builtinTypeKinds[e.Value]; ok {
+			return &BasicType{Name: e.Value, Kind: kind}
+		}
+
+		symbol := a.currentScope.Resolve(e.Value)
+		switch {
+		case symbol == nil:
+			a.reportError(e.Token, "Bilinmeyen tip: %s", e.Value)
+		case symbol.IsType:
+			return symbol.DataType
+		case symbol.Type == CLASS_TYPE:
+			return classTypeOf(symbol)
+		case symbol.Type == TEMPLATE_TYPE:
+			// Şablon parametreleri örnekleme sırasında belirlenir
+		default:
+			a.reportError(e.Token, "%s bir tip değil", e.Value)
+		}
+		return unknownType
+	case *ast.MemberExpression:
+		// Diğer paketlerin tipleri (ör. io.Reader) henüz çözümlenmiyor
+		return unknownType
+	case *ast.ArrayType:
+		elementType := a.resolveType(e.ElementType)
+		if e.Size == nil {
+			return &ArrayType{ElementType: elementType, Size: -1}
+		}
+		sizeLit, ok := e.Size.(*ast.IntegerLiteral)
+		if !ok {
+			a.reportError(e.Token, "Dizi boyutu sabit bir tamsayı olmalıdır")
+			return &ArrayType{ElementType: elementType, Size: -1}
+		}
+		if sizeLit.Value < 0 {
+			a.reportError(sizeLit.Token, "Array boyutu negatif olamaz")
+		}
+		return &ArrayType{ElementType: elementType, Size: sizeLit.Value}
+	case *ast.Ellipsis:
+		// Variadic parametre, fonksiyon içinde bir slice'tır
+		return &ArrayType{ElementType: a.resolveType(e.Element), Size: -1}
+	case *ast.PointerType:
+		return &PointerType{ElementType: a.resolveType(e.Elem)}
+	case *ast.PrefixExpression:
+		// İfade konumunda yazılan *T (ör. alloc(*int, n))
+		if e.Operator != "*" {
+			a.reportError(e.Token, "Geçersiz tip ifadesi: %s", e.String())
+			return unknownType
+		}
+		return &PointerType{ElementType: a.resolveType(e.Right)}
+	case *ast.ChanType:
+		return &ChanType{ElementType: a.resolveType(e.Value), Dir: e.Dir}
+	case *ast.MapType:
+		return a.resolveMapType(e)
+	case *ast.StructType:
+		structType := &StructType{}
+		a.resolveStructFields(e, structType)
+		return structType
+	case *ast.InterfaceType:
+		interfaceType := &InterfaceType{Methods: make(map[string]*FunctionType)}
+		a.resolveInterfaceType(e, interfaceType)
+		return interfaceType
+	case *ast.FuncType:
+		funcType := &FunctionType{ReturnType: &BasicType{Name: "void", Kind: VOID_TYPE}}
+		for _, param := range e.Parameters {
+			funcType.ParameterTypes = append(funcType.ParameterTypes, a.resolveType(param))
+		}
+		if n := len(e.Parameters); n > 0 {
+			_, funcType.IsVariadic = e.Parameters[n-1].(*ast.Ellipsis)
+		}
+		if e.ReturnType != nil {
+			funcType.ReturnType = a.resolveType(e.ReturnType)
+		}
+		return funcType
+	case *ast.ResultList:
+		// Tek ve adlandırılmış bir sonuç, sonucun tipidir
+		tuple := &TupleType{}
+		for _, result := range e.Results {
+			tuple.Types = append(tuple.Types, a.resolveType(result.Type))
+		}
+		if len(tuple.Types) == 1 {
+			return tuple.Types[0]
+		}
+		return tuple
+	default:
+		a.reportError(nodeToken(expr), "Geçersiz tip ifadesi: %s", expr.String())
+		return unknownType
+	}
+}
+
+// isAssignableType, value tipindeki bir değerin target tipindeki bir hedefe
+// atanıp atanamayacağını kontrol eder. Bilinmeyen tipler hata zincirini
+// önlemek için atanabilir sayılır; tamsayı değerler ondalık hedeflere, arayüzü
+// uygulayan değerler arayüz hedeflerine, adsız değerler dayanak tipi aynı olan
+// adlandırılmış hedeflere, çift yönlü kanallar yönlü kanallara atanabilir.
+func (a *Analyzer) isAssignableType(value, target Type) bool {
+	if value == nil || target == nil || isUnknownType(value) || isUnknownType(target) {
+		return true
+	}
+	if value.Equals(target) || a.satisfiesInterface(value, target) || namedAssignable(value, target) ||
+		chanAssignable(value, target) {
+		return true
+	}
+	valueBasic, valueIsBasic := value.(*BasicType)
+	targetBasic, targetIsBasic := underlyingType(target).(*BasicType)
+	if valueIsBasic && targetIsBasic {
+		if targetBasic.Kind == FLOAT_TYPE && valueBasic.Kind == INTEGER_TYPE {
+			return true
+		}
+		// null, sınıf ve bileşik tipler için sıfır değerdir
+		return false
+	}
+	if valueIsBasic && valueBasic.Kind == NULL_TYPE {
+		return isNullable(target)
+	}
+	return false
+}
+
+// isNullable, bir tipin sıfır değerinin null olup olmadığını kontrol eder:
+// sınıflar, arayüzler, fonksiyonlar, kanallar, map'ler ve işaretçiler null
+// ile karşılaştırılabilir ve null alabilir.
+func isNullable(t Type) bool {
+	switch underlyingType(t).(type) {
+	case *ClassType, *InterfaceType, *FunctionType, *ChanType, *MapType, *PointerType:
+		return true
+	}
+	return false
+}
+
+// isNullComparison, işlenenlerden birinin null, diğerinin null alabilen bir
+// tip olduğu karşılaştırmaları (err != nil) tanır.
+func isNullComparison(left, right Type) bool {
+	if basic, ok := right.(*BasicType); ok && basic.Kind == NULL_TYPE {
+		return isNullable(left)
+	}
+	if basic, ok := left.(*BasicType); ok && basic.Kind == NULL_TYPE {
+		return isNullable(right)
+	}
+	return false
+}
+
+// nodeToken, token alanına doğrudan erişilemeyen düğümler için hata
+// raporlamada kullanılacak bir token oluşturur.
+func nodeToken(node ast.Node) token.Token {
+	return token.Token{Literal: node.TokenLiteral(), Position: node.Pos()}
+}
@@ -17,6 +17,11 @@ type Analyzer struct {
 	imports       []string
 	typeInference bool // Tip çıkarımı etkin mi?
 	inferencer    *TypeInference
+	function      *functionContext // Gövdesi analiz edilen fonksiyon
+	unsafeDepth   int              // İç içe unsafe bloklarının derinliği
+
+	// Bir string'i indeksleyen ifadeler; string baytlarına atama yapılamaz
+	stringIndexes map[*ast.IndexExpression]bool
 }
 
 // New, yeni bir Analyzer oluşturur.
@@ -31,6 +36,7 @@ func New() *Analyzer {
 		packageName:   "",
 		imports:       []string{},
 		typeInference: true, // Varsayılan olarak tip çıkarımı etkin
+		stringIndexes: make(map[*ast.IndexExpression]bool),
 	}
 
 	a.inferencer = NewTypeInference(a)
@@ -52,14 +58,30 @@ func (a *Analyzer) PrintGlobalScope() {
 // initializeBuiltins, built-in functions ve packages'ları global scope'a ekler.
 func (a *Analyzer) initializeBuiltins() {
 	// Built-in functions
-	a.addBuiltinFunction("println", []SymbolType{}, VOID_TYPE)
-	a.addBuiltinFunction("print", []SymbolType{}, VOID_TYPE)
+	a.addVariadicBuiltinFunction("println", []SymbolType{}, VOID_TYPE)
+	a.addVariadicBuiltinFunction("print", []SymbolType{}, VOID_TYPE)
 	a.addBuiltinFunction("panic", []SymbolType{UNKNOWN_TYPE}, VOID_TYPE)
 	a.addBuiltinFunction("recover", []SymbolType{}, UNKNOWN_TYPE)
+	// recover, panic'e verilen değeri interface{} olarak döndürür
+	a.globalScope.Symbols["recover"].DataType = &FunctionType{
+		ParameterTypes: []Type{},
+		ReturnType:     &InterfaceType{Methods: make(map[string]*FunctionType)},
+	}
 	a.addBuiltinFunction("len", []SymbolType{UNKNOWN_TYPE}, INTEGER_TYPE)
 	a.addBuiltinFunction("cap", []SymbolType{UNKNOWN_TYPE}, INTEGER_TYPE)
 	a.addBuiltinFunction("make", []SymbolType{UNKNOWN_TYPE}, UNKNOWN_TYPE)
 	a.addBuiltinFunction("new", []SymbolType{UNKNOWN_TYPE}, UNKNOWN_TYPE)
+	a.addBuiltinFunction("close", []SymbolType{UNKNOWN_TYPE}, VOID_TYPE)
+	a.addBuiltinFunction("delete", []SymbolType{UNKNOWN_TYPE, UNKNOWN_TYPE}, VOID_TYPE)
+	a.addVariadicBuiltinFunction("append", []SymbolType{UNKNOWN_TYPE}, UNKNOWN_TYPE)
+	a.addBuiltinFunction("copy", []SymbolType{UNKNOWN_TYPE, UNKNOWN_TYPE}, INTEGER_TYPE)
+	a.addBuiltinFunction("alloc", []SymbolType{UNKNOWN_TYPE, INTEGER_TYPE}, UNKNOWN_TYPE)
+	a.addBuiltinFunction("free", []SymbolType{UNKNOWN_TYPE}, VOID_TYPE)
+
+	// Built-in types
+	a.addBuiltinType("error", &InterfaceType{Name: "error", Methods: map[string]*FunctionType{
+		"Error": {ReturnType: &BasicType{Name: "string", Kind: STRING_TYPE}},
+	}})
 
 	// Standard library packages
 	a.addStandardPackage("fmt")
@@ -67,6 +89,7 @@ func (a *Analyzer) initializeBuiltins() {
 	a.addStandardPackage("io")
 	a.addStandardPackage("strings")
 	a.addStandardPackage("math")
+	a.addStandardPackage("runtime")
 }
 
 // addBuiltinFunction, bir built-in function'ı global scope'a ekler.
@@ -86,6 +109,20 @@ func (a *Analyzer) addBuiltinFunction(name string, paramTypes []SymbolType, retu
 	}
 }
 
+// addVariadicBuiltinFunction, değişken sayıda argüman alan bir built-in
+// function'ı global scope'a ekler.
+func (a *Analyzer) addVariadicBuiltinFunction(name string, paramTypes []SymbolType, returnType SymbolType) {
+	a.addBuiltinFunction(name, paramTypes, returnType)
+	a.globalScope.Symbols[name].Signature.IsVariadic = true
+}
+
+// addBuiltinType, önceden tanımlanmış bir tipi global scope'a ekler.
+func (a *Analyzer) addBuiltinType(name string, dataType Type) {
+	symbol := a.globalScope.Define(name, typeSymbolKind(dataType), token.Token{})
+	symbol.IsType = true
+	symbol.DataType = dataType
+}
+
 // addStandardPackage, bir standard library package'ını global scope'a ekler.
 func (a *Analyzer) addStandardPackage(name string) {
 	symbol := a.globalScope.Define(name, PACKAGE_TYPE, token.Token{})
@@ -105,6 +142,10 @@ func (a *Analyzer) addStandardPackage(name string) {
 		a.addPackageFunction(symbol, "Max", []SymbolType{FLOAT_TYPE, FLOAT_TYPE}, FLOAT_TYPE)
 		a.addPackageFunction(symbol, "Min", []SymbolType{FLOAT_TYPE, FLOAT_TYPE}, FLOAT_TYPE)
 		a.addPackageFunction(symbol, "Abs", []SymbolType{FLOAT_TYPE}, FLOAT_TYPE)
+	case "runtime":
+		a.addPackageFunction(symbol, "Gosched", []SymbolType{}, VOID_TYPE)
+		a.addPackageFunction(symbol, "NumGoroutine", []SymbolType{}, INTEGER_TYPE)
+		a.addPackageFunction(symbol, "NumCPU", []SymbolType{}, INTEGER_TYPE)
 	}
 }
 
@@ -188,6 +229,9 @@ func (a *Analyzer) Analyze(program *ast.Program) {
 	for _, stmt := range program.Statements {
 		a.analyzeStatement(stmt)
 	}
+
+	// Dallanma analizi: break, continue, goto ve etiketleri denetle
+	a.checkBranches(program.Statements)
 }
 
 // Errors, analiz sırasında karşılaşılan hataları döndürür.
@@ -222,6 +266,13 @@ func (a *Analyzer) collectDeclarations(program *ast.Program) {
 			a.collectClassDeclaration(s)
 		}
 	}
+
+	// Tip bildirimleri sınıf adlarına başvurabildiğinden sınıflardan sonra toplanır
+	a.declareTypes(program.Statements)
+
+	// Fonksiyon ve metot imzaları tiplere başvurabildiğinden en son toplanır
+	a.collectFunctions(program.Statements)
+	a.collectMethods(program.Statements)
 }
 
 // collectFunctionDeclaration, bir fonksiyon tanımını toplar.
@@ -263,6 +314,12 @@ func (a *Analyzer) collectClassDeclaration(class *ast.ClassStatement) {
 		Methods:    make(map[string]*Symbol),
 		Implements: []*Symbol{},
 	}
+	symbol.DataType = &ClassType{
+		Name:       name,
+		Fields:     make(map[string]Type),
+		Methods:    make(map[string]*FunctionType),
+		Implements: []*InterfaceType{},
+	}
 
 	// Kalıtım
 	if class.Extends != nil {
@@ -312,12 +369,18 @@ func (a *Analyzer) analyzeStatement(stmt ast.Statement) Type {
 		return a.analyzeConstStatement(s)
 	case *ast.ReturnStatement:
 		return a.analyzeReturnStatement(s)
+	case *ast.MultiAssignStatement:
+		return a.analyzeMultiAssignStatement(s)
+	case *ast.FunctionStatement:
+		return a.analyzeFunctionStatement(s)
 	case *ast.ExpressionStatement:
 		return a.analyzeExpression(s.Expression)
 	case *ast.BlockStatement:
 		return a.analyzeBlockStatement(s)
 	case *ast.ForStatement:
 		return a.analyzeForStatement(s)
+	case *ast.RangeStatement:
+		return a.analyzeRangeStatement(s)
 	case *ast.WhileStatement:
 		return a.analyzeWhileStatement(s)
 	case *ast.ClassStatement:
@@ -330,12 +393,38 @@ func (a *Analyzer) analyzeStatement(stmt ast.Statement) Type {
 		return a.analyzeThrowStatement(s)
 	case *ast.ScopeStatement:
 		return a.analyzeScopeStatement(s)
+	case *ast.UnsafeStatement:
+		return a.analyzeUnsafeStatement(s)
+	case *ast.DeferStatement:
+		return a.analyzeDeferStatement(s)
+	case *ast.GoStatement:
+		return a.analyzeGoStatement(s)
+	case *ast.SendStatement:
+		return a.analyzeSendStatement(s)
+	case *ast.SelectStatement:
+		return a.analyzeSelectStatement(s)
 	case *ast.SwitchStatement:
 		return a.analyzeSwitchStatement(s)
+	case *ast.TypeSwitchStatement:
+		return a.analyzeTypeSwitchStatement(s)
 	case *ast.PackageStatement:
 		return a.analyzePackageStatement(s)
 	case *ast.ImportStatement:
 		return a.analyzeImportStatement(s)
+	case *ast.TypeStatement:
+		// Global tipler collectDeclarations sırasında tanımlanır
+		if a.currentScope != a.globalScope {
+			a.declareTypes([]ast.Statement{s})
+		}
+		return &BasicType{Name: "void", Kind: VOID_TYPE}
+	case *ast.LabeledStatement:
+		if s.Statement != nil {
+			return a.analyzeStatement(s.Statement)
+		}
+		return &BasicType{Name: "void", Kind: VOID_TYPE}
+	case *ast.BranchStatement:
+		// Dallanma hedefleri checkBranches tarafından denetlenir
+		return &BasicType{Name: "void", Kind: VOID_TYPE}
 	default:
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 	}
@@ -356,6 +445,8 @@ func (a *Analyzer) analyzeExpression(expr ast.Expression) Type {
 		return &BasicType{Name: "int", Kind: INTEGER_TYPE}
 	case *ast.FloatLiteral:
 		return &BasicType{Name: "float", Kind: FLOAT_TYPE}
+	case *ast.ImaginaryLiteral:
+		return a.analyzeImaginaryLiteral(e)
 	case *ast.StringLiteral:
 		return &BasicType{Name: "string", Kind: STRING_TYPE}
 	case *ast.CharLiteral:
@@ -368,6 +459,8 @@ func (a *Analyzer) analyzeExpression(expr ast.Expression) Type {
 		return a.analyzePrefixExpression(e)
 	case *ast.InfixExpression:
 		return a.analyzeInfixExpression(e)
+	case *ast.AssignExpression:
+		return a.analyzeAssignExpression(e)
 	case *ast.IfExpression:
 		return a.analyzeIfExpression(e)
 	case *ast.FunctionLiteral:
@@ -378,6 +471,8 @@ func (a *Analyzer) analyzeExpression(expr ast.Expression) Type {
 		return a.analyzeArrayLiteral(e)
 	case *ast.IndexExpression:
 		return a.analyzeIndexExpression(e)
+	case *ast.SliceExpression:
+		return a.analyzeSliceExpression(e)
 	case *ast.HashLiteral:
 		return a.analyzeHashLiteral(e)
 	case *ast.MemberExpression:
@@ -388,6 +483,12 @@ func (a *Analyzer) analyzeExpression(expr ast.Expression) Type {
 		return a.analyzeTemplateExpression(e)
 	case *ast.ArrayType:
 		return a.analyzeArrayType(e)
+	case *ast.ChanType, *ast.MapType:
+		return a.resolveType(e)
+	case *ast.CompositeLiteral:
+		return a.analyzeCompositeLiteral(e)
+	case *ast.TypeAssertExpression:
+		return a.analyzeTypeAssertion(e)
 	default:
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 	}
@@ -460,27 +561,28 @@ func (a *Analyzer) analyzeVarStatement(stmt *ast.VarStatement) Type {
 	var varType Type = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 
 	// Değişken değerini analiz et
+	var valueType Type
 	if stmt.Value != nil {
-		valueType := a.analyzeExpression(stmt.Value)
-
-		// Tip belirtilmişse, tip kontrolü yap
-		if stmt.Type != nil {
-			// declaredType := a.analyzeExpression(stmt.Type)
-			// if !declaredType.Equals(valueType) {
-			// 	a.reportError(stmt.Token, "Tip uyuşmazlığı: %s tipindeki değer %s tipindeki değişkene atanamaz", valueType.String(), declaredType.String())
-			// }
-			// varType = declaredType
-		} else {
-			// Tip belirtilmemişse, değerin tipini kullan
-			varType = valueType
+		valueType = a.analyzeExpression(stmt.Value)
+		a.checkSingleValue(stmt.Token, valueType)
+		varType = valueType
+	}
+
+	// Tip belirtilmişse, tip kontrolü yap; değer yoksa değişken sıfır değerini alır
+	if stmt.Type != nil {
+		declaredType := a.resolveType(stmt.Type)
+		if valueType != nil && !a.isAssignableType(valueType, declaredType) {
+			if iface, ok := declaredType.(*InterfaceType); ok {
+				a.reportError(stmt.Token, "%s", a.notImplemented(valueType, iface))
+			} else {
+				a.reportError(stmt.Token, "Tip uyuşmazlığı: %s tipindeki değer %s tipindeki değişkene atanamaz", valueType.String(), declaredType.String())
+			}
 		}
-	} else if stmt.Type != nil {
-		// Değer yoksa ama tip belirtilmişse, belirtilen tipi kullan
-		// varType = a.analyzeExpression(stmt.Type)
+		varType = declaredType
 	}
 
 	// Değişkeni tanımla
-	a.currentScope.Define(stmt.Name.Value, symbolTypeFromType(varType), stmt.Token)
+	a.currentScope.DefineVariable(stmt.Name.Value, varType, stmt.Token)
 
 	return varType
 }
@@ -519,10 +621,22 @@ func (a *Analyzer) analyzeConstStatement(stmt *ast.ConstStatement) Type {
 
 // Diğer analiz fonksiyonları buraya eklenecek
 func (a *Analyzer) analyzeReturnStatement(stmt *ast.ReturnStatement) Type {
-	if stmt.ReturnValue != nil {
-		return a.analyzeExpression(stmt.ReturnValue)
+	values := make([]Type, len(stmt.ReturnValues))
+	for i, value := range stmt.ReturnValues {
+		values[i] = a.analyzeExpression(value)
 	}
-	return &BasicType{Name: "void", Kind: VOID_TYPE}
+
+	if a.function != nil && a.function.checked {
+		a.checkReturnValues(stmt.Token, values)
+	}
+
+	switch len(values) {
+	case 0:
+		return &BasicType{Name: "void", Kind: VOID_TYPE}
+	case 1:
+		return values[0]
+	}
+	return &TupleType{Types: values}
 }
 
 func (a *Analyzer) analyzeBlockStatement(stmt *ast.BlockStatement) Type {
@@ -621,28 +735,27 @@ func (a *Analyzer) analyzeClassStatement(stmt *ast.ClassStatement) Type {
 }
 
 func (a *Analyzer) analyzeMethodStatement(stmt *ast.MethodStatement) Type {
-	// Metot tipini oluştur
-	funcType := &FunctionType{
-		ParameterTypes: make([]Type, len(stmt.Parameters)),
-		ReturnType:     &BasicType{Name: "void", Kind: VOID_TYPE},
+	// Alıcı tipi ve metot imzası collectMethods sırasında çözümlenmiştir;
+	// hatalar tekrar raporlanmasın diye burada yalnızca aranır
+	var recvType Type = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	if symbol := a.currentScope.Resolve(stmt.Receiver.Value); symbol != nil {
+		switch {
+		case symbol.IsType:
+			recvType = symbol.DataType
+		case symbol.Type == CLASS_TYPE:
+			recvType = classTypeOf(symbol)
+		}
 	}
 
-	// Parametre tiplerini belirle
-	for i := range stmt.Parameters {
-		// Parametre tipi için bir alan eklenebilir
-		// funcType.ParameterTypes[i] = a.analyzeExpression(stmt.Parameters[i].Type)
-		funcType.ParameterTypes[i] = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	funcType, ok := a.methodSet(recvType)[stmt.Name.Value]
+	if !ok {
+		funcType = a.methodSignature(stmt.Parameters, stmt.ReturnType)
 	}
-
-	// Dönüş tipini belirle
-	if stmt.ReturnType != nil {
-		// funcType.ReturnType = a.analyzeExpression(stmt.ReturnType)
+	if stmt.Pointer && !isUnknownType(recvType) {
+		recvType = &PointerType{ElementType: recvType}
 	}
 
-	// Metot gövdesini analiz et
-	if stmt.Body != nil {
-		a.analyzeBlockStatement(stmt.Body)
-	}
+	a.analyzeFunctionBody(stmt.ReceiverName, recvType, stmt.Parameters, stmt.ReturnType, funcType, stmt.Body)
 
 	return funcType
 }
@@ -661,14 +774,15 @@ func (a *Analyzer) analyzeTryCatchStatement(stmt *ast.TryCatchStatement) Type {
 			prevScope := a.currentScope
 			a.currentScope = catchScope
 
-			// Parametre tipini belirle
-			var paramType Type = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+			// Parametre tipini belirle; tipsiz catch her panic değerini
+			// boş arayüz olarak alır
+			var paramType Type = &InterfaceType{Methods: make(map[string]*FunctionType)}
 			if catch.Type != nil {
-				// paramType = a.analyzeExpression(catch.Type)
+				paramType = a.resolveType(catch.Type)
 			}
 
 			// Parametreyi tanımla
-			a.currentScope.Define(catch.Parameter.Value, symbolTypeFromType(paramType), catch.Parameter.Token)
+			a.currentScope.DefineVariable(catch.Parameter.Value, paramType, catch.Parameter.Token)
 
 			// Catch bloğunu analiz et
 			if catch.Body != nil {
@@ -702,6 +816,32 @@ func (a *Analyzer) analyzeThrowStatement(stmt *ast.ThrowStatement) Type {
 	return &BasicType{Name: "void", Kind: VOID_TYPE}
 }
 
+// analyzeDeferStatement, bir defer deyimini analiz eder. Ertelenen çağrı
+// sıradan bir çağrı gibi denetlenir.
+func (a *Analyzer) analyzeDeferStatement(stmt *ast.DeferStatement) Type {
+	if a.function == nil {
+		a.reportError(stmt.Token, "defer yalnızca fonksiyon içinde kullanılabilir")
+	}
+	if stmt.Call != nil {
+		a.analyzeExpression(stmt.Call)
+	}
+
+	return &BasicType{Name: "void", Kind: VOID_TYPE}
+}
+
+// analyzeGoStatement, bir go deyimini analiz eder. Başlatılan çağrı sıradan
+// bir çağrı gibi denetlenir; sonuçları kullanılmaz.
+func (a *Analyzer) analyzeGoStatement(stmt *ast.GoStatement) Type {
+	if a.function == nil {
+		a.reportError(stmt.Token, "go yalnızca fonksiyon içinde kullanılabilir")
+	}
+	if stmt.Call != nil {
+		a.analyzeExpression(stmt.Call)
+	}
+
+	return &BasicType{Name: "void", Kind: VOID_TYPE}
+}
+
 func (a *Analyzer) analyzeScopeStatement(stmt *ast.ScopeStatement) Type {
 	// Scope bloğunu analiz et
 	if stmt.Body != nil {
@@ -736,6 +876,8 @@ func (a *Analyzer) analyzeImportStatement(stmt *ast.ImportStatement) Type {
 		// strings package'ı zaten initializeBuiltins'de eklendi
 	case "math":
 		// math package'ı zaten initializeBuiltins'de eklendi
+	case "runtime":
+		// runtime package'ı zaten initializeBuiltins'de eklendi
 	default:
 		// Bilinmeyen package için warning verebiliriz
 		// a.reportError(stmt.Token, "Bilinmeyen package: %s", importPath)
@@ -752,6 +894,11 @@ func (a *Analyzer) analyzeIdentifier(expr *ast.Identifier) Type {
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 	}
 
+	// Bileşik tipli değişkenler tam tiplerini taşır
+	if symbol.DataType != nil {
+		return symbol.DataType
+	}
+
 	// Sembol tipini döndür
 	switch symbol.Type {
 	case INTEGER_TYPE:
@@ -813,13 +960,7 @@ func (a *Analyzer) analyzeIdentifier(expr *ast.Identifier) Type {
 
 		return funcType
 	case CLASS_TYPE:
-		// Sınıf tipini oluştur
-		return &ClassType{
-			Name:       symbol.Name,
-			Fields:     make(map[string]Type),
-			Methods:    make(map[string]*FunctionType),
-			Implements: []*InterfaceType{},
-		}
+		return classTypeOf(symbol)
 	default:
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 	}
@@ -843,6 +984,15 @@ func (a *Analyzer) analyzePrefixExpression(expr *ast.PrefixExpression) Type {
 			a.reportError(expr.Token, "- operatörü sayısal tipte olmalıdır")
 		}
 		return rightType
+	case "^", "~":
+		// Bit düzeyinde tümleyen tamsayı tipinde olmalıdır
+		return a.checkComplementOperand(expr.Token, expr.Operator, rightType)
+	case "<-":
+		return a.analyzeReceiveExpression(expr, rightType)
+	case "&":
+		return a.analyzeAddressOf(expr, rightType)
+	case "*":
+		return a.analyzeDereference(expr, rightType)
 	default:
 		a.reportError(expr.Token, "Bilinmeyen önek operatörü: %s", expr.Operator)
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
@@ -854,6 +1004,10 @@ func (a *Analyzer) analyzeInfixExpression(expr *ast.InfixExpression) Type {
 	leftType := a.analyzeExpression(expr.Left)
 	rightType := a.analyzeExpression(expr.Right)
 
+	if result, ok := a.checkPointerArithmetic(expr.Token, expr.Operator, leftType, rightType); ok {
+		return result
+	}
+
 	// Operatöre göre tip kontrolü yap
 	switch expr.Operator {
 	case "+", "-", "*", "/", "%":
@@ -877,8 +1031,10 @@ func (a *Analyzer) analyzeInfixExpression(expr *ast.InfixExpression) Type {
 		return &BasicType{Name: "int", Kind: INTEGER_TYPE}
 	case "==", "!=", "<", ">", "<=", ">=":
 		// Karşılaştırma operatörleri aynı tipte olmalıdır
-		if !leftType.Equals(rightType) {
+		if !leftType.Equals(rightType) && !isNullComparison(leftType, rightType) {
 			a.reportError(expr.Token, "Karşılaştırma operatörünün sol ve sağ tarafı aynı tipte olmalıdır")
+		} else {
+			a.checkStructComparison(expr.Token, expr.Operator, leftType)
 		}
 
 		return &BasicType{Name: "bool", Kind: BOOLEAN_TYPE}
@@ -893,19 +1049,18 @@ func (a *Analyzer) analyzeInfixExpression(expr *ast.InfixExpression) Type {
 		}
 
 		return &BasicType{Name: "bool", Kind: BOOLEAN_TYPE}
-	case "=":
-		// Atama operatörü aynı tipte olmalıdır
-		if !leftType.Equals(rightType) {
-			a.reportError(expr.Token, "Atama operatörünün sol ve sağ tarafı aynı tipte olmalıdır")
-		}
-
-		return leftType
+	case "&", "|", "^", "&^":
+		// Bit düzeyinde operatörler tamsayı tipinde olmalıdır
+		return a.checkBitwiseOperands(expr.Token, expr.Operator, leftType, rightType)
+	case "<<", ">>":
+		// Kaydırma operatörleri
+		return a.checkShiftOperands(expr.Token, expr.Operator, leftType, rightType, expr.Right)
 	case ":=":
 		// Kısa değişken tanımlama operatörü
 		// Sol taraf bir tanımlayıcı olmalıdır
 		if ident, ok := expr.Left.(*ast.Identifier); ok {
 			// Tanımlayıcıyı tanımla
-			a.currentScope.Define(ident.Value, symbolTypeFromType(rightType), ident.Token)
+			a.currentScope.DefineVariable(ident.Value, rightType, ident.Token)
 		} else {
 			a.reportError(expr.Token, "Kısa değişken tanımlama operatörünün sol tarafı bir tanımlayıcı olmalıdır")
 		}
@@ -917,6 +1072,25 @@ func (a *Analyzer) analyzeInfixExpression(expr *ast.InfixExpression) Type {
 	}
 }
 
+func (a *Analyzer) analyzeAssignExpression(expr *ast.AssignExpression) Type {
+	// Sol ve sağ tarafı analiz et
+	leftType := a.analyzeExpression(expr.Left)
+	valueType := a.analyzeExpression(expr.Value)
+
+	a.checkAssignable(expr.Token, expr.Left)
+	if operator := expr.BinaryOperator(); operator != "" {
+		// Bileşik atama, x = x op y gibi denetlenir
+		valueType = a.inferencer.inferBinaryOperationType(expr.Token, operator, leftType, valueType, expr.Value)
+	}
+
+	// Atama operatörü aynı tipte olmalıdır
+	if !leftType.Equals(valueType) {
+		a.reportError(expr.Token, "Atama operatörünün sol ve sağ tarafı aynı tipte olmalıdır")
+	}
+
+	return leftType
+}
+
 func (a *Analyzer) analyzeIfExpression(expr *ast.IfExpression) Type {
 	// Koşulu analiz et
 	condType := a.analyzeExpression(expr.Condition)
@@ -950,61 +1124,37 @@ func (a *Analyzer) analyzeIfExpression(expr *ast.IfExpression) Type {
 }
 
 func (a *Analyzer) analyzeFunctionLiteral(expr *ast.FunctionLiteral) Type {
-	// Fonksiyon tipini oluştur
-	funcType := &FunctionType{
-		ParameterTypes: make([]Type, len(expr.Parameters)),
-		ReturnType:     &BasicType{Name: "void", Kind: VOID_TYPE},
-	}
-
-	// Fonksiyon kapsamı oluştur
-	funcScope := NewScope(a.currentScope)
-	prevScope := a.currentScope
-	a.currentScope = funcScope
-
-	// Parametre tiplerini belirle
-	for i, param := range expr.Parameters {
-		// Parametre tipi için bir alan eklenebilir
-		// funcType.ParameterTypes[i] = a.analyzeExpression(param.Type)
-		funcType.ParameterTypes[i] = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
-
-		// Parametreyi tanımla
-		a.currentScope.Define(param.Value, symbolTypeFromType(funcType.ParameterTypes[i]), param.Token)
-	}
-
-	// Dönüş tipini belirle
-	if expr.ReturnType != nil {
-		// funcType.ReturnType = a.analyzeExpression(expr.ReturnType)
-	}
-
-	// Fonksiyon gövdesini analiz et
-	if expr.Body != nil {
-		a.analyzeBlockStatement(expr.Body)
-	}
-
-	// Önceki kapsama geri dön
-	a.currentScope = prevScope
-
+	funcType := a.methodSignature(expr.Parameters, expr.ReturnType)
+	a.analyzeFunctionBody(nil, nil, expr.Parameters, expr.ReturnType, funcType, expr.Body)
 	return funcType
 }
 
 func (a *Analyzer) analyzeCallExpression(expr *ast.CallExpression) Type {
+	// İlk argümanı tip, kanal, map, slice veya işaretçi olan yerleşik fonksiyonlar
+	switch {
+	case a.isBuiltin(expr.Function, "make"):
+		return a.analyzeMakeCall(expr)
+	case a.isBuiltin(expr.Function, "close"):
+		return a.analyzeCloseCall(expr)
+	case a.isBuiltin(expr.Function, "delete"):
+		return a.analyzeDeleteCall(expr)
+	case a.isBuiltin(expr.Function, "append"):
+		return a.analyzeAppendCall(expr)
+	case a.isBuiltin(expr.Function, "copy"):
+		return a.analyzeCopyCall(expr)
+	case a.isBuiltin(expr.Function, "alloc"):
+		return a.analyzeAllocCall(expr)
+	case a.isBuiltin(expr.Function, "free"):
+		return a.analyzeFreeCall(expr, "free")
+	}
+
 	// Fonksiyonu analiz et
 	funcType := a.analyzeExpression(expr.Function)
 
 	// Fonksiyon tipini kontrol et
 	if ft, ok := funcType.(*FunctionType); ok {
-		// Argüman sayısını kontrol et
-		if len(expr.Arguments) != len(ft.ParameterTypes) {
-			a.reportError(expr.Token, "Fonksiyon çağrısında yanlış sayıda argüman: %d bekleniyor, %d alındı", len(ft.ParameterTypes), len(expr.Arguments))
-		}
-
-		// Argüman tiplerini kontrol et
-		for i, arg := range expr.Arguments {
-			argType := a.analyzeExpression(arg)
-			if i < len(ft.ParameterTypes) && !argType.Equals(ft.ParameterTypes[i]) {
-				a.reportError(expr.Token, "Fonksiyon çağrısında yanlış argüman tipi: %s bekleniyor, %s alındı", ft.ParameterTypes[i].String(), argType.String())
-			}
-		}
+		// Argümanları parametrelerle karşılaştır
+		a.checkCallArguments(expr, ft, false)
 
 		// Dönüş tipini döndür
 		return ft.ReturnType
@@ -1041,6 +1191,11 @@ func (a *Analyzer) analyzeIndexExpression(expr *ast.IndexExpression) Type {
 	// Sol tarafı analiz et
 	leftType := a.analyzeExpression(expr.Left)
 
+	// Map'ler anahtar tipleriyle indekslenir
+	if mapType, ok := underlyingType(leftType).(*MapType); ok {
+		return a.analyzeMapIndex(expr, mapType)
+	}
+
 	// İndeksi analiz et
 	indexType := a.analyzeExpression(expr.Index)
 
@@ -1049,13 +1204,14 @@ func (a *Analyzer) analyzeIndexExpression(expr *ast.IndexExpression) Type {
 		a.reportError(expr.Token, "İndeks ifadesi tamsayı tipinde olmalıdır")
 	}
 
-	// Sol taraf tipini kontrol et
-	if arrayType, ok := leftType.(*ArrayType); ok {
+	// Sol taraf tipini kontrol et; adlandırılmış tipler dayanak tipleri gibi indekslenir
+	if arrayType, ok := underlyingType(leftType).(*ArrayType); ok {
 		// Dizi elemanı tipini döndür
 		return arrayType.ElementType
-	} else if mapType, ok := leftType.(*MapType); ok {
-		// Map değeri tipini döndür
-		return mapType.ValueType
+	} else if isStringType(leftType) {
+		// String'ler bayt dizisi olarak indekslenir
+		a.stringIndexes[expr] = true
+		return &BasicType{Name: "byte", Kind: INTEGER_TYPE}
 	} else {
 		a.reportError(expr.Token, "İndekslenebilir olmayan ifade")
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
@@ -1127,8 +1283,12 @@ func (a *Analyzer) analyzeMemberExpression(expr *ast.MemberExpression) Type {
 		}
 	}
 
-	// Nesneyi analiz et
+	// Nesneyi analiz et; işaretçiler üzerinden alan ve metotlara doğrudan
+	// erişilir (p.x)
 	objectType := a.analyzeExpression(expr.Object)
+	if pt, ok := objectType.(*PointerType); ok {
+		objectType = pt.ElementType
+	}
 
 	// Nesne tipini kontrol et
 	if classType, ok := objectType.(*ClassType); ok {
@@ -1141,6 +1301,23 @@ func (a *Analyzer) analyzeMemberExpression(expr *ast.MemberExpression) Type {
 			a.reportError(expr.Token, "Sınıfta tanımlanmamış üye: %s", memberName)
 			return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
 		}
+	} else if structType, ok := objectType.(*StructType); ok {
+		return a.structMemberType(memberIdent.Token, structType, memberName)
+	} else if interfaceType, ok := objectType.(*InterfaceType); ok {
+		if methodType, ok := interfaceType.MethodSet()[memberName]; ok {
+			return methodType
+		}
+		a.reportError(memberIdent.Token, "%s tipinde '%s' adında bir metot yok", interfaceType.String(), memberName)
+		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	} else if namedType, ok := objectType.(*NamedType); ok {
+		if methodType, ok := namedType.Methods[memberName]; ok {
+			return methodType
+		}
+		a.reportError(memberIdent.Token, "%s tipinde '%s' adında bir metot yok", namedType.Name, memberName)
+		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	} else if isUnknownType(objectType) {
+		// Nesnenin tipi bilinmiyorsa hata zaten raporlanmıştır
+		return objectType
 	} else {
 		a.reportError(expr.Token, "Üye erişimi için sınıf tipinde nesne veya package bekleniyor")
 		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
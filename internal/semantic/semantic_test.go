@@ -1,12 +1,14 @@
 package semantic
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/inkbytefo/go-minus/internal/ast"
 	"github.com/inkbytefo/go-minus/internal/lexer"
 	"github.com/inkbytefo/go-minus/internal/parser"
 	"github.com/inkbytefo/go-minus/internal/testutil"
+	"github.com/inkbytefo/go-minus/internal/token"
 )
 
 // parseProgram parses a GO-Minus program and returns the AST and any errors.
@@ -78,6 +80,1187 @@ func TestVariableUsage(t *testing.T) {
 			WantErr:  true,
 			ErrorMsg: "Tanımlanmamış tanımlayıcı",
 		},
+		{
+			Name:    "Use variable declared in if block",
+			Input:   "func f() { if true { var x = 1; x += 1; } else { y := 2; y += 1; } }",
+			WantErr: false,
+		},
+		{
+			Name:     "If block variable is not visible after the block",
+			Input:    "func f() { if true { var x = 1; x += 1; }; x += 1; }",
+			WantErr:  true,
+			ErrorMsg: "Tanımlanmamış tanımlayıcı: x",
+		},
+		{
+			Name:     "Undeclared variable in if block should fail",
+			Input:    "func f() { if true { var y = nope; y += 1; } }",
+			WantErr:  true,
+			ErrorMsg: "Tanımlanmamış tanımlayıcı: nope",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestFunctionDeclaration(t *testing.T) {
+	tests := []testutil.SemanticTestCase{
+		{
+			Name:    "Simple function declaration",
+			Input:   "func add(x, y) { return x + y; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Function without parameters",
+			Input:   "func hello() { }",
+			WantErr: false,
+		},
+		{
+			Name:     "Function redeclaration should fail",
+			Input:    "func test() { } func test() { }",
+			WantErr:  true,
+			ErrorMsg: "Fonksiyon zaten tanımlı: test",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			if len(parseErrors) > 0 {
+				t.Skip("Parser errors, skipping semantic test")
+				return
+			}
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestReturnStatements(t *testing.T) {
+	tests := []testutil.SemanticTestCase{
+		{
+			Name:    "Return in function",
+			Input:   "func test() { return 5; }",
+			WantErr: false,
+		},
+		// TODO: Return outside function check not implemented yet
+		// {
+		//     Name:     "Return outside function should fail",
+		//     Input:    "return 5;",
+		//     WantErr:  true,
+		//     ErrorMsg: "return outside function",
+		// },
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			if len(parseErrors) > 0 {
+				t.Skip("Parser errors, skipping semantic test")
+				return
+			}
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestExpressions(t *testing.T) {
+	tests := []testutil.SemanticTestCase{
+		{
+			Name:    "Arithmetic expression",
+			Input:   "var result = 5 + 3;",
+			WantErr: false,
+		},
+		{
+			Name:    "Boolean expression",
+			Input:   "var result = true && false;",
+			WantErr: false,
+		},
+		{
+			Name:    "Comparison expression",
+			Input:   "var result = 5 > 3;",
+			WantErr: false,
+		},
+		{
+			Name:    "Hex and binary literals",
+			Input:   "var mask = 0xFF + 0b1010 + 0o17;",
+			WantErr: false,
+		},
+		{
+			Name:     "Integer constant overflow should fail",
+			Input:    "var big = 99999999999999999999;",
+			WantErr:  true,
+			ErrorMsg: "int tipine sığmıyor",
+		},
+		{
+			Name:     "Imaginary literal should fail",
+			Input:    "var z = 1.5i;",
+			WantErr:  true,
+			ErrorMsg: "Sanal sayı literalleri desteklenmiyor: 1.5i",
+		},
+		{
+			Name:     "Imaginary literal in an expression should fail",
+			Input:    "func main() { x := 2 * 2i; }",
+			WantErr:  true,
+			ErrorMsg: "Sanal sayı literalleri desteklenmiyor: 2i",
+		},
+		{
+			Name:    "Bitwise and shift expression",
+			Input:   "var flags = 6; var mask = (flags & 0xF) | (1 << 4) ^ ^flags &^ 2 >> 1;",
+			WantErr: false,
+		},
+		{
+			Name:     "Bitwise operator on float should fail",
+			Input:    "var x = 1.5 & 3;",
+			WantErr:  true,
+			ErrorMsg: "& operatörünün sol tarafı tamsayı tipinde olmalıdır",
+		},
+		{
+			Name:     "Float shift count should fail",
+			Input:    "var x = 1 << 2.0;",
+			WantErr:  true,
+			ErrorMsg: "Kaydırma sayısı tamsayı tipinde olmalıdır",
+		},
+		{
+			Name:     "Negative shift count should fail",
+			Input:    "var x = 1 << -1;",
+			WantErr:  true,
+			ErrorMsg: "Kaydırma sayısı negatif olamaz",
+		},
+		{
+			Name:     "Complement of bool should fail",
+			Input:    "var x = ^true;",
+			WantErr:  true,
+			ErrorMsg: "^ operatörü tamsayı tipinde olmalıdır",
+		},
+		{
+			Name:    "Compound assignment",
+			Input:   "var sum = 0; var flags = 6; sum += 3; sum *= 2; flags &^= 2; flags <<= 1;",
+			WantErr: false,
+		},
+		{
+			Name:     "Compound assignment with mismatched type should fail",
+			Input:    "var sum = 0; sum += 1.5;",
+			WantErr:  true,
+			ErrorMsg: "Atama operatörünün sağ tarafı sol tarafla aynı tipte olmalıdır",
+		},
+		{
+			Name:     "Compound bitwise assignment on float should fail",
+			Input:    "var f = 1.5; f |= 1;",
+			WantErr:  true,
+			ErrorMsg: "| operatörünün sol tarafı tamsayı tipinde olmalıdır",
+		},
+		{
+			Name:     "Assignment to constant should fail",
+			Input:    "const limit = 10; limit += 1;",
+			WantErr:  true,
+			ErrorMsg: "Sabite atama yapılamaz: limit",
+		},
+		{
+			Name:     "Assignment to non-variable should fail",
+			Input:    "5 += 1;",
+			WantErr:  true,
+			ErrorMsg: "Atama operatörünün sol tarafı bir değişken olmalıdır",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestBranchStatements(t *testing.T) {
+	tests := []testutil.SemanticTestCase{
+		{
+			Name:    "Break and continue in loop",
+			Input:   "var i = 0; while i < 10 { i += 1; if i == 2 { continue; } if i == 5 { break; } }",
+			WantErr: false,
+		},
+		{
+			Name:    "Break in switch",
+			Input:   "var x = 1; switch x { case 1: break; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Labeled break from switch inside loop",
+			Input:   "var i = 0; dis: while i < 10 { switch i { case 3: break dis; } i += 1; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Labeled continue of outer loop",
+			Input:   "var i = 0; dis: while i < 10 { i += 1; while true { continue dis; } }",
+			WantErr: false,
+		},
+		{
+			Name:    "Goto forward and backward",
+			Input:   "var i = 0; basla: i += 1; if i < 10 { goto basla; } goto son; i = 0; son: i += 1;",
+			WantErr: false,
+		},
+		{
+			Name:    "Goto over a declaration in an inner block",
+			Input:   "func f() { goto son; if true { var x = 1; }; son: return; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Goto backward over a declaration",
+			Input:   "func f() { var i = 0; basla: x := i; i += x; if i < 10 { goto basla; } }",
+			WantErr: false,
+		},
+		{
+			Name:    "Branches inside function body",
+			Input:   "func f() { dis: while true { break dis; } }",
+			WantErr: false,
+		},
+		{
+			Name:     "Break outside loop should fail",
+			Input:    "var x = 1; break;",
+			WantErr:  true,
+			ErrorMsg: "break ifadesi döngü veya switch dışında kullanılamaz",
+		},
+		{
+			Name:     "Continue in switch outside loop should fail",
+			Input:    "var x = 1; switch x { case 1: continue; }",
+			WantErr:  true,
+			ErrorMsg: "continue ifadesi döngü dışında kullanılamaz",
+		},
+		{
+			Name:     "Continue to switch label should fail",
+			Input:    "var x = 1; while true { sec: switch x { case 1: continue sec; } }",
+			WantErr:  true,
+			ErrorMsg: "Geçersiz continue etiketi: sec",
+		},
+		{
+			Name:     "Break to non-enclosing label should fail",
+			Input:    "var x = 1; dis: while true { break; } while true { break dis; }",
+			WantErr:  true,
+			ErrorMsg: "Geçersiz break etiketi: dis",
+		},
+		{
+			Name:     "Goto undefined label should fail",
+			Input:    "goto yok;",
+			WantErr:  true,
+			ErrorMsg: "Tanımlanmamış etiket: yok",
+		},
+		{
+			Name:     "Goto over a short variable declaration should fail",
+			Input:    "func f() { goto L; x := 1; L: x += 1; }",
+			WantErr:  true,
+			ErrorMsg: "goto L, x değişkeninin tanımının üzerinden atlıyor",
+		},
+		{
+			Name:     "Goto from a nested block over a var declaration should fail",
+			Input:    "func f() { var i = 0; if i == 0 { goto son; }; var y int = 2; son: i += y; }",
+			WantErr:  true,
+			ErrorMsg: "goto son, y değişkeninin tanımının üzerinden atlıyor",
+		},
+		{
+			Name:     "Unused label should fail",
+			Input:    "var i = 0; dis: while i < 10 { i += 1; }",
+			WantErr:  true,
+			ErrorMsg: "Etiket tanımlandı ancak kullanılmadı: dis",
+		},
+		{
+			Name:     "Duplicate label should fail",
+			Input:    "var i = 0; son: i += 1; son: i += 2; goto son;",
+			WantErr:  true,
+			ErrorMsg: "Etiket zaten tanımlı: son",
+		},
+		{
+			Name:     "Break in function body outside loop should fail",
+			Input:    "func f() { break; }",
+			WantErr:  true,
+			ErrorMsg: "break ifadesi döngü veya switch dışında kullanılamaz",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestRangeStatements(t *testing.T) {
+	tests := []testutil.SemanticTestCase{
+		{
+			Name:    "Range over array with index and value",
+			Input:   "var xs = [1, 2, 3]; var sum = 0; for i, v := range xs { sum = sum + i * v; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Range over integer",
+			Input:   "var sum = 0; for i := range 10 { sum = sum + i; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Range over string yields runes",
+			Input:   "var r2 = 'a'; for _, r := range \"çay\" { r2 = r; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Range over map",
+			Input:   "var m = {\"a\": 1}; var total = 0; for k, v := range m { total = total + v; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Range assigning to existing variables",
+			Input:   "var xs = [1, 2, 3]; var i = 0; var v = 0; for i, v = range xs { }",
+			WantErr: false,
+		},
+		{
+			Name:    "Range variables are scoped to the loop",
+			Input:   "var xs = [1, 2]; for i := range xs { } var j = i;",
+			WantErr: true,
+		},
+		{
+			Name:     "Two variables over integer should fail",
+			Input:    "for i, v := range 10 { }",
+			WantErr:  true,
+			ErrorMsg: "Tamsayı üzerinde range en fazla bir değişken alabilir",
+		},
+		{
+			Name:     "Range over bool should fail",
+			Input:    "for x := range true { }",
+			WantErr:  true,
+			ErrorMsg: "range ifadesi dizi, slice, string, map, kanal veya tamsayı olmalıdır",
+		},
+		{
+			Name:     "Assigning range value to mismatched type should fail",
+			Input:    "var s = \"\"; for _, s = range [1, 2] { }",
+			WantErr:  true,
+			ErrorMsg: "range değeri int tipinde",
+		},
+		{
+			Name:     "Assigning range index to constant should fail",
+			Input:    "const c = 0; for c = range 3 { }",
+			WantErr:  true,
+			ErrorMsg: "Sabite atama yapılamaz: c",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestStructs(t *testing.T) {
+	tests := []testutil.SemanticTestCase{
+		{
+			Name:    "Struct declaration and keyed literal",
+			Input:   "type Point struct { x, y int }; var p = Point{x: 1, y: 2}; var sum = p.x + p.y;",
+			WantErr: false,
+		},
+		{
+			Name:    "Struct shorthand with tags and positional literal",
+			Input:   "struct User { Name string `json:\"name\"`; Age int }; var u = User{\"ali\", 30}; var n = u.Name;",
+			WantErr: false,
+		},
+		{
+			Name:    "Embedded field promotion",
+			Input:   "type Base struct { id int }; type Derived struct { Base; name string }; var d = Derived{name: \"x\"}; var id = d.id; var b = d.Base;",
+			WantErr: false,
+		},
+		{
+			Name:    "Embedded pointer field promotion",
+			Input:   "type Base struct { id int }; func (b *Base) ID() int { return b.id; }; type Derived struct { *Base; name string }; var d = Derived{Base: &Base{1}}; var id = d.id + d.ID(); var b *Base = d.Base;",
+			WantErr: false,
+		},
+		{
+			Name:    "Struct equality",
+			Input:   "type Point struct { x, y int }; type Line struct { name string; a, b Point }; var l = Line{}; var eq = l.a == l.b; var ne = l != Line{};",
+			WantErr: false,
+		},
+		{
+			Name:    "Forward reference and declared variable type",
+			Input:   "type Line struct { a, b Point }; type Point struct { x, y int }; var l Line; var x = l.b.x;",
+			WantErr: false,
+		},
+		{
+			Name:    "Struct values are assignable to the same type",
+			Input:   "type P struct { x int }; var a = P{1}; var b P = a; b.x = 2;",
+			WantErr: false,
+		},
+		{
+			Name:     "Unknown field should fail",
+			Input:    "type Point struct { x, y int }; var p = Point{}; var z = p.z;",
+			WantErr:  true,
+			ErrorMsg: "Point tipinde 'z' adında bir alan yok",
+		},
+		{
+			Name:     "Unknown field in literal should fail",
+			Input:    "type Point struct { x, y int }; var p = Point{z: 1};",
+			WantErr:  true,
+			ErrorMsg: "Point tipinde 'z' adında bir alan yok",
+		},
+		{
+			Name:     "Duplicate field in literal should fail",
+			Input:    "type Point struct { x, y int }; var p = Point{x: 1, x: 2};",
+			WantErr:  true,
+			ErrorMsg: "Alan birden fazla kez verildi: x",
+		},
+		{
+			Name:     "Mixed keyed and positional literal should fail",
+			Input:    "type Point struct { x, y int }; var p = Point{x: 1, 2};",
+			WantErr:  true,
+			ErrorMsg: "alan adlı ve konumsal değerler karıştırılamaz",
+		},
+		{
+			Name:     "Too few positional values should fail",
+			Input:    "type Point struct { x, y int }; var p = Point{1};",
+			WantErr:  true,
+			ErrorMsg: "Point değişmezinde 2 değer bekleniyordu, 1 verildi",
+		},
+		{
+			Name:     "Field value type mismatch should fail",
+			Input:    "type Point struct { x, y int }; var p = Point{x: \"a\"};",
+			WantErr:  true,
+			ErrorMsg: "string tipindeki değer int tipindeki x alanına atanamaz",
+		},
+		{
+			Name:     "Duplicate field declaration should fail",
+			Input:    "type T struct { a int; a string };",
+			WantErr:  true,
+			ErrorMsg: "Alan zaten tanımlı: a",
+		},
+		{
+			Name:     "Ambiguous promoted field should fail",
+			Input:    "type A struct { v int }; type B struct { v int }; type C struct { A; B }; var c = C{}; var v = c.v;",
+			WantErr:  true,
+			ErrorMsg: "Belirsiz seçici: C.v",
+		},
+		{
+			Name:     "Recursive struct should fail",
+			Input:    "type Node struct { value int; next Node };",
+			WantErr:  true,
+			ErrorMsg: "Geçersiz özyinelemeli tip: Node",
+		},
+		{
+			Name:     "Unknown field type should fail",
+			Input:    "type T struct { a Missing };",
+			WantErr:  true,
+			ErrorMsg: "Bilinmeyen tip: Missing",
+		},
+		{
+			Name:     "Ordering structs should fail",
+			Input:    "type Point struct { x, y int }; var a = Point{}; var lt = a < a;",
+			WantErr:  true,
+			ErrorMsg: "Struct değerleri yalnızca == ve != ile karşılaştırılabilir: Point",
+		},
+		{
+			Name:     "Comparing structs with incomparable fields should fail",
+			Input:    "type Bag struct { items []int }; var a = Bag{}; var eq = a == a;",
+			WantErr:  true,
+			ErrorMsg: "Karşılaştırılamayan alanları olan struct değerleri karşılaştırılamaz: Bag",
+		},
+		{
+			Name:     "Assigning a different struct should fail",
+			Input:    "type A struct { x int }; type B struct { x int }; var b B = A{1};",
+			WantErr:  true,
+			ErrorMsg: "Tip uyuşmazlığı: A tipindeki değer B tipindeki değişkene atanamaz",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestInterfaces(t *testing.T) {
+	shape := "type Shape interface { Area() int }; type Square struct { side int }; func (s Square) Area() int { return s.side * s.side; };"
+	tests := []testutil.SemanticTestCase{
+		{
+			Name:    "Struct with method satisfies interface",
+			Input:   shape + " var sh Shape = Square{2}; var a = sh.Area();",
+			WantErr: false,
+		},
+		{
+			Name:    "Empty interface accepts any value",
+			Input:   "var x interface{} = 1; var y interface{} = \"a\"; x = y;",
+			WantErr: false,
+		},
+		{
+			Name:    "Embedded interfaces and promoted methods",
+			Input:   shape + " type Namer interface { Name() string }; type Named interface { Shape; Namer }; type Box struct { Square }; func (b Box) Name() string { return \"box\"; }; var n Named = Box{};",
+			WantErr: false,
+		},
+		{
+			Name:    "Interface with forward reference",
+			Input:   "type Outer interface { Inner }; type Inner interface { Get() int }; var o Outer = nil;",
+			WantErr: false,
+		},
+		{
+			Name:    "Type assertion and type switch",
+			Input:   shape + " var sh Shape = Square{2}; var sq = sh.(Square); var side = sq.side; switch v := sh.(type) { case Square: var s = v.side; case nil: break; default: var a = v.Area(); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Class implementing an interface",
+			Input:   "type Shape interface { Area() int }; class Circle implements Shape { func Area() int { return 3; } }",
+			WantErr: false,
+		},
+		{
+			Name:     "Missing method should fail",
+			Input:    "type Shape interface { Area() int; Perimeter() int }; type Square struct { side int }; func (s Square) Area() int { return 1; }; var sh Shape = Square{1};",
+			WantErr:  true,
+			ErrorMsg: "Square tipi Shape arayüzünü uygulamıyor: Perimeter metodu eksik",
+		},
+		{
+			Name:     "Wrong method signature should fail",
+			Input:    "type Shape interface { Area() int }; type Square struct { side int }; func (s Square) Area() string { return \"\"; }; var sh Shape = Square{1};",
+			WantErr:  true,
+			ErrorMsg: "Area metodunun imzası farklı",
+		},
+		{
+			Name:     "Class not implementing an interface should fail",
+			Input:    "type Shape interface { Area() int }; class Circle implements Shape { func Radius() int { return 3; } }",
+			WantErr:  true,
+			ErrorMsg: "Circle tipi Shape arayüzünü uygulamıyor: Area metodu eksik",
+		},
+		{
+			Name:    "Comma-ok type assertion",
+			Input:   shape + " func side(sh Shape) int { sq, ok := sh.(Square); var found bool = ok; if found { return sq.side; }; var other Shape; other, ok = sh.(Shape); return 0; }",
+			WantErr: false,
+		},
+		{
+			Name:     "Comma-ok type assertion with three targets should fail",
+			Input:    "func f(x interface{}) { a, b, c := x.(int); }",
+			WantErr:  true,
+			ErrorMsg: "Atama uyuşmazlığı: 3 hedef ancak 1 değer var",
+		},
+		{
+			Name:     "Impossible type assertion should fail",
+			Input:    "type Shape interface { Area() int }; type Point struct { x int }; var sh Shape = nil; var p = sh.(Point);",
+			WantErr:  true,
+			ErrorMsg: "İmkansız tip iddiası",
+		},
+		{
+			Name:     "Type assertion on non-interface should fail",
+			Input:    "var x = 1; var y = x.(int);",
+			WantErr:  true,
+			ErrorMsg: "Tip iddiası yalnızca arayüz değerlerinde kullanılabilir",
+		},
+		{
+			Name:     "Duplicate type switch case should fail",
+			Input:    "var x interface{} = 1; switch x.(type) { case int: break; case int: break; }",
+			WantErr:  true,
+			ErrorMsg: "Tip switch'inde tekrarlanan case: int",
+		},
+		{
+			Name:     "Type switch outside switch should fail",
+			Input:    "var x interface{} = 1; var y = x.(type);",
+			WantErr:  true,
+			ErrorMsg: "yalnızca tip switch'inde kullanılabilir",
+		},
+		{
+			Name:     "Duplicate method should fail",
+			Input:    "type T struct { a int }; func (t T) Get() int { return 1; }; func (t T) Get() int { return 2; };",
+			WantErr:  true,
+			ErrorMsg: "Metot zaten tanımlı: T.Get",
+		},
+		{
+			Name:     "Method with field name should fail",
+			Input:    "type T struct { a int }; func (t T) a() int { return 1; };",
+			WantErr:  true,
+			ErrorMsg: "Alan ve metot aynı ada sahip: T.a",
+		},
+		{
+			Name:     "Recursive interface embedding should fail",
+			Input:    "type A interface { B }; type B interface { A };",
+			WantErr:  true,
+			ErrorMsg: "Geçersiz özyinelemeli tip",
+		},
+		{
+			Name:     "Embedding a non-interface should fail",
+			Input:    "type I interface { int };",
+			WantErr:  true,
+			ErrorMsg: "Arayüze yalnızca arayüz tipleri gömülebilir: int",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestNamedTypes(t *testing.T) {
+	temps := "type Celsius float64; type Fahrenheit float64;"
+	point := "type Point struct { x, y int }; func (p Point) Sum() int { return p.x + p.y; };"
+	tests := []testutil.SemanticTestCase{
+		{
+			Name:    "Named type inherits operations of its underlying type",
+			Input:   temps + " func (c Celsius) Warm() bool { return c > 30.0; }; var c Celsius = 36.6; var f = c * 9 / 5 + 32; var w = c.Warm(); var d Celsius = f;",
+			WantErr: false,
+		},
+		{
+			Name:    "Conversions between named and underlying types",
+			Input:   temps + " var c Celsius = 20.0; var f = Fahrenheit(c * 9 / 5 + 32); var x float64 = float64(f); var n = int(x);",
+			WantErr: false,
+		},
+		{
+			Name:    "Named func type",
+			Input:   "type Handler func(int) error; var h Handler; var e = h(1); var s = e.Error();",
+			WantErr: false,
+		},
+		{
+			Name:    "Named slice type",
+			Input:   "type IntList []int; var l = IntList{1, 2, 3}; var first = l[0]; for i, v := range l { var s = v; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Struct types with identical fields convert",
+			Input:   point + " type Vec Point; var p = Point{1, 2}; var v = Vec(p); var x = v.x; var q = Point(v);",
+			WantErr: false,
+		},
+		{
+			Name:    "Alias is identical to its target",
+			Input:   temps + point + " type Temp = Celsius; type P = Point; var c Celsius = 1.0; var t Temp = c; var p P = Point{1, 2}; var s = p.Sum();",
+			WantErr: false,
+		},
+		{
+			Name:    "Alias with forward reference",
+			Input:   "type A = B; type B = int; var a A = 1; var b int = a;",
+			WantErr: false,
+		},
+		{
+			Name:    "Named type satisfies interface through its methods",
+			Input:   temps + " type Stringer interface { String() string }; func (c Celsius) String() string { return \"C\"; }; var s Stringer = Celsius(1.0);",
+			WantErr: false,
+		},
+		{
+			Name:    "error is a predeclared interface",
+			Input:   "type MyErr struct { msg string }; func (e MyErr) Error() string { return e.msg; }; var err error = MyErr{\"x\"}; var m = err.Error();",
+			WantErr: false,
+		},
+		{
+			Name:     "Distinct named types are not assignable",
+			Input:    temps + " var c Celsius = 1.0; var f Fahrenheit = c;",
+			WantErr:  true,
+			ErrorMsg: "Celsius tipindeki değer Fahrenheit tipindeki değişkene atanamaz",
+		},
+		{
+			Name:     "Mixing named types in arithmetic should fail",
+			Input:    temps + " var c Celsius = 1.0; var f Fahrenheit = 2.0; var x = c + f;",
+			WantErr:  true,
+			ErrorMsg: "Uyumsuz tipler: Celsius ve Fahrenheit",
+		},
+		{
+			Name:     "Named value is not assignable to its underlying type",
+			Input:    "type Count int; var c Count = 1; var i int = c;",
+			WantErr:  true,
+			ErrorMsg: "Count tipindeki değer int tipindeki değişkene atanamaz",
+		},
+		{
+			Name:    "Named slice is assignable to its slice literal type",
+			Input:   "type IntSlice []int; func sum(xs []int) int { return len(xs); }; var s IntSlice = IntSlice{1, 2}; var t []int = s; var n = sum(s);",
+			WantErr: false,
+		},
+		{
+			Name:    "Named func is assignable to its func literal type",
+			Input:   "type Handler func(int) int; func double(x int) int { return x * 2; }; var h Handler = double; var g func(int) int = h;",
+			WantErr: false,
+		},
+		{
+			Name:    "Named map is assignable to its map literal type",
+			Input:   "type Ages map[string]int; var a Ages = make(Ages); var m map[string]int = a; var b Ages = m;",
+			WantErr: false,
+		},
+		{
+			Name:     "Named argument for an unnamed parameter should fail",
+			Input:    "type Count int; func f(n int) int { return n; }; var c Count = 1; var r = f(c);",
+			WantErr:  true,
+			ErrorMsg: "Fonksiyon çağrısı için yanlış argüman tipi: int bekleniyor, Count alındı",
+		},
+		{
+			Name:     "Mixing a named type with another operand type should fail",
+			Input:    "type Count int; var c Count = 1; var x float64 = 1.5; var y = c + x;",
+			WantErr:  true,
+			ErrorMsg: "Uyumsuz tipler: Count ve float",
+		},
+		{
+			Name:     "Named struct type does not inherit methods",
+			Input:    point + " type Vec Point; var v = Vec{1, 2}; var s = v.Sum();",
+			WantErr:  true,
+			ErrorMsg: "Vec tipinde 'Sum' adında bir alan yok",
+		},
+		{
+			Name:     "Method of a named type is not promoted to the underlying type",
+			Input:    temps + " func (c Celsius) Warm() bool { return c > 30.0; }; var x float64 = 1.0; var w = x.Warm();",
+			WantErr:  true,
+			ErrorMsg: "Üye erişimi",
+		},
+		{
+			Name:     "Invalid conversion should fail",
+			Input:    temps + " var c = Celsius(\"hot\");",
+			WantErr:  true,
+			ErrorMsg: "string tipindeki değer Celsius tipine dönüştürülemez",
+		},
+		{
+			Name:     "Conversion takes exactly one argument",
+			Input:    temps + " var c = Celsius(1.0, 2.0);",
+			WantErr:  true,
+			ErrorMsg: "Celsius dönüşümü tek bir argüman alır, 2 verildi",
+		},
+		{
+			Name:     "Recursive named type should fail",
+			Input:    "type T T;",
+			WantErr:  true,
+			ErrorMsg: "Geçersiz özyinelemeli tip: T",
+		},
+		{
+			Name:     "Recursive named array type should fail",
+			Input:    "type T [2]T;",
+			WantErr:  true,
+			ErrorMsg: "Geçersiz özyinelemeli tip: T",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestFunctionSignatures(t *testing.T) {
+	div := "func div(a, b int) (int, error) { return a / b, nil; };"
+	tests := []testutil.SemanticTestCase{
+		{
+			Name:    "Typed parameters are visible in the body",
+			Input:   "func f(a, b int, s string) int { var t string = s; return a + b; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Multiple return values",
+			Input:   div + "func main() { q, err := div(6, 3); var n int = q; var e error = err; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Named results with bare return",
+			Input:   "func split(sum int) (x, y int) { x = sum / 2; y = sum - x; return; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Functions can be called before their declaration",
+			Input:   "func main() { var n int = twice(2); }; func twice(n int) int { return n * 2; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Forwarding a multi-value call",
+			Input:   div + "func wrap() (int, error) { return div(4, 2); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Multi-value assignment to existing variables",
+			Input:   div + "func main() { var q int; var err error; q, err = div(1, 1); a, b := 1, 2; a, b = b, a; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Blank identifier discards a result",
+			Input:   div + "func main() { _, err := div(1, 1); }",
+			WantErr: false,
+		},
+		{
+			Name:     "Wrong number of return values should fail",
+			Input:    "func f() (int, error) { return 1; }",
+			WantErr:  true,
+			ErrorMsg: "Yanlış sayıda dönüş değeri: 2 bekleniyor, 1 verildi",
+		},
+		{
+			Name:     "Wrong return value type should fail",
+			Input:    "func f() (int, string) { return 1, 2; }",
+			WantErr:  true,
+			ErrorMsg: "Dönüş değeri tipi uyuşmuyor: int tipindeki değer string olarak döndürülemez",
+		},
+		{
+			Name:     "Bare return without named results should fail",
+			Input:    "func f() int { return; }",
+			WantErr:  true,
+			ErrorMsg: "Yanlış sayıda dönüş değeri: 1 bekleniyor, 0 verildi",
+		},
+		{
+			Name:     "Assignment count mismatch should fail",
+			Input:    div + "func main() { a, b, c := div(1, 1); }",
+			WantErr:  true,
+			ErrorMsg: "Atama uyuşmazlığı: 3 hedef ancak 2 değer var",
+		},
+		{
+			Name:     "Multi-value call in single-value context should fail",
+			Input:    div + "func main() { x := div(1, 1); }",
+			WantErr:  true,
+			ErrorMsg: "Çok değerli (int, error) tek değer bağlamında kullanıldı",
+		},
+		{
+			Name:     "Short declaration without new variables should fail",
+			Input:    div + "func main() { q, err := div(1, 1); q, err := div(2, 1); }",
+			WantErr:  true,
+			ErrorMsg: "':=' operatörünün sol tarafında yeni değişken yok",
+		},
+		{
+			Name:    "Argument type mismatch should fail",
+			Input:   "func f(s string) { }; func main() { f(1); }",
+			WantErr: true,
+		},
+		{
+			Name:     "Result type mismatch in assignment should fail",
+			Input:    div + "func main() { var s string; var err error; s, err = div(1, 1); }",
+			WantErr:  true,
+			ErrorMsg: "Tip uyuşmazlığı: int tipindeki değer string tipindeki hedefe atanamaz",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestVariadicFunctions(t *testing.T) {
+	sum := "func sum(base int, xs ...int) int { var total int = base; for _, x := range xs { total += x }; return total; }; "
+
+	tests := []struct {
+		Name     string
+		Input    string
+		WantErr  bool
+		ErrorMsg string
+	}{
+		{
+			Name:    "Variadic call with extra arguments",
+			Input:   sum + "func main() { var n int = sum(1, 2, 3) + sum(0); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Spreading a slice into a variadic parameter",
+			Input:   sum + "func main() { var nums []int; var n int = sum(1, nums...); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Variadic parameter is a slice in the body",
+			Input:   "func count(xs ...string) int { var first []string = xs; return len(xs); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Variadic interface parameter accepts any value",
+			Input:   "func log(args ...interface{}) { }; func main() { log(1, \"a\", true); }",
+			WantErr: false,
+		},
+		{
+			Name:     "Missing fixed argument should fail",
+			Input:    sum + "func main() { var n int = sum(); }",
+			WantErr:  true,
+			ErrorMsg: "Fonksiyon çağrısı için yetersiz argüman sayısı: en az 1 bekleniyor, 0 alındı",
+		},
+		{
+			Name:     "Extra argument of the wrong type should fail",
+			Input:    sum + "func main() { var n int = sum(1, 2, \"3\"); }",
+			WantErr:  true,
+			ErrorMsg: "Fonksiyon çağrısı için yanlış argüman tipi: int bekleniyor, string alındı",
+		},
+		{
+			Name:     "Spreading into a non-variadic function should fail",
+			Input:    "func f(xs []int) { }; func main() { var nums []int; f(nums...); }",
+			WantErr:  true,
+			ErrorMsg: "Variadic olmayan fonksiyonun çağrısında '...' kullanılamaz",
+		},
+		{
+			Name:     "Spreading a slice of the wrong type should fail",
+			Input:    sum + "func main() { var words []string; var n int = sum(1, words...); }",
+			WantErr:  true,
+			ErrorMsg: "Fonksiyon çağrısı için yanlış argüman tipi: []int bekleniyor, []string alındı",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestClosures(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    string
+		WantErr  bool
+		ErrorMsg string
+	}{
+		{
+			Name:    "Closure captures an outer variable",
+			Input:   "func counter() func() int { var n int = 0; return func() int { n += 1; return n; }; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Function values can be nil",
+			Input:   "func main() { var f func(int) int = nil; if f == nil { f = func(x int) int { return x; }; }; var n int = f(1); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Global function used as a value",
+			Input:   "func inc(x int) int { return x + 1; }; func main() { var f func(int) int = inc; var n int = f(2); }",
+			WantErr: false,
+		},
+		{
+			Name:     "Calling a function value with the wrong argument type should fail",
+			Input:    "func main() { var f func(int) int = func(x int) int { return x; }; var n int = f(\"a\"); }",
+			WantErr:  true,
+			ErrorMsg: "Fonksiyon çağrısı için yanlış argüman tipi: int bekleniyor, string alındı",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestDefer(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    string
+		WantErr  bool
+		ErrorMsg string
+	}{
+		{
+			Name:    "Deferred call with arguments",
+			Input:   "func log(s string, n int) { }; func main() { var n int = 1; defer log(\"n\", n); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Deferred closure recovers into a named result",
+			Input:   "func safe() (ok bool) { defer func() { var r interface{} = recover(); if r != nil { ok = false; }; }(); panic(\"boom\"); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Catch parameter is typed",
+			Input:   "func main() { try { throw \"x\"; } catch (e) { var s string = e.(string); }; }",
+			WantErr: false,
+		},
+		{
+			Name:     "Defer outside a function should fail",
+			Input:    "func log() { }; defer log();",
+			WantErr:  true,
+			ErrorMsg: "defer yalnızca fonksiyon içinde kullanılabilir",
+		},
+		{
+			Name:     "Deferred call with the wrong argument type should fail",
+			Input:    "func log(n int) { }; func main() { defer log(\"a\"); }",
+			WantErr:  true,
+			ErrorMsg: "Fonksiyon çağrısı için yanlış argüman tipi: int bekleniyor, string alındı",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestGoStatement(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    string
+		WantErr  bool
+		ErrorMsg string
+	}{
+		{
+			Name:    "Goroutine with arguments",
+			Input:   "func worker(id int, name string) { }; func main() { var n int = 1; go worker(n, \"w\"); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Goroutine running a closure",
+			Input:   "import \"runtime\"; func main() { var n int = 0; go func() { n = n + 1; runtime.Gosched(); }(); var c int = runtime.NumGoroutine(); }",
+			WantErr: false,
+		},
+		{
+			Name:     "Go outside a function should fail",
+			Input:    "func worker() { }; go worker();",
+			WantErr:  true,
+			ErrorMsg: "go yalnızca fonksiyon içinde kullanılabilir",
+		},
+		{
+			Name:     "Goroutine call with the wrong argument type should fail",
+			Input:    "func worker(n int) { }; func main() { go worker(\"a\"); }",
+			WantErr:  true,
+			ErrorMsg: "Fonksiyon çağrısı için yanlış argüman tipi: int bekleniyor, string alındı",
+		},
 	}
 
 	for _, tt := range tests {
@@ -100,34 +1283,149 @@ func TestVariableUsage(t *testing.T) {
 	}
 }
 
-func TestFunctionDeclaration(t *testing.T) {
-	tests := []testutil.SemanticTestCase{
+func TestChannels(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    string
+		WantErr  bool
+		ErrorMsg string
+	}{
 		{
-			Name:    "Simple function declaration",
-			Input:   "func add(x, y) { return x + y; }",
+			Name:    "Buffered channel send, receive and close",
+			Input:   "func main() { var ch chan int = make(chan int, 2); ch <- 1; var v int = <-ch; v, ok := <-ch; close(ch); var n int = len(ch) + cap(ch); }",
 			WantErr: false,
 		},
 		{
-			Name:    "Function without parameters",
-			Input:   "func hello() { }",
+			Name:    "Directional channel parameters",
+			Input:   "func produce(out chan<- int) { out <- 1; close(out); }; func consume(in <-chan int) int { return <-in; }; func main() { ch := make(chan int); go produce(ch); var v int = consume(ch); }",
 			WantErr: false,
 		},
-		// TODO: Function redeclaration check not implemented yet
-		// {
-		//     Name:     "Function redeclaration should fail",
-		//     Input:    "func test() { } func test() { }",
-		//     WantErr:  true,
-		//     ErrorMsg: "already declared",
-		// },
+		{
+			Name:    "Range over a channel",
+			Input:   "func main() { ch := make(chan string, 1); ch <- \"a\"; close(ch); for s := range ch { var t string = s; } }",
+			WantErr: false,
+		},
+		{
+			Name:    "Select with send, receive and default",
+			Input:   "func main() { a := make(chan int, 1); b := make(chan bool); select { case a <- 1: case v, ok := <-b: var w bool = v && ok; case <-a: break; default: } }",
+			WantErr: false,
+		},
+		{
+			Name:     "Sending a value of the wrong type should fail",
+			Input:    "func main() { ch := make(chan int); ch <- \"a\"; }",
+			WantErr:  true,
+			ErrorMsg: "string tipindeki değer chan int tipindeki kanala gönderilemez",
+		},
+		{
+			Name:     "Receiving from a send-only channel should fail",
+			Input:    "func f(out chan<- int) int { return <-out; }",
+			WantErr:  true,
+			ErrorMsg: "Alma işlemi chan<- int tipindeki kanalda kullanılamaz",
+		},
+		{
+			Name:     "Closing a receive-only channel should fail",
+			Input:    "func f(in <-chan int) { close(in); }",
+			WantErr:  true,
+			ErrorMsg: "Kapatma işlemi <-chan int tipindeki kanalda kullanılamaz",
+		},
+		{
+			Name:     "Receiving from a non-channel should fail",
+			Input:    "func main() { var x int = 1; var y int = <-x; }",
+			WantErr:  true,
+			ErrorMsg: "Alma işlemi bir kanal gerektirir, int alındı",
+		},
+		{
+			Name:     "Range over a channel with two variables should fail",
+			Input:    "func main() { ch := make(chan int); for i, v := range ch { } }",
+			WantErr:  true,
+			ErrorMsg: "Kanal üzerinde range en fazla bir değişken alabilir",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.Name, func(t *testing.T) {
 			program, parseErrors := parseProgram(tt.Input)
-			if len(parseErrors) > 0 {
-				t.Skip("Parser errors, skipping semantic test")
-				return
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
 			}
+		})
+	}
+}
+
+func TestMaps(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    string
+		WantErr  bool
+		ErrorMsg string
+	}{
+		{
+			Name:    "Map literal, lookup, assignment and delete",
+			Input:   "func main() { m := map[string]int{\"a\": 1}; m[\"b\"] = 2; m[\"a\"] += 1; var v int = m[\"a\"]; v, ok := m[\"c\"]; delete(m, \"a\"); var n int = len(m); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Struct keys and range over a map",
+			Input:   "type Point struct { X int; Y int; }; func main() { m := make(map[Point]string); m[Point{1, 2}] = \"p\"; for k, v := range m { var x int = k.X; var s string = v; } }",
+			WantErr: false,
+		},
+		{
+			Name:    "Nil maps",
+			Input:   "func main() { var m map[int]bool; if m == nil { m = make(map[int]bool, 4); } m = nil; }",
+			WantErr: false,
+		},
+		{
+			Name:     "Slice keys should fail",
+			Input:    "func main() { var m map[[]int]string; }",
+			WantErr:  true,
+			ErrorMsg: "Geçersiz map anahtar tipi: []int",
+		},
+		{
+			Name:     "Lookup with a key of the wrong type should fail",
+			Input:    "func main() { m := make(map[string]int); var v int = m[1]; }",
+			WantErr:  true,
+			ErrorMsg: "int tipindeki anahtar map[string]int tipindeki map'te kullanılamaz",
+		},
+		{
+			Name:     "Values of the wrong type should fail",
+			Input:    "func main() { m := map[string]int{\"a\": \"b\"}; }",
+			WantErr:  true,
+			ErrorMsg: "string tipindeki değer map[string]int tipindeki map'e eklenemez",
+		},
+		{
+			Name:     "Duplicate literal keys should fail",
+			Input:    "func main() { m := map[string]int{\"a\": 1, \"a\": 2}; }",
+			WantErr:  true,
+			ErrorMsg: "Map değişmezinde tekrarlanan anahtar",
+		},
+		{
+			Name:     "Deleting from a non-map should fail",
+			Input:    "func main() { var x int = 1; delete(x, 1); }",
+			WantErr:  true,
+			ErrorMsg: "delete işlemi bir map gerektirir, int alındı",
+		},
+		{
+			Name:     "Deleting with a mismatched key type should fail",
+			Input:    "func main() { m := map[string]int{\"a\": 1}; delete(m, 1); }",
+			WantErr:  true,
+			ErrorMsg: "int tipindeki anahtar map[string]int tipindeki map'te kullanılamaz",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
 
 			_, semanticErrors := analyzeProgram(program)
 
@@ -144,29 +1442,182 @@ func TestFunctionDeclaration(t *testing.T) {
 	}
 }
 
-func TestReturnStatements(t *testing.T) {
-	tests := []testutil.SemanticTestCase{
+// TestAssignmentTargetErrorsReportedOnce checks that an assignment target is
+// analyzed only once, so its errors are not reported twice.
+func TestAssignmentTargetErrorsReportedOnce(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		errorMsg string
+	}{
+		{"Undefined index base", "func main() { nope[0] = 1; }", "Tanımlanmamış tanımlayıcı: nope"},
+		{"Undefined string index", "func main() { s := \"abc\"; s[nope] = 1; }", "Tanımlanmamış tanımlayıcı: nope"},
+		{"String index in multi-assignment", "func main() { s := \"abc\"; var i int; s[0], i = 1, 2; }", "String elemanlarına atama yapılamaz: s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.input)
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+			count := 0
+			for _, err := range semanticErrors {
+				if strings.Contains(err, tt.errorMsg) {
+					count++
+				}
+			}
+			if count != 1 {
+				t.Errorf("expected %q to be reported once, got %d times: %v", tt.errorMsg, count, semanticErrors)
+			}
+		})
+	}
+}
+
+func TestStrings(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    string
+		WantErr  bool
+		ErrorMsg string
+	}{
 		{
-			Name:    "Return in function",
-			Input:   "func test() { return 5; }",
+			Name:    "Length, indexing, slicing and comparison",
+			Input:   "func main() { s := \"merhaba\"; var n int = len(s); var b byte = s[0]; var t string = s[1:3] + s[:2] + s[4:]; var ok bool = s < t && s != \"\"; }",
 			WantErr: false,
 		},
-		// TODO: Return outside function check not implemented yet
-		// {
-		//     Name:     "Return outside function should fail",
-		//     Input:    "return 5;",
-		//     WantErr:  true,
-		//     ErrorMsg: "return outside function",
-		// },
+		{
+			Name:    "Conversions between strings, bytes and runes",
+			Input:   "func main() { s := \"héllo\"; b := []byte(s); r := []rune(s); var u string = string(b) + string(r) + string(rune(233)); }",
+			WantErr: false,
+		},
+		{
+			Name:     "Slicing a non-string should fail",
+			Input:    "func main() { var x int = 5; var y int = x[1:2]; }",
+			WantErr:  true,
+			ErrorMsg: "Dilimleme işlemi bir string, dizi veya slice gerektirir, int alındı",
+		},
+		{
+			Name:     "Non-integer bounds should fail",
+			Input:    "func main() { s := \"abc\"; var t string = s[\"a\":]; }",
+			WantErr:  true,
+			ErrorMsg: "Dilimleme sınırı tamsayı tipinde olmalıdır, string alındı",
+		},
+		{
+			Name:     "Inverted constant bounds should fail",
+			Input:    "func main() { s := \"abc\"; var t string = s[2:1]; }",
+			WantErr:  true,
+			ErrorMsg: "Geçersiz dilimleme sınırları: 2 > 1",
+		},
+		{
+			Name:     "Slicing past the end of a literal should fail",
+			Input:    "func main() { var t string = \"abc\"[1:5]; }",
+			WantErr:  true,
+			ErrorMsg: "Dilimleme sınırı 5, 3 uzunluğundaki string'in dışında",
+		},
+		{
+			Name:     "Assigning to a string index should fail",
+			Input:    "func main() { s := \"abc\"; s[0] = 1; }",
+			WantErr:  true,
+			ErrorMsg: "String elemanlarına atama yapılamaz: s",
+		},
+		{
+			Name:     "Compound assignment to a string index should fail",
+			Input:    "func main() { s := \"abc\"; s[1] += 2; }",
+			WantErr:  true,
+			ErrorMsg: "String elemanlarına atama yapılamaz: s",
+		},
+		{
+			Name:    "Converting a float to a string should fail",
+			Input:   "func main() { var f float = 1.5; var s string = string(f); }",
+			WantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.Name, func(t *testing.T) {
 			program, parseErrors := parseProgram(tt.Input)
-			if len(parseErrors) > 0 {
-				t.Skip("Parser errors, skipping semantic test")
-				return
+			testutil.AssertNoErrors(t, parseErrors)
+
+			_, semanticErrors := analyzeProgram(program)
+
+			if tt.WantErr {
+				if len(semanticErrors) == 0 {
+					t.Errorf("Expected semantic error, but got none")
+				} else if tt.ErrorMsg != "" {
+					testutil.AssertErrorContains(t, semanticErrors, tt.ErrorMsg)
+				}
+			} else {
+				testutil.AssertNoErrors(t, semanticErrors)
 			}
+		})
+	}
+}
+
+func TestSlices(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    string
+		WantErr  bool
+		ErrorMsg string
+	}{
+		{
+			Name:    "Slice expressions on slices and arrays",
+			Input:   "func main() { xs := make([]int, 5, 10); var a []int = xs[1:3]; var b []int = xs[:2:4]; var arr [4]int; var c []int = arr[1:]; var n int = len(a) + cap(b) + c[0]; }",
+			WantErr: false,
+		},
+		{
+			Name:    "Append, spread and copy",
+			Input:   "func main() { xs := make([]int, 2); xs = append(xs, 1, 2); ys := append(xs[:1:1], xs...); var n int = copy(ys, xs[1:]); b := make([]byte, 3); n = copy(b, \"abc\"); b = append(b, \"de\"...); }",
+			WantErr: false,
+		},
+		{
+			Name:    "Multi-dimensional slices",
+			Input:   "func main() { grid := make([][]int, 3); for i := range grid { grid[i] = make([]int, 3); } grid[1][2] = 5; g := [][]int{{1, 2}, {3}}; var row []int = g[0][1:]; }",
+			WantErr: false,
+		},
+		{
+			Name:     "Inverted three-index bounds should fail",
+			Input:    "func main() { xs := make([]int, 5); var a []int = xs[1:4:3]; }",
+			WantErr:  true,
+			ErrorMsg: "Geçersiz dilimleme sınırları: 4 > 3",
+		},
+		{
+			Name:     "Slicing past the end of an array should fail",
+			Input:    "func main() { var arr [4]int; var a []int = arr[2:5]; }",
+			WantErr:  true,
+			ErrorMsg: "Dilimleme sınırı 5, 4 uzunluğundaki dizinin dışında",
+		},
+		{
+			Name:     "Three-index slicing of a string should fail",
+			Input:    "func main() { s := \"abc\"; var t string = s[0:1:2]; }",
+			WantErr:  true,
+			ErrorMsg: "3 indeksli dilimleme string'lerde kullanılamaz",
+		},
+		{
+			Name:     "Appending a value of the wrong type should fail",
+			Input:    "func main() { xs := make([]int, 0); xs = append(xs, \"a\"); }",
+			WantErr:  true,
+			ErrorMsg: "string tipindeki değer []int tipindeki slice'a eklenemez",
+		},
+		{
+			Name:     "Copying between different element types should fail",
+			Input:    "func main() { xs := make([]int, 2); ys := make([]string, 2); var n int = copy(xs, ys); }",
+			WantErr:  true,
+			ErrorMsg: "copy işleminde []string tipinden []int tipine kopyalanamaz",
+		},
+		{
+			Name:     "Copying into a non-slice should fail",
+			Input:    "func main() { var x int = 1; xs := make([]int, 2); var n int = copy(x, xs); }",
+			WantErr:  true,
+			ErrorMsg: "copy işleminin hedefi bir slice olmalıdır, int alındı",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			program, parseErrors := parseProgram(tt.Input)
+			testutil.AssertNoErrors(t, parseErrors)
 
 			_, semanticErrors := analyzeProgram(program)
 
@@ -183,23 +1634,91 @@ func TestReturnStatements(t *testing.T) {
 	}
 }
 
-func TestExpressions(t *testing.T) {
-	tests := []testutil.SemanticTestCase{
+func TestPointers(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    string
+		WantErr  bool
+		ErrorMsg string
+	}{
 		{
-			Name:    "Arithmetic expression",
-			Input:   "var result = 5 + 3;",
+			Name:    "Address-of, dereference and assignment through a pointer",
+			Input:   "func main() { x := 1; p := &x; *p = 5; var y int = *p + 1; var q *int = p; if q != nil { *q += y; } }",
 			WantErr: false,
 		},
 		{
-			Name:    "Boolean expression",
-			Input:   "var result = true && false;",
+			Name:    "Pointer receivers and field access through pointers",
+			Input:   "type Point struct { x, y int }\nfunc (p *Point) Move(dx int) { p.x += dx }\nfunc (p Point) Sum() int { return p.x + p.y }\nfunc main() { pt := Point{1, 2}; pt.Move(3); pp := &pt; pp.Move(1); var s int = pp.Sum() + pp.y; }",
 			WantErr: false,
 		},
 		{
-			Name:    "Comparison expression",
-			Input:   "var result = 5 > 3;",
+			Name:    "Pointers to composite literals and pointer-typed fields",
+			Input:   "type Node struct { value int; next *Node }\nfunc main() { n := &Node{value: 1}; n.next = &Node{value: 2}; var v int = n.next.value; }",
+			WantErr: false,
+		},
+		{
+			Name:    "alloc, free, delete and pointer arithmetic inside unsafe",
+			Input:   "func main() { unsafe { p := alloc(int, 4); q := p + 2; *q = 7; var n int = q - p; free(p); r := alloc(float64); delete(r); } }",
+			WantErr: false,
+		},
+		{
+			Name:    "delete on a map stays valid outside unsafe",
+			Input:   "func main() { m := map[string]int{\"a\": 1}; delete(m, \"a\"); }",
 			WantErr: false,
 		},
+		{
+			Name:     "Dereferencing a non-pointer should fail",
+			Input:    "func main() { x := 1; var y int = *x; }",
+			WantErr:  true,
+			ErrorMsg: "* işleci bir işaretçi gerektirir, int alındı",
+		},
+		{
+			Name:     "Taking the address of a literal should fail",
+			Input:    "func main() { p := &5; }",
+			WantErr:  true,
+			ErrorMsg: "5 ifadesinin adresi alınamaz",
+		},
+		{
+			Name:    "Assigning a pointer of the wrong type should fail",
+			Input:   "func main() { x := 1; var p *string = &x; }",
+			WantErr: true,
+		},
+		{
+			Name:     "alloc outside unsafe should fail",
+			Input:    "func main() { p := alloc(int); }",
+			WantErr:  true,
+			ErrorMsg: "alloc yalnızca unsafe bloklarında kullanılabilir",
+		},
+		{
+			Name:     "free outside unsafe should fail",
+			Input:    "func main() { x := 1; free(&x); }",
+			WantErr:  true,
+			ErrorMsg: "free(p) yalnızca unsafe bloklarında kullanılabilir",
+		},
+		{
+			Name:     "delete of a pointer outside unsafe should fail",
+			Input:    "func main() { x := 1; delete(&x); }",
+			WantErr:  true,
+			ErrorMsg: "delete(p) yalnızca unsafe bloklarında kullanılabilir",
+		},
+		{
+			Name:     "Pointer arithmetic outside unsafe should fail",
+			Input:    "func main() { x := 1; p := &x; q := p + 1; }",
+			WantErr:  true,
+			ErrorMsg: "İşaretçi aritmetiği yalnızca unsafe bloklarında kullanılabilir",
+		},
+		{
+			Name:     "Adding two pointers should fail",
+			Input:    "func main() { x := 1; unsafe { p := &x; q := p + p; } }",
+			WantErr:  true,
+			ErrorMsg: "Geçersiz işaretçi aritmetiği: *int + *int",
+		},
+		{
+			Name:     "Freeing a non-pointer should fail",
+			Input:    "func main() { unsafe { free(3); } }",
+			WantErr:  true,
+			ErrorMsg: "free işlemi bir işaretçi gerektirir, int alındı",
+		},
 	}
 
 	for _, tt := range tests {
@@ -268,3 +1787,16 @@ func TestComplexProgram(t *testing.T) {
 		t.Logf("Semantic errors (expected): %v", semanticErrors)
 	}
 }
+
+func TestErrorPositionIncludesFilename(t *testing.T) {
+	input := "var ok = 1;\nvar big = 99999999999999999999;"
+	fset := token.NewFileSet()
+	file := fset.AddFile("sabitler.gom", -1, len(input))
+
+	p := parser.New(lexer.NewFile(file, input, 0))
+	program := p.ParseProgram()
+	testutil.AssertNoErrors(t, p.Errors())
+
+	_, semanticErrors := analyzeProgram(program)
+	testutil.AssertErrorContains(t, semanticErrors, "sabitler.gom:2:11: ")
+}
@@ -0,0 +1,165 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+)
+
+// sliceType, bir tipin (dayanak tipine göre) slice olup olmadığını kontrol
+// eder ve slice tipini döndürür.
+func sliceType(t Type) (*ArrayType, bool) {
+	at, ok := underlyingType(t).(*ArrayType)
+	return at, ok && at.Size == -1
+}
+
+// analyzeSliceExpression, s[i:j] ve s[i:j:k] biçimindeki bir dilimleme
+// ifadesini analiz eder. Sınırlar tamsayı olmalıdır; sabit sınırlar negatif
+// olamaz ve 0 <= i <= j <= k sırasını bozamaz. String'ler ve slice'lar kendi
+// tiplerinde, diziler eleman tipinin slice'ı olarak dilimlenir. Üç indeksli
+// biçim string'lerde kullanılamaz.
+func (a *Analyzer) analyzeSliceExpression(expr *ast.SliceExpression) Type {
+	unknownType := &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	leftType := a.analyzeExpression(expr.Left)
+
+	var constants []int64
+	for _, bound := range []ast.Expression{expr.Low, expr.High, expr.Max} {
+		if bound == nil {
+			continue
+		}
+		boundType := a.analyzeExpression(bound)
+		if !isUnknownType(boundType) && !isIntegerType(boundType) {
+			a.reportError(expr.Token, "Dilimleme sınırı tamsayı tipinde olmalıdır, %s alındı", boundType.String())
+		}
+		value, ok := constantIntValue(bound)
+		if !ok {
+			continue
+		}
+		if value < 0 {
+			a.reportError(expr.Token, "Dilimleme sınırı negatif olamaz: %d", value)
+		}
+		if n := len(constants); n > 0 && constants[n-1] > value {
+			a.reportError(expr.Token, "Geçersiz dilimleme sınırları: %d > %d", constants[n-1], value)
+		}
+		constants = append(constants, value)
+	}
+
+	if isUnknownType(leftType) {
+		return unknownType
+	}
+
+	if isStringType(leftType) {
+		if expr.Max != nil {
+			a.reportError(expr.Token, "3 indeksli dilimleme string'lerde kullanılamaz")
+		}
+		high, ok := constantIntValue(expr.High)
+		if lit, isLit := expr.Left.(*ast.StringLiteral); isLit && ok && high > int64(len(lit.Value)) {
+			a.reportError(expr.Token, "Dilimleme sınırı %d, %d uzunluğundaki string'in dışında", high, len(lit.Value))
+		}
+		return leftType
+	}
+
+	at, ok := underlyingType(leftType).(*ArrayType)
+	if !ok {
+		a.reportError(expr.Token, "Dilimleme işlemi bir string, dizi veya slice gerektirir, %s alındı", leftType.String())
+		return unknownType
+	}
+	if at.Size == -1 {
+		return leftType
+	}
+
+	// Dizilerde sabit sınırlar dizinin uzunluğunu aşamaz
+	for _, value := range constants {
+		if value > at.Size {
+			a.reportError(expr.Token, "Dilimleme sınırı %d, %d uzunluğundaki dizinin dışında", value, at.Size)
+			break
+		}
+	}
+	return &ArrayType{ElementType: at.ElementType, Size: -1}
+}
+
+// canCopyElements, src'nin elemanlarının dst slice'ına eklenip
+// kopyalanabileceğini belirler: eleman tipleri aynı olmalıdır; []byte'a
+// string'ler de kopyalanabilir.
+func canCopyElements(dst *ArrayType, src Type) bool {
+	if isStringType(src) {
+		return isByteSlice(dst)
+	}
+	st, ok := sliceType(src)
+	return ok && dst.ElementType.Equals(st.ElementType)
+}
+
+// analyzeAppendCall, append(s, x...) çağrısını analiz eder. Eklenen değerler
+// slice'ın eleman tipine atanabilir olmalıdır; append(s, t...) biçiminde t
+// aynı eleman tipinde bir slice (veya []byte'a eklenen bir string) olmalıdır.
+// Sonuç ilk argümanın tipidir.
+func (a *Analyzer) analyzeAppendCall(expr *ast.CallExpression) Type {
+	if len(expr.Arguments) == 0 {
+		a.reportError(expr.Token, "append fonksiyonu en az 1 argüman alır")
+		return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	}
+
+	resultType := a.analyzeExpression(expr.Arguments[0])
+	st, ok := sliceType(resultType)
+	if !ok {
+		if !isUnknownType(resultType) {
+			a.reportError(expr.Token, "append işlemi bir slice gerektirir, %s alındı", resultType.String())
+		}
+		for _, arg := range expr.Arguments[1:] {
+			a.analyzeExpression(arg)
+		}
+		return resultType
+	}
+
+	if expr.Ellipsis.IsValid() {
+		if len(expr.Arguments) != 2 {
+			a.reportError(expr.Token, "append(s, t...) biçiminde 2 argüman gerekir, %d verildi", len(expr.Arguments))
+		}
+		for _, arg := range expr.Arguments[1:] {
+			argType := a.analyzeExpression(arg)
+			if !isUnknownType(argType) && !canCopyElements(st, argType) {
+				a.reportError(expr.Token, "%s tipindeki değer %s tipindeki slice'a eklenemez",
+					argType.String(), resultType.String())
+			}
+		}
+		return resultType
+	}
+
+	for _, arg := range expr.Arguments[1:] {
+		argType := a.analyzeExpression(arg)
+		if !a.isAssignableType(argType, st.ElementType) {
+			a.reportError(expr.Token, "%s tipindeki değer %s tipindeki slice'a eklenemez",
+				argType.String(), resultType.String())
+		}
+	}
+	return resultType
+}
+
+// analyzeCopyCall, copy(dst, src) çağrısını analiz eder. Hedef bir slice,
+// kaynak aynı eleman tipinde bir slice (veya []byte hedefi için bir string)
+// olmalıdır. Sonuç kopyalanan eleman sayısıdır.
+func (a *Analyzer) analyzeCopyCall(expr *ast.CallExpression) Type {
+	intType := &BasicType{Name: "int", Kind: INTEGER_TYPE}
+	if len(expr.Arguments) != 2 {
+		a.reportError(expr.Token, "copy fonksiyonu 2 argüman alır, %d verildi", len(expr.Arguments))
+		for _, arg := range expr.Arguments {
+			a.analyzeExpression(arg)
+		}
+		return intType
+	}
+
+	dstType := a.analyzeExpression(expr.Arguments[0])
+	srcType := a.analyzeExpression(expr.Arguments[1])
+	if isUnknownType(dstType) || isUnknownType(srcType) {
+		return intType
+	}
+
+	dst, ok := sliceType(dstType)
+	if !ok {
+		a.reportError(expr.Token, "copy işleminin hedefi bir slice olmalıdır, %s alındı", dstType.String())
+		return intType
+	}
+	if !canCopyElements(dst, srcType) {
+		a.reportError(expr.Token, "copy işleminde %s tipinden %s tipine kopyalanamaz",
+			srcType.String(), dstType.String())
+	}
+	return intType
+}
@@ -0,0 +1,45 @@
+package semantic
+
+// isStringType, bir tipin (dayanak tipine göre) string olup olmadığını kontrol eder.
+func isStringType(t Type) bool {
+	basicType, ok := underlyingType(t).(*BasicType)
+	return ok && basicType.Kind == STRING_TYPE
+}
+
+// isByteSlice, bir tipin []byte (veya []uint8) olup olmadığını kontrol eder.
+func isByteSlice(t Type) bool {
+	at, ok := underlyingType(t).(*ArrayType)
+	if !ok || at.Size != -1 {
+		return false
+	}
+	elem, ok := at.ElementType.(*BasicType)
+	return ok && (elem.Name == "byte" || elem.Name == "uint8")
+}
+
+// isRuneSlice, bir tipin []rune (veya []char) olup olmadığını kontrol eder.
+func isRuneSlice(t Type) bool {
+	at, ok := underlyingType(t).(*ArrayType)
+	if !ok || at.Size != -1 {
+		return false
+	}
+	elem, ok := underlyingType(at.ElementType).(*BasicType)
+	return ok && elem.Kind == CHAR_TYPE
+}
+
+// isStringConversion, string ile []byte, []rune ve rune arasındaki
+// dönüşümleri tanır. Tamsayılar string'e tek bir rune olarak dönüşür.
+func isStringConversion(value, target Type) bool {
+	switch {
+	case isStringType(target):
+		return isNumericType(value) && !isFloatType(value) || isByteSlice(value) || isRuneSlice(value)
+	case isStringType(value):
+		return isByteSlice(target) || isRuneSlice(target)
+	}
+	return false
+}
+
+// isFloatType, bir tipin (dayanak tipine göre) kayan noktalı olup olmadığını kontrol eder.
+func isFloatType(t Type) bool {
+	basicType, ok := underlyingType(t).(*BasicType)
+	return ok && basicType.Kind == FLOAT_TYPE
+}
@@ -0,0 +1,453 @@
+package semantic
+
+import (
+	"github.com/inkbytefo/go-minus/internal/ast"
+	"github.com/inkbytefo/go-minus/internal/token"
+)
+
+// declareTypes, verilen ifadelerdeki tip bildirimlerini geçerli kapsamda
+// tanımlar. Tipler birbirine ileriye doğru başvurabildiğinden önce tüm adlar
+// tanımlanır, ardından tip gövdeleri çözümlenir: önce takma adlar, sonra
+// struct ve arayüz gövdeleri, en son başka tiplere dayanan adlandırılmış
+// tipler. Böylece bir tip, dayandığı tip tamamlandıktan sonra tanımlanır.
+func (a *Analyzer) declareTypes(stmts []ast.Statement) {
+	decls := map[string]*ast.TypeStatement{}
+	for _, stmt := range stmts {
+		if ts, ok := stmt.(*ast.TypeStatement); ok {
+			if _, exists := decls[ts.Name.Value]; !exists {
+				decls[ts.Name.Value] = ts
+			}
+		}
+	}
+
+	declared := []*ast.TypeStatement{}
+	for _, stmt := range stmts {
+		if ts, ok := stmt.(*ast.TypeStatement); ok && a.declareType(ts, a.typeDeclKind(ts, decls, map[*ast.TypeStatement]bool{})) {
+			declared = append(declared, ts)
+		}
+	}
+
+	const (
+		defining = iota + 1
+		defined
+	)
+	state := map[*ast.TypeStatement]int{}
+	var define func(ts *ast.TypeStatement)
+	define = func(ts *ast.TypeStatement) {
+		switch state[ts] {
+		case defined:
+			return
+		case defining:
+			a.reportError(ts.Name.Token, "Geçersiz özyinelemeli tip: %s", ts.Name.Value)
+			return
+		}
+		state[ts] = defining
+		// Takma adlar yalnızca diğer takma adları bekler; diğer tiplerin
+		// sembolleri bildirimden beri son hallerindedir
+		if ident, ok := ts.Type.(*ast.Identifier); ok {
+			if dep, exists := decls[ident.Value]; exists && (dep.Alias || !ts.Alias) {
+				define(dep)
+			}
+		}
+		a.defineType(ts)
+		state[ts] = defined
+	}
+
+	for _, ts := range declared {
+		if ts.Alias {
+			define(ts)
+		}
+	}
+	for _, ts := range declared {
+		switch ts.Type.(type) {
+		case *ast.StructType, *ast.InterfaceType:
+			define(ts)
+		}
+	}
+	for _, ts := range declared {
+		define(ts)
+	}
+
+	for _, ts := range declared {
+		a.checkRecursiveType(ts)
+	}
+}
+
+// typeDeclKind, bir tip bildiriminin tanımladığı tipin türünü belirler.
+// Bildirilmiş bir struct veya arayüz tipine dayanan tipler (type MyPoint
+// Point) dayandıkları tipin türünü alır; diğer tipler için UNKNOWN_TYPE döner.
+func (a *Analyzer) typeDeclKind(ts *ast.TypeStatement, decls map[string]*ast.TypeStatement, visiting map[*ast.TypeStatement]bool) SymbolType {
+	switch t := ts.Type.(type) {
+	case *ast.StructType:
+		return STRUCT_TYPE
+	case *ast.InterfaceType:
+		return INTERFACE_TYPE
+	case *ast.Identifier:
+		if target, exists := decls[t.Value]; exists {
+			if visiting[target] {
+				return UNKNOWN_TYPE
+			}
+			visiting[ts] = true
+			return a.typeDeclKind(target, decls, visiting)
+		}
+		if symbol := a.currentScope.Resolve(t.Value); symbol != nil && symbol.IsType {
+			switch symbol.DataType.(type) {
+			case *StructType:
+				return STRUCT_TYPE
+			case *InterfaceType:
+				return INTERFACE_TYPE
+			}
+		}
+	}
+	return UNKNOWN_TYPE
+}
+
+// declareType, bir tip adını geçerli kapsamda tanımlar. Struct, arayüz ve
+// adlandırılmış tipler için gövdesi henüz çözümlenmemiş bir tip oluşturulur;
+// takma adların tipi ise tanımlandıklarında belirlenir.
+func (a *Analyzer) declareType(stmt *ast.TypeStatement, kind SymbolType) bool {
+	name := stmt.Name.Value
+	if _, exists := a.currentScope.Symbols[name]; exists {
+		a.reportError(stmt.Name.Token, "Tip zaten tanımlı: %s", name)
+		return false
+	}
+
+	symbol := a.currentScope.Define(name, UNKNOWN_TYPE, stmt.Name.Token)
+	symbol.IsType = true
+	symbol.DataType = &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+	switch {
+	case stmt.Alias:
+	case kind == STRUCT_TYPE:
+		symbol.Type = STRUCT_TYPE
+		symbol.DataType = &StructType{Name: name}
+	case kind == INTERFACE_TYPE:
+		symbol.Type = INTERFACE_TYPE
+		symbol.DataType = &InterfaceType{Name: name, Methods: make(map[string]*FunctionType)}
+	default:
+		symbol.DataType = &NamedType{Name: name, Methods: make(map[string]*FunctionType)}
+	}
+
+	return true
+}
+
+// defineType, tanımlanmış bir tipin gövdesini çözümler.
+func (a *Analyzer) defineType(stmt *ast.TypeStatement) {
+	symbol := a.currentScope.Symbols[stmt.Name.Value]
+
+	if stmt.Alias {
+		// Takma ad, hedef tipin kendisidir
+		symbol.DataType = a.resolveType(stmt.Type)
+		symbol.Type = typeSymbolKind(symbol.DataType)
+		return
+	}
+
+	switch t := stmt.Type.(type) {
+	case *ast.StructType:
+		a.resolveStructFields(t, symbol.DataType.(*StructType))
+		return
+	case *ast.InterfaceType:
+		a.resolveInterfaceType(t, symbol.DataType.(*InterfaceType))
+		return
+	}
+
+	target := a.resolveType(stmt.Type)
+	switch dataType := symbol.DataType.(type) {
+	case *StructType:
+		// Yeni tip alanları paylaşır, metotları paylaşmaz
+		if st, ok := target.(*StructType); ok {
+			dataType.Fields = st.Fields
+		}
+	case *InterfaceType:
+		if it, ok := target.(*InterfaceType); ok {
+			dataType.Methods = it.Methods
+			dataType.Embeds = it.Embeds
+		}
+	case *NamedType:
+		dataType.Underlying = underlyingType(target)
+		symbol.Type = symbolTypeFromType(dataType.Underlying)
+	}
+}
+
+// resolveStructFields, bir struct tipinin alanlarını çözümleyip target'a ekler.
+func (a *Analyzer) resolveStructFields(st *ast.StructType, target *StructType) {
+	for _, field := range st.Fields {
+		name := field.FieldName()
+		tok := nodeToken(field.Type)
+		if field.Name != nil {
+			tok = field.Name.Token
+		}
+
+		fieldType := a.resolveType(field.Type)
+		if target.Field(name) != nil {
+			a.reportError(tok, "Alan zaten tanımlı: %s", name)
+			continue
+		}
+
+		tag := ""
+		if field.Tag != nil {
+			tag = field.Tag.Value
+		}
+
+		target.Fields = append(target.Fields, &StructField{
+			Name:     name,
+			Type:     fieldType,
+			Tag:      tag,
+			Embedded: field.Embedded(),
+		})
+	}
+}
+
+// checkRecursiveType, bir struct'ın veya adlandırılmış tipin kendisini
+// (doğrudan veya dolaylı olarak) değer olarak içermediğini kontrol eder; böyle
+// bir tipin boyutu sonsuz olurdu. Kendini gömen arayüzler de geçersizdir.
+func (a *Analyzer) checkRecursiveType(stmt *ast.TypeStatement) {
+	if stmt.Alias {
+		return
+	}
+
+	switch t := a.currentScope.Symbols[stmt.Name.Value].DataType.(type) {
+	case *InterfaceType:
+		if embedsInterface(t, t, map[*InterfaceType]bool{}) {
+			a.reportError(stmt.Name.Token, "Geçersiz özyinelemeli tip: %s", t.Name)
+		}
+	case *StructType:
+		visited := map[Type]bool{}
+		for _, field := range t.Fields {
+			if containsType(field.Type, t, visited) {
+				a.reportError(stmt.Name.Token, "Geçersiz özyinelemeli tip: %s", t.Name)
+				return
+			}
+		}
+	case *NamedType:
+		if containsType(t.Underlying, t, map[Type]bool{}) {
+			a.reportError(stmt.Name.Token, "Geçersiz özyinelemeli tip: %s", t.Name)
+		}
+	}
+}
+
+// containsType, t tipinin target tipini değer olarak içerip içermediğini
+// kontrol eder. Slice'lar elemanlarını ayrı bellekte tuttuğundan sayılmaz.
+func containsType(t Type, target Type, visited map[Type]bool) bool {
+	if t == target {
+		return true
+	}
+
+	switch tt := t.(type) {
+	case *StructType:
+		if visited[tt] {
+			return false
+		}
+		visited[tt] = true
+		for _, field := range tt.Fields {
+			if containsType(field.Type, target, visited) {
+				return true
+			}
+		}
+	case *ArrayType:
+		if tt.Size >= 0 {
+			return containsType(tt.ElementType, target, visited)
+		}
+	case *NamedType:
+		if visited[tt] {
+			return false
+		}
+		visited[tt] = true
+		return containsType(tt.Underlying, target, visited)
+	}
+	return false
+}
+
+// lookupField, bir alanı veya metodu struct'ta ve gömülü struct'larında arar.
+// Gömülü alanların alanları ve metotları dış struct'a yükseltilir; en sığ
+// derinlikte bulunan seçici kullanılır. Aynı derinlikte birden fazla seçici
+// varsa seçici belirsizdir.
+func lookupField(st *StructType, name string) (field *StructField, method *FunctionType, ambiguous bool) {
+	current := []*StructType{st}
+	visited := map[*StructType]bool{}
+
+	for len(current) > 0 {
+		var next []*StructType
+		count := 0
+		for _, s := range current {
+			if visited[s] {
+				continue
+			}
+			visited[s] = true
+
+			for _, f := range s.Fields {
+				if f.Name == name {
+					field = f
+					count++
+				}
+				if inner := embeddedStruct(f); inner != nil {
+					next = append(next, inner)
+				}
+			}
+			if m, exists := s.Methods[name]; exists {
+				method = m
+				count++
+			}
+		}
+
+		if count > 1 {
+			return nil, nil, true
+		}
+		if count == 1 {
+			return field, method, false
+		}
+		current = next
+	}
+
+	return nil, nil, false
+}
+
+// embeddedStruct, gömülü bir alanın (T veya *T) struct tipini döndürür.
+// Alan gömülü değilse veya bir struct'ı göstermiyorsa nil döner.
+func embeddedStruct(f *StructField) *StructType {
+	if !f.Embedded {
+		return nil
+	}
+	t := f.Type
+	if pointer, ok := t.(*PointerType); ok {
+		t = pointer.ElementType
+	}
+	st, _ := t.(*StructType)
+	return st
+}
+
+// structMemberType, bir struct üzerindeki alan veya metot erişiminin tipini döndürür.
+func (a *Analyzer) structMemberType(tok token.Token, st *StructType, name string) Type {
+	field, method, ambiguous := lookupField(st, name)
+	switch {
+	case ambiguous:
+		a.reportError(tok, "Belirsiz seçici: %s.%s", st.String(), name)
+	case method != nil:
+		return method
+	case field == nil:
+		a.reportError(tok, "%s tipinde '%s' adında bir alan yok", st.String(), name)
+	default:
+		return field.Type
+	}
+	return &BasicType{Name: "unknown", Kind: UNKNOWN_TYPE}
+}
+
+// analyzeCompositeLiteral, bir bileşik değişmezi analiz eder. Struct
+// değişmezlerinde alanlar ya adlarıyla ya da tanım sırasıyla verilir;
+// verilmeyen alanlar sıfır değerini alır.
+func (a *Analyzer) analyzeCompositeLiteral(expr *ast.CompositeLiteral) Type {
+	litType := a.resolveType(expr.Type)
+
+	switch t := underlyingType(litType).(type) {
+	case *StructType:
+		a.analyzeStructLiteral(expr, t)
+	case *ArrayType:
+		for _, element := range expr.Elements {
+			a.checkElementValue(expr.Token, element, t.ElementType)
+		}
+	case *MapType:
+		a.analyzeMapLiteral(expr, t)
+	default:
+		if !isUnknownType(litType) {
+			a.reportError(expr.Token, "%s tipi için bileşik değişmez kullanılamaz", litType.String())
+		}
+		for _, element := range expr.Elements {
+			if kv, ok := element.(*ast.KeyValueExpression); ok {
+				element = kv.Value
+			}
+			a.analyzeExpression(element)
+		}
+	}
+
+	return litType
+}
+
+// analyzeStructLiteral, bir struct değişmezinin elemanlarını alanlarla eşleştirir.
+func (a *Analyzer) analyzeStructLiteral(expr *ast.CompositeLiteral, st *StructType) {
+	keyed := 0
+	for _, element := range expr.Elements {
+		if _, ok := element.(*ast.KeyValueExpression); ok {
+			keyed++
+		}
+	}
+
+	if keyed > 0 && keyed != len(expr.Elements) {
+		a.reportError(expr.Token, "Struct değişmezinde alan adlı ve konumsal değerler karıştırılamaz")
+		return
+	}
+
+	if keyed == 0 {
+		if len(expr.Elements) > 0 && len(expr.Elements) != len(st.Fields) {
+			a.reportError(expr.Token, "%s değişmezinde %d değer bekleniyordu, %d verildi",
+				st.String(), len(st.Fields), len(expr.Elements))
+		}
+		for i, element := range expr.Elements {
+			if i < len(st.Fields) {
+				a.checkFieldValue(expr.Token, st.Fields[i], element)
+			} else {
+				a.analyzeExpression(element)
+			}
+		}
+		return
+	}
+
+	given := map[string]bool{}
+	for _, element := range expr.Elements {
+		kv := element.(*ast.KeyValueExpression)
+		key, ok := kv.Key.(*ast.Identifier)
+		if !ok {
+			a.reportError(kv.Token, "Struct değişmezinde alan adı bir tanımlayıcı olmalıdır")
+			a.analyzeExpression(kv.Value)
+			continue
+		}
+
+		field := st.Field(key.Value)
+		switch {
+		case field == nil:
+			a.reportError(key.Token, "%s tipinde '%s' adında bir alan yok", st.String(), key.Value)
+			a.analyzeExpression(kv.Value)
+		case given[key.Value]:
+			a.reportError(key.Token, "Alan birden fazla kez verildi: %s", key.Value)
+		default:
+			given[key.Value] = true
+			a.checkFieldValue(key.Token, field, kv.Value)
+		}
+	}
+}
+
+// checkFieldValue, bir değerin struct alanına atanabilirliğini kontrol eder.
+func (a *Analyzer) checkFieldValue(tok token.Token, field *StructField, value ast.Expression) {
+	valueType := a.analyzeExpression(value)
+	if !a.isAssignableType(valueType, field.Type) {
+		a.reportError(tok, "%s tipindeki değer %s tipindeki %s alanına atanamaz",
+			valueType.String(), field.Type.String(), field.Name)
+	}
+}
+
+// checkElementValue, bir değerin dizi elemanı olarak atanabilirliğini kontrol eder.
+func (a *Analyzer) checkElementValue(tok token.Token, value ast.Expression, elementType Type) {
+	if kv, ok := value.(*ast.KeyValueExpression); ok {
+		a.analyzeExpression(kv.Key)
+		value = kv.Value
+	}
+	valueType := a.analyzeExpression(value)
+	if !a.isAssignableType(valueType, elementType) {
+		a.reportError(tok, "%s tipindeki değer %s tipindeki diziye eklenemez",
+			valueType.String(), elementType.String())
+	}
+}
+
+// checkStructComparison, struct değerlerinin karşılaştırılmasını denetler.
+// Struct'lar yalnızca == ve != ile ve tüm alanları karşılaştırılabiliyorsa
+// karşılaştırılabilir.
+func (a *Analyzer) checkStructComparison(tok token.Token, operator string, t Type) {
+	st, ok := underlyingType(t).(*StructType)
+	if !ok {
+		return
+	}
+	switch {
+	case operator != "==" && operator != "!=":
+		a.reportError(tok, "Struct değerleri yalnızca == ve != ile karşılaştırılabilir: %s", t.String())
+	case !isComparableKey(st):
+		a.reportError(tok, "Karşılaştırılamayan alanları olan struct değerleri karşılaştırılamaz: %s", t.String())
+	}
+}
@@ -82,6 +82,8 @@ type Symbol struct {
 	Value     interface{}
 	Signature *FunctionSignature // Fonksiyonlar için
 	Class     *ClassInfo         // Sınıflar için
+	DataType  Type               // Bileşik tipli değişkenler için tam tip (dizi, map vb.)
+	IsType    bool               // Sembol bir tip adıdır; tipin kendisi DataType'tadır
 }
 
 // FunctionSignature, bir fonksiyonun imzasını temsil eder.
@@ -138,6 +140,16 @@ func (s *Scope) Define(name string, symbolType SymbolType, tok token.Token) *Sym
 	return symbol
 }
 
+// DefineVariable, bir değişkeni tam tipiyle birlikte tanımlar. Temel olmayan
+// tipler sembolde saklanır; böylece değişken çözümlendiğinde eleman tipleri kaybolmaz.
+func (s *Scope) DefineVariable(name string, t Type, tok token.Token) *Symbol {
+	symbol := s.Define(name, symbolTypeFromType(t), tok)
+	if _, basic := t.(*BasicType); t != nil && !basic {
+		symbol.DataType = t
+	}
+	return symbol
+}
+
 // Resolve, bir sembolü çözümler.
 func (s *Scope) Resolve(name string) *Symbol {
 	symbol, ok := s.Symbols[name]
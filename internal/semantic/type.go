@@ -2,6 +2,9 @@ package semantic
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/inkbytefo/go-minus/internal/ast"
 )
 
 // Type, bir tipi temsil eder.
@@ -70,10 +73,56 @@ func (mt *MapType) Equals(other Type) bool {
 	return false
 }
 
-// FunctionType, bir fonksiyon tipini temsil eder.
+// ChanType, bir kanal tipini temsil eder. Yönlü kanallar yalnızca gönderme
+// (chan<- T) veya yalnızca alma (<-chan T) işlemine izin verir.
+type ChanType struct {
+	ElementType Type
+	Dir         ast.ChanDir
+}
+
+// String, kanal tipinin string temsilini döndürür.
+func (ct *ChanType) String() string {
+	switch ct.Dir {
+	case ast.SEND:
+		return "chan<- " + ct.ElementType.String()
+	case ast.RECV:
+		return "<-chan " + ct.ElementType.String()
+	}
+	return "chan " + ct.ElementType.String()
+}
+
+// Equals, iki kanal tipinin eşit olup olmadığını kontrol eder.
+func (ct *ChanType) Equals(other Type) bool {
+	if otherChan, ok := other.(*ChanType); ok {
+		return ct.Dir == otherChan.Dir && ct.ElementType.Equals(otherChan.ElementType)
+	}
+	return false
+}
+
+// PointerType, bir işaretçi tipini temsil eder.
+type PointerType struct {
+	ElementType Type
+}
+
+// String, işaretçi tipinin string temsilini döndürür.
+func (pt *PointerType) String() string {
+	return "*" + pt.ElementType.String()
+}
+
+// Equals, iki işaretçi tipinin aynı tipi gösterip göstermediğini kontrol eder.
+func (pt *PointerType) Equals(other Type) bool {
+	if otherPointer, ok := other.(*PointerType); ok {
+		return pt.ElementType.Equals(otherPointer.ElementType)
+	}
+	return false
+}
+
+// FunctionType, bir fonksiyon tipini temsil eder. Variadic fonksiyonlarda
+// son parametre tipi fazladan argümanları toplayan slice'tır.
 type FunctionType struct {
 	ParameterTypes []Type
 	ReturnType     Type
+	IsVariadic     bool
 }
 
 // String, fonksiyon tipinin string temsilini döndürür.
@@ -84,6 +133,10 @@ func (ft *FunctionType) String() string {
 		if i > 0 {
 			result += ", "
 		}
+		if slice, ok := paramType.(*ArrayType); ok && ft.IsVariadic && i == len(ft.ParameterTypes)-1 {
+			result += "..." + slice.ElementType.String()
+			continue
+		}
 		result += paramType.String()
 	}
 
@@ -99,7 +152,7 @@ func (ft *FunctionType) String() string {
 // Equals, iki fonksiyon tipinin eşit olup olmadığını kontrol eder.
 func (ft *FunctionType) Equals(other Type) bool {
 	if otherFunc, ok := other.(*FunctionType); ok {
-		if len(ft.ParameterTypes) != len(otherFunc.ParameterTypes) {
+		if len(ft.ParameterTypes) != len(otherFunc.ParameterTypes) || ft.IsVariadic != otherFunc.IsVariadic {
 			return false
 		}
 
@@ -144,23 +197,77 @@ func (ct *ClassType) Equals(other Type) bool {
 	return false
 }
 
-// InterfaceType, bir arayüz tipini temsil eder.
+// InterfaceType, bir arayüz tipini temsil eder. Adlandırılmış arayüzler ada
+// göre, anonim arayüzler metot kümelerine göre karşılaştırılır.
 type InterfaceType struct {
-	Name    string
+	Name    string // Anonim arayüzler için boş
 	Methods map[string]*FunctionType
+	Embeds  []*InterfaceType // Metotları arayüze katılan gömülü arayüzler
 }
 
 // String, arayüz tipinin string temsilini döndürür.
 func (it *InterfaceType) String() string {
-	return it.Name
+	if it.Name != "" {
+		return it.Name
+	}
+
+	methods := it.MethodSet()
+	if len(methods) == 0 {
+		return "interface{}"
+	}
+
+	result := "interface {"
+	for i, name := range sortedMethodNames(methods) {
+		if i > 0 {
+			result += ";"
+		}
+		result += " " + name + "()"
+	}
+	return result + " }"
 }
 
 // Equals, iki arayüz tipinin eşit olup olmadığını kontrol eder.
 func (it *InterfaceType) Equals(other Type) bool {
-	if otherInterface, ok := other.(*InterfaceType); ok {
+	otherInterface, ok := other.(*InterfaceType)
+	if !ok {
+		return false
+	}
+	if it.Name != "" || otherInterface.Name != "" {
 		return it.Name == otherInterface.Name
 	}
-	return false
+
+	methods, otherMethods := it.MethodSet(), otherInterface.MethodSet()
+	if len(methods) != len(otherMethods) {
+		return false
+	}
+	for name, method := range methods {
+		otherMethod, exists := otherMethods[name]
+		if !exists || !sameSignature(method, otherMethod) {
+			return false
+		}
+	}
+	return true
+}
+
+// MethodSet, arayüzün gömülü arayüzlerden gelenler dahil tüm metotlarını döndürür.
+func (it *InterfaceType) MethodSet() map[string]*FunctionType {
+	methods := make(map[string]*FunctionType)
+	it.collectMethods(methods, map[*InterfaceType]bool{})
+	return methods
+}
+
+func (it *InterfaceType) collectMethods(methods map[string]*FunctionType, visited map[*InterfaceType]bool) {
+	if visited[it] {
+		return
+	}
+	visited[it] = true
+
+	for _, embed := range it.Embeds {
+		embed.collectMethods(methods, visited)
+	}
+	for name, method := range it.Methods {
+		methods[name] = method
+	}
 }
 
 // TemplateType, bir şablon tipini temsil eder.
@@ -193,3 +300,123 @@ func (tt *TemplateType) Equals(other Type) bool {
 	}
 	return false
 }
+
+// StructField, bir struct alanını temsil eder.
+type StructField struct {
+	Name     string
+	Type     Type
+	Tag      string
+	Embedded bool // Gömülü alanların alanları dış struct'a yükseltilir
+}
+
+// StructType, bir struct tipini temsil eder. Adlandırılmış struct'lar ada göre,
+// anonim struct'lar alanlarına göre karşılaştırılır.
+type StructType struct {
+	Name    string // Anonim struct'lar için boş
+	Fields  []*StructField
+	Methods map[string]*FunctionType // Alıcısı bu tip olan metotlar
+}
+
+// String, struct tipinin string temsilini döndürür.
+func (st *StructType) String() string {
+	if st.Name != "" {
+		return st.Name
+	}
+
+	result := "struct {"
+	for i, field := range st.Fields {
+		if i > 0 {
+			result += ";"
+		}
+		if field.Embedded {
+			result += " " + field.Type.String()
+		} else {
+			result += fmt.Sprintf(" %s %s", field.Name, field.Type.String())
+		}
+	}
+	return result + " }"
+}
+
+// Equals, iki struct tipinin eşit olup olmadığını kontrol eder.
+func (st *StructType) Equals(other Type) bool {
+	otherStruct, ok := other.(*StructType)
+	if !ok {
+		return false
+	}
+	if st.Name != "" || otherStruct.Name != "" {
+		return st.Name == otherStruct.Name
+	}
+	if len(st.Fields) != len(otherStruct.Fields) {
+		return false
+	}
+	for i, field := range st.Fields {
+		otherField := otherStruct.Fields[i]
+		if field.Name != otherField.Name || field.Embedded != otherField.Embedded ||
+			field.Tag != otherField.Tag || !field.Type.Equals(otherField.Type) {
+			return false
+		}
+	}
+	return true
+}
+
+// Field, doğrudan tanımlanmış bir alanı adıyla döndürür.
+func (st *StructType) Field(name string) *StructField {
+	for _, field := range st.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// NamedType, struct ve arayüz dışındaki bir tipe dayanan adlandırılmış bir
+// tipi temsil eder (ör. type Celsius float64). Adlandırılmış tipler yalnızca
+// kendileriyle özdeştir ve kendi metot kümelerine sahiptir; dayandıkları
+// tipin işlemlerini ise devralırlar.
+type NamedType struct {
+	Name       string
+	Underlying Type                     // Adsız dayanak tip (ör. float64, []int, func(int) error)
+	Methods    map[string]*FunctionType // Alıcısı bu tip olan metotlar
+}
+
+// String, adlandırılmış tipin string temsilini döndürür.
+func (nt *NamedType) String() string {
+	return nt.Name
+}
+
+// Equals, iki adlandırılmış tipin aynı tip olup olmadığını kontrol eder.
+func (nt *NamedType) Equals(other Type) bool {
+	otherNamed, ok := other.(*NamedType)
+	return ok && otherNamed == nt
+}
+
+// TupleType, birden çok sonuç döndüren bir fonksiyonun sonuç tipini temsil
+// eder. Bu tipteki değerler yalnızca çoklu atamalarda ve return ifadelerinde
+// kullanılabilir.
+type TupleType struct {
+	Types []Type
+}
+
+// String, tuple tipinin string temsilini döndürür.
+func (tt *TupleType) String() string {
+	names := make([]string, len(tt.Types))
+	for i, t := range tt.Types {
+		names[i] = t.String()
+	}
+	return "(" + strings.Join(names, ", ") + ")"
+}
+
+// Equals, iki tuple tipinin eleman tiplerinin sırasıyla eşit olup olmadığını
+// kontrol eder.
+func (tt *TupleType) Equals(other Type) bool {
+	otherTuple, ok := other.(*TupleType)
+	if !ok || len(tt.Types) != len(otherTuple.Types) {
+		return false
+	}
+	for i, t := range tt.Types {
+		if !t.Equals(otherTuple.Types[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -93,8 +93,8 @@ func CreateTestToken(tokenType token.TokenType, literal string, line, column int
 		Literal: literal,
 		Line:    line,
 		Column:  column,
-		Pos:     0,
-		End:     len(literal),
+		Pos:     token.NoPos,
+		End:     token.Pos(len(literal)),
 	}
 }
 
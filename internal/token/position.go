@@ -1,13 +1,263 @@
 package token
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
 // Position, kaynak koddaki bir konumu temsil eder.
 type Position struct {
-	Line   int // Satır numarası (1-tabanlı)
-	Column int // Sütun numarası (1-tabanlı)
-	Offset int // Dosyadaki byte offset (0-tabanlı)
+	Filename string // Dosya adı (varsa)
+	Line     int    // Satır numarası (1-tabanlı)
+	Column   int    // Sütun numarası (1-tabanlı)
+	Offset   int    // Dosyadaki byte offset (0-tabanlı)
 }
 
 // IsValid, konumun geçerli olup olmadığını kontrol eder.
 func (p Position) IsValid() bool {
 	return p.Line > 0
 }
+
+// String, konumu tanılama mesajlarında kullanılan biçimde döndürür:
+//
+//	dosya.gom:satır:sütun   dosya adı varsa
+//	satır:sütun             dosya adı yoksa
+//	dosya.gom               konum geçersizse
+//	-                       konum geçersiz ve dosya adı yoksa
+func (p Position) String() string {
+	s := p.Filename
+	if p.IsValid() {
+		if s != "" {
+			s += ":"
+		}
+		s += fmt.Sprintf("%d:%d", p.Line, p.Column)
+	}
+	if s == "" {
+		s = "-"
+	}
+	return s
+}
+
+// bom, UTF-8 bayt sırası işaretidir (U+FEFF).
+const bom = "\uFEFF"
+
+// Pos, bir FileSet içindeki bir konumun kompakt temsilidir.
+// Değer, dosyanın taban değeri ile dosya içindeki byte offset'in toplamıdır.
+// Tam konum (dosya adı, satır, sütun) FileSet.Position ile elde edilir.
+type Pos int
+
+// NoPos, geçersiz (bilinmeyen) konumu temsil eder.
+const NoPos Pos = 0
+
+// IsValid, konumun geçerli olup olmadığını kontrol eder.
+func (p Pos) IsValid() bool {
+	return p != NoPos
+}
+
+// File, bir FileSet'e eklenmiş tek bir kaynak dosyasını temsil eder.
+// Her satırın başlangıç offset'ini tutan bir satır tablosu içerir.
+type File struct {
+	name string // Dosya adı
+	base int    // Pos taban değeri
+	size int    // Dosya boyutu (byte)
+
+	mutex sync.Mutex
+	lines []int  // Satır başlangıç offset'leri; ilk eleman her zaman 0
+	src   string // Sütunları karakter (rune) olarak hesaplamak için kaynak (opsiyonel)
+}
+
+// Name, dosyanın adını döndürür.
+func (f *File) Name() string {
+	return f.name
+}
+
+// Base, dosyanın Pos taban değerini döndürür.
+func (f *File) Base() int {
+	return f.base
+}
+
+// Size, dosyanın byte cinsinden boyutunu döndürür.
+func (f *File) Size() int {
+	return f.size
+}
+
+// LineCount, satır tablosundaki satır sayısını döndürür.
+func (f *File) LineCount() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return len(f.lines)
+}
+
+// AddLine, verilen offset'te yeni bir satırın başladığını kaydeder.
+// Offset önceki satır başlangıcından küçük veya eşitse ya da dosya
+// boyutunu aşıyorsa yok sayılır. Dosya '\n' ile bitiyorsa dosya sonu
+// konumu yeni (boş) bir satırın başı olarak kabul edilir.
+func (f *File) AddLine(offset int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if i := len(f.lines); (i == 0 || f.lines[i-1] < offset) && offset <= f.size {
+		f.lines = append(f.lines, offset)
+	}
+}
+
+// SetSource, dosyanın içeriğini kaydeder ve satır tablosunu içerikten oluşturur.
+// İçerik bilindiğinde sütunlar byte yerine karakter (rune) olarak hesaplanır.
+func (f *File) SetSource(src string) {
+	lines := []int{0}
+	for i := 0; i < len(src); i++ {
+		if src[i] == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.lines = lines
+	f.src = src
+}
+
+// LineStart, verilen satırın (1-tabanlı) ilk baytının Pos değerini döndürür.
+func (f *File) LineStart(line int) Pos {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if line < 1 || line > len(f.lines) {
+		panic(fmt.Sprintf("geçersiz satır numarası %d (satır sayısı %d)", line, len(f.lines)))
+	}
+	return Pos(f.base + f.lines[line-1])
+}
+
+// Pos, dosya içindeki bir byte offset'i için Pos değerini döndürür.
+func (f *File) Pos(offset int) Pos {
+	if offset < 0 || offset > f.size {
+		panic(fmt.Sprintf("geçersiz dosya offset'i %d (dosya boyutu %d)", offset, f.size))
+	}
+	return Pos(f.base + offset)
+}
+
+// Offset, bir Pos değerinin dosya içindeki byte offset'ini döndürür.
+func (f *File) Offset(p Pos) int {
+	if int(p) < f.base || int(p) > f.base+f.size {
+		panic(fmt.Sprintf("geçersiz Pos değeri %d (dosya aralığı [%d, %d])", p, f.base, f.base+f.size))
+	}
+	return int(p) - f.base
+}
+
+// Line, bir Pos değerinin satır numarasını döndürür.
+func (f *File) Line(p Pos) int {
+	return f.Position(p).Line
+}
+
+// Position, bir Pos değerinin dosya adı, satır ve sütun bilgisini döndürür.
+func (f *File) Position(p Pos) Position {
+	if !p.IsValid() {
+		return Position{}
+	}
+	offset := f.Offset(p)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	// lines[0] == 0 olduğundan i her zaman >= 0'dır
+	i := sort.Search(len(f.lines), func(i int) bool { return f.lines[i] > offset }) - 1
+	start := f.lines[i]
+	if start == 0 && strings.HasPrefix(f.src, bom) && offset >= len(bom) {
+		start = len(bom) // Dosya başındaki BOM sütun sayısına dahil edilmez
+	}
+	column := offset - start + 1
+	if offset <= len(f.src) {
+		column = utf8.RuneCountInString(f.src[start:offset]) + 1
+	}
+	return Position{Filename: f.name, Line: i + 1, Column: column, Offset: offset}
+}
+
+// FileSet, bir derleme birimindeki kaynak dosyalarını tutar.
+// Her dosyaya ayrı bir Pos aralığı atanır; böylece tek bir Pos değeri
+// hem dosyayı hem de dosya içindeki konumu belirtir.
+type FileSet struct {
+	mutex sync.RWMutex
+	base  int     // Bir sonraki dosyanın taban değeri
+	files []*File // Taban değerine göre sıralı dosyalar
+	last  *File   // Son bulunan dosya (önbellek)
+}
+
+// NewFileSet, yeni bir FileSet oluşturur.
+func NewFileSet() *FileSet {
+	return &FileSet{
+		base: 1, // 0 == NoPos
+	}
+}
+
+// Base, bir sonraki eklenecek dosyanın kullanabileceği en küçük taban değerini döndürür.
+func (s *FileSet) Base() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.base
+}
+
+// AddFile, verilen ad, taban ve boyutla yeni bir dosya ekler.
+// base negatifse FileSet.Base() kullanılır. Dosyanın Pos aralığı
+// [base, base+size] olur; sonraki dosya base+size+1'den başlar.
+func (s *FileSet) AddFile(filename string, base, size int) *File {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if base < 0 {
+		base = s.base
+	}
+	if base < s.base {
+		panic(fmt.Sprintf("geçersiz dosya taban değeri %d (en az %d olmalı)", base, s.base))
+	}
+	if size < 0 {
+		panic(fmt.Sprintf("geçersiz dosya boyutu %d", size))
+	}
+
+	f := &File{name: filename, base: base, size: size, lines: []int{0}}
+	s.base = base + size + 1 // Dosya sonu konumu için +1
+	s.files = append(s.files, f)
+	s.last = f
+	return f
+}
+
+// File, verilen Pos değerini içeren dosyayı döndürür. Bulunamazsa nil döner.
+func (s *FileSet) File(p Pos) *File {
+	if !p.IsValid() {
+		return nil
+	}
+
+	s.mutex.RLock()
+	if f := s.last; f != nil && f.base <= int(p) && int(p) <= f.base+f.size {
+		s.mutex.RUnlock()
+		return f
+	}
+	i := sort.Search(len(s.files), func(i int) bool { return s.files[i].base > int(p) }) - 1
+	var f *File
+	if i >= 0 && int(p) <= s.files[i].base+s.files[i].size {
+		f = s.files[i]
+	}
+	s.mutex.RUnlock()
+
+	if f != nil {
+		s.mutex.Lock()
+		s.last = f
+		s.mutex.Unlock()
+	}
+	return f
+}
+
+// Position, bir Pos değerini dosya adı, satır ve sütun bilgisine dönüştürür.
+func (s *FileSet) Position(p Pos) Position {
+	if f := s.File(p); f != nil {
+		return f.Position(p)
+	}
+	return Position{}
+}
+
+// Files, FileSet'teki dosyaları eklenme sırasına göre döndürür.
+func (s *FileSet) Files() []*File {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return append([]*File(nil), s.files...)
+}
@@ -9,8 +9,8 @@ type Token struct {
 	Literal  string    // Token'ın değişmez değeri (örn: "x", "123", "(")
 	Line     int       // Token'ın bulunduğu satır numarası
 	Column   int       // Token'ın bulunduğu sütun numarası
-	Pos      int       // Token'ın dosyadaki başlangıç pozisyonu (byte offset)
-	End      int       // Token'ın dosyadaki bitiş pozisyonu (byte offset)
+	Pos      Pos       // Token'ın FileSet içindeki başlangıç konumu
+	End      Pos       // Token'ın FileSet içindeki bitiş konumu
 	Position Position  // Token'ın konumu
 }
 
@@ -19,11 +19,13 @@ const (
 	// Özel token türleri
 	ILLEGAL TokenType = "ILLEGAL" // Tanınmayan token veya karakter
 	EOF     TokenType = "EOF"     // Dosya sonu
+	COMMENT TokenType = "COMMENT" // Yorum (yalnızca lexer.ScanComments modunda)
 
 	// Tanımlayıcılar + Değişmez Değerler (Literals)
 	IDENT  TokenType = "IDENT"  // main, foobar, x, y, ...
 	INT    TokenType = "INT"    // 1343456
 	FLOAT  TokenType = "FLOAT"  // 3.14
+	IMAG   TokenType = "IMAG"   // 2i
 	STRING TokenType = "STRING" // "hello world"
 	CHAR   TokenType = "CHAR"   // 'a'
 
@@ -56,12 +58,14 @@ const (
 	BIT_AND     TokenType = "&"
 	BIT_OR      TokenType = "|"
 	BIT_XOR     TokenType = "^"
-	BIT_NOT     TokenType = "~"
+	BIT_NOT     TokenType = "~" // Kısıt (constraint) konumunda ~T için de kullanılır
+	AND_NOT     TokenType = "&^"
 	LEFT_SHIFT  TokenType = "<<"
 	RIGHT_SHIFT TokenType = ">>"
 
 	// Bit bileşik atama operatörleri
 	AND_ASSIGN         TokenType = "&="
+	AND_NOT_ASSIGN     TokenType = "&^="
 	OR_ASSIGN          TokenType = "|="
 	XOR_ASSIGN         TokenType = "^="
 	LEFT_SHIFT_ASSIGN  TokenType = "<<="
@@ -71,6 +75,9 @@ const (
 	LOGICAL_AND TokenType = "&&"
 	LOGICAL_OR  TokenType = "||"
 
+	// Kanal operatörü
+	LARROW TokenType = "<-" // Kanala gönderme (c <- v) ve kanaldan alma (<-c)
+
 	// C++ tarzı operatörler
 	ARROW     TokenType = "->" // Pointer üye erişimi için
 	SCOPE_RES TokenType = "::" // Kapsam çözümleme operatörü
@@ -80,7 +87,8 @@ const (
 	SEMICOLON TokenType = ";" // İsteğe bağlı
 	COLON     TokenType = ":"
 	DOT       TokenType = "."
-	DEFINE    TokenType = ":=" // Kısa değişken tanımlama
+	ELLIPSIS  TokenType = "..." // Değişken sayıda parametre (args ...int)
+	DEFINE    TokenType = ":="  // Kısa değişken tanımlama
 
 	LPAREN   TokenType = "("
 	RPAREN   TokenType = ")"
@@ -102,6 +110,7 @@ const (
 	WHILE       TokenType = "WHILE" // C++ tarzı döngü için eklenebilir
 	BREAK       TokenType = "BREAK"
 	CONTINUE    TokenType = "CONTINUE"
+	GOTO        TokenType = "GOTO"
 	STRUCT      TokenType = "STRUCT"
 	INTERFACE   TokenType = "INTERFACE"
 	MAP         TokenType = "MAP"
@@ -166,6 +175,7 @@ var keywords = map[string]TokenType{
 	"while":       WHILE,
 	"break":       BREAK,
 	"continue":    CONTINUE,
+	"goto":        GOTO,
 	"struct":      STRUCT,
 	"interface":   INTERFACE,
 	"map":         MAP,
@@ -61,6 +61,28 @@ func TestFullCompilerPipeline(t *testing.T) {
 			`,
 			wantErr: false,
 		},
+		{
+			name: "Program without semicolons",
+			input: `
+				var total = 0
+
+				func add(a, b) {
+					return a +
+						b
+				}
+
+				func main() {
+					total = add(
+						1,
+						2,
+					)
+					if total > 2 {
+						return
+					}
+				}
+			`,
+			wantErr: false,
+		},
 		{
 			name: "Syntax error",
 			input: `
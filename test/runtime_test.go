@@ -0,0 +1,442 @@
+package test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/inkbytefo/go-minus/internal/irgen"
+	"github.com/inkbytefo/go-minus/internal/lexer"
+	"github.com/inkbytefo/go-minus/internal/parser"
+	"github.com/inkbytefo/go-minus/internal/semantic"
+)
+
+// runProgram compiles a GO-Minus program and returns its combined output.
+func runProgram(t *testing.T, input string) string {
+	t.Helper()
+
+	output, _ := exec.Command(buildProgram(t, input)).CombinedOutput()
+	return string(output)
+}
+
+// buildProgram compiles a GO-Minus program with llc and the system C compiler,
+// links it against the C runtime and returns the path of the executable. The
+// test is skipped when the toolchain is not available.
+func buildProgram(t *testing.T, input string) string {
+	t.Helper()
+
+	llc, err := exec.LookPath("llc")
+	if err != nil {
+		t.Skip("llc not found")
+	}
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("C compiler not found")
+	}
+
+	p := parser.New(lexer.New(input))
+	program := p.ParseProgram()
+	if len(p.Errors()) > 0 {
+		t.Fatalf("Parser errors: %v", p.Errors())
+	}
+	analyzer := semantic.New()
+	analyzer.Analyze(program)
+	if len(analyzer.Errors()) > 0 {
+		t.Fatalf("Semantic errors: %v", analyzer.Errors())
+	}
+	ir, err := irgen.NewWithAnalyzer(analyzer).GenerateProgram(program)
+	if err != nil {
+		t.Fatalf("GenerateProgram() error = %v", err)
+	}
+
+	dir := t.TempDir()
+	irFile := filepath.Join(dir, "main.ll")
+	objFile := filepath.Join(dir, "main.o")
+	binFile := filepath.Join(dir, "main")
+	if err := os.WriteFile(irFile, []byte(ir), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if output, err := exec.Command(llc, "-filetype=obj", "-relocation-model=pic", irFile, "-o", objFile).CombinedOutput(); err != nil {
+		t.Fatalf("llc failed: %v\n%s", err, output)
+	}
+	runtimeFiles, err := filepath.Glob(filepath.Join("..", "internal", "codegen", "runtime", "*.c"))
+	if err != nil || len(runtimeFiles) == 0 {
+		t.Fatalf("runtime sources not found: %v", err)
+	}
+	args := append([]string{objFile, "-o", binFile, "-pthread", "-lm"}, runtimeFiles...)
+	if output, err := exec.Command(cc, args...).CombinedOutput(); err != nil {
+		t.Fatalf("link failed: %v\n%s", err, output)
+	}
+	return binFile
+}
+
+// TestCompiledPrograms compiles and runs programs whose behavior can only be
+// observed at run time.
+func TestCompiledPrograms(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name: "Append leaves its operand unchanged",
+			input: `
+package main
+
+import "fmt"
+
+func grow(s []int) []int {
+	return append(s, 7, 8)
+}
+
+func main() {
+	a := make([]int, 2, 10)
+	b := append(a, 5)
+	c := grow(a)
+	fmt.Println(len(a), len(b), len(c), cap(a))
+	full := []int{1, 2}
+	d := append(full, 3)
+	fmt.Println(len(full), len(d), d[2])
+}
+`,
+			want: "2 3 4 10\n2 3 3\n",
+		},
+		{
+			name: "Range variables are fresh on every iteration",
+			input: `
+package main
+
+import "fmt"
+
+func main() {
+	fs := make([]func() int, 0)
+	for i := range 3 {
+		fs = append(fs, func() int { return i })
+	}
+	values := []int{10, 20}
+	for _, v := range values {
+		fs = append(fs, func() int { return v })
+	}
+	ps := []*int{}
+	for i := range 2 {
+		ps = append(ps, &i)
+	}
+	for _, f := range fs {
+		fmt.Println(f())
+	}
+	fmt.Println(*ps[0], *ps[1])
+}
+`,
+			want: "0\n1\n2\n10\n20\n0 1\n",
+		},
+		{
+			name: "Comma-ok type assertion does not panic",
+			input: `
+package main
+
+import "fmt"
+
+type Point struct {
+	x int
+	y int
+}
+
+func main() {
+	var v interface{} = Point{1, 2}
+	p, ok := v.(Point)
+	fmt.Println(p.x, p.y, ok)
+	n, isInt := v.(int)
+	fmt.Println(n, isInt)
+	var none interface{}
+	q, found := none.(Point)
+	fmt.Println(q.x, q.y, found)
+}
+`,
+			want: "1 2 1\n0 0\n0 0 0\n",
+		},
+		{
+			name: "Blocked goroutines do not stall the worker pool",
+			input: `
+package main
+
+import "fmt"
+import "runtime"
+
+func relay(in chan int, out chan int) {
+	v := <-in
+	out <- v + 1
+}
+
+func main() {
+	first := make(chan int)
+	in := first
+	for i := range 50 {
+		out := make(chan int)
+		go relay(in, out)
+		in = out
+	}
+	first <- 0
+	fmt.Println(<-in)
+	for runtime.NumGoroutine() > 1 {
+		runtime.Gosched()
+	}
+	fmt.Println(runtime.NumGoroutine())
+}
+`,
+			want: "50\n1\n",
+		},
+		{
+			name: "Goroutines spinning on Gosched do not starve the run queue",
+			input: `
+package main
+
+import "fmt"
+import "runtime"
+
+var ready int
+
+func spin(done chan int) {
+	for ready == 0 {
+		runtime.Gosched()
+	}
+	done <- 1
+}
+
+func setReady() {
+	ready = 1
+}
+
+func main() {
+	done := make(chan int)
+	n := runtime.NumCPU()
+	for i := range n {
+		go spin(done)
+	}
+	go setReady()
+	for i := range n {
+		<-done
+	}
+	fmt.Println("ok")
+}
+`,
+			want: "ok\n",
+		},
+		{
+			name: "Channel wrapper from the concurrent package without type parameters",
+			input: `
+package main
+
+import "fmt"
+
+type Channel struct {
+	ch chan int
+}
+
+func New(capacity int) *Channel {
+	c := &Channel{}
+	c.ch = make(chan int, capacity)
+	return c
+}
+
+func (c *Channel) Send(value int) {
+	c.ch <- value
+}
+
+func (c *Channel) Receive() int {
+	return <-c.ch
+}
+
+func (c *Channel) TryReceive() (int, bool) {
+	select {
+	case value := <-c.ch:
+		return value, true
+	default:
+		var zero int
+		return zero, false
+	}
+}
+
+func (c *Channel) TrySend(value int) bool {
+	select {
+	case c.ch <- value:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *Channel) Close() {
+	close(c.ch)
+}
+
+func (c *Channel) Len() int {
+	return len(c.ch)
+}
+
+func (c *Channel) Cap() int {
+	return cap(c.ch)
+}
+
+func main() {
+	c := New(2)
+	c.Send(1)
+	fmt.Println(c.TrySend(2), c.TrySend(3), c.Len(), c.Cap())
+	fmt.Println(c.Receive())
+	v, ok := c.TryReceive()
+	fmt.Println(v, ok)
+	v, ok = c.TryReceive()
+	fmt.Println(v, ok)
+	c.Close()
+}
+`,
+			want: "1 0 2 2\n1\n2 1\n0 0\n",
+		},
+		{
+			name: "Freed temporary strings do not affect stored strings",
+			input: `
+package main
+
+import "fmt"
+
+func main() {
+	s := ""
+	for i := range 3 {
+		s += "ab"
+		fmt.Println(s+"!", len(s+"?"), s+"c" == s+"c", string(s[0:1]+"b"))
+	}
+	t := s + "x"
+	fmt.Println(s, t, len([]byte(t+"yz")))
+}
+`,
+			want: "ab! 3 1 ab\nabab! 5 1 ab\nababab! 7 1 ab\nababab abababx 9\n",
+		},
+		{
+			name: "Catch and recover unwind through the same frames",
+			input: `
+package main
+
+import "fmt"
+
+type MyErr struct {
+	code int
+}
+
+func thrower(n int) int {
+	defer fmt.Println("thrower defer", n)
+	if n > 0 {
+		throw MyErr{n}
+	}
+	return n
+}
+
+func catches() int {
+	r := 0
+	try {
+		defer fmt.Println("deferred in try")
+		r = thrower(3)
+	} catch (e MyErr) {
+		fmt.Println("caught", e.code)
+		r = e.code
+	} finally {
+		fmt.Println("finally")
+	}
+	return r
+}
+
+func recovers() (res int) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			fmt.Println("recovered", r.(string))
+			res = 7
+		}
+	}()
+	try {
+		thrower(0)
+	} catch (e MyErr) {
+		fmt.Println("wrong catch")
+	}
+	panic("boom")
+	return 1
+}
+
+func rethrows() (res int) {
+	defer func() {
+		e := recover()
+		fmt.Println("recovered thrown", e.(MyErr).code)
+		res = 9
+	}()
+	try {
+		thrower(5)
+	} catch (e string) {
+		fmt.Println("wrong catch")
+	} finally {
+		fmt.Println("finally before recover")
+	}
+	return 1
+}
+
+func main() {
+	fmt.Println(catches())
+	fmt.Println(recovers())
+	fmt.Println(rethrows())
+	try {
+		panic("runtime")
+	} catch (e) {
+		fmt.Println("caught panic", e.(string))
+	}
+}
+`,
+			want: "thrower defer 3\ncaught 3\nfinally\ndeferred in try\n3\nthrower defer 0\nrecovered boom\n7\nthrower defer 5\nfinally before recover\nrecovered thrown 5\n9\ncaught panic runtime\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := runProgram(t, tt.input); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTemporaryStringsAreFreed runs a loop that builds a large temporary string
+// on every iteration under a memory limit. Temporaries that are only compared,
+// printed or measured must be freed, otherwise the program runs out of memory.
+func TestTemporaryStringsAreFreed(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not found")
+	}
+	bin := buildProgram(t, `
+package main
+
+import "fmt"
+
+func main() {
+	a := "x"
+	for i := range 10 {
+		a += a
+	}
+	b := a + "y"
+	n := 0
+	for i := range 200000 {
+		if a+"y" == b {
+			n++
+		}
+		n += len(a + "z")
+		fmt.Print(a[0:0] + string(a[0:0]+a[0:0]))
+	}
+	fmt.Println(n)
+}
+`)
+
+	output, err := exec.Command(sh, "-c", "ulimit -v 65536 && exec \"$0\"", bin).CombinedOutput()
+	if err != nil {
+		t.Fatalf("program failed: %v\n%s", err, output)
+	}
+	if got, want := string(output), "205200000\n"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}